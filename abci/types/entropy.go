@@ -0,0 +1,6 @@
+package types
+
+// EntropyQueryPath is the path of the query answered by Tendermint rather than the application,
+// with the verified entropy of a past height. The query data is an encoded RequestEntropy, in which
+// a height of 0 is the latest height, and the response value an encoded ResponseEntropy.
+const EntropyQueryPath = "/tendermint/entropy"
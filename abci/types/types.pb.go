@@ -628,6 +628,8 @@ type RequestBeginBlock struct {
 	Header               Header         `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
 	LastCommitInfo       LastCommitInfo `protobuf:"bytes,3,opt,name=last_commit_info,json=lastCommitInfo,proto3" json:"last_commit_info"`
 	ByzantineValidators  []Evidence     `protobuf:"bytes,4,rep,name=byzantine_validators,json=byzantineValidators,proto3" json:"byzantine_validators"`
+	Entropy              BlockEntropy   `protobuf:"bytes,5,opt,name=entropy,proto3" json:"entropy"`
+	TrivialEntropy       bool           `protobuf:"varint,6,opt,name=trivial_entropy,json=trivialEntropy,proto3" json:"trivial_entropy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -694,6 +696,20 @@ func (m *RequestBeginBlock) GetByzantineValidators() []Evidence {
 	return nil
 }
 
+func (m *RequestBeginBlock) GetEntropy() BlockEntropy {
+	if m != nil {
+		return m.Entropy
+	}
+	return BlockEntropy{}
+}
+
+func (m *RequestBeginBlock) GetTrivialEntropy() bool {
+	if m != nil {
+		return m.TrivialEntropy
+	}
+	return false
+}
+
 type RequestCheckTx struct {
 	Tx                   []byte      `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	Type                 CheckTxType `protobuf:"varint,2,opt,name=type,proto3,enum=tendermint.abci.types.CheckTxType" json:"type,omitempty"`
@@ -2157,7 +2173,20 @@ func (m *ValidatorParams) GetPubKeyTypes() []string {
 // EntropyParams contains config for DKG and entropy generation
 type EntropyParams struct {
 	// Note: must be greater than 0
-	AeonLength           int64    `protobuf:"varint,1,opt,name=aeon_length,json=aeonLength,proto3" json:"aeon_length,omitempty"`
+	AeonLength int64 `protobuf:"varint,1,opt,name=aeon_length,json=aeonLength,proto3" json:"aeon_length,omitempty"`
+	// Note: must be greater than 0
+	DkgStateDuration int64 `protobuf:"varint,2,opt,name=dkg_state_duration,json=dkgStateDuration,proto3" json:"dkg_state_duration,omitempty"`
+	// Percentage increase in dkg state durations after each failed iteration
+	DkgIterationDurationIncrease int64 `protobuf:"varint,3,opt,name=dkg_iteration_duration_increase,json=dkgIterationDurationIncrease,proto3" json:"dkg_iteration_duration_increase,omitempty"`
+	// Note: must be greater or equal to dkg_state_duration
+	DkgMaxStateDuration int64 `protobuf:"varint,4,opt,name=dkg_max_state_duration,json=dkgMaxStateDuration,proto3" json:"dkg_max_state_duration,omitempty"`
+	DkgResetDelay       int64 `protobuf:"varint,5,opt,name=dkg_reset_delay,json=dkgResetDelay,proto3" json:"dkg_reset_delay,omitempty"`
+	// Reshare the keys of the current aeon to the validators of the next
+	DkgReshare bool `protobuf:"varint,6,opt,name=dkg_reshare,json=dkgReshare,proto3" json:"dkg_reshare,omitempty"`
+	// Note: must be BlsAeon or GlowAeon
+	KeyType string `protobuf:"bytes,7,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// Note: must be Shuffle or Weighted
+	ProposerSampling     string   `protobuf:"bytes,8,opt,name=proposer_sampling,json=proposerSampling,proto3" json:"proposer_sampling,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2203,6 +2232,55 @@ func (m *EntropyParams) GetAeonLength() int64 {
 	return 0
 }
 
+func (m *EntropyParams) GetDkgStateDuration() int64 {
+	if m != nil {
+		return m.DkgStateDuration
+	}
+	return 0
+}
+
+func (m *EntropyParams) GetDkgIterationDurationIncrease() int64 {
+	if m != nil {
+		return m.DkgIterationDurationIncrease
+	}
+	return 0
+}
+
+func (m *EntropyParams) GetDkgMaxStateDuration() int64 {
+	if m != nil {
+		return m.DkgMaxStateDuration
+	}
+	return 0
+}
+
+func (m *EntropyParams) GetDkgResetDelay() int64 {
+	if m != nil {
+		return m.DkgResetDelay
+	}
+	return 0
+}
+
+func (m *EntropyParams) GetDkgReshare() bool {
+	if m != nil {
+		return m.DkgReshare
+	}
+	return false
+}
+
+func (m *EntropyParams) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+func (m *EntropyParams) GetProposerSampling() string {
+	if m != nil {
+		return m.ProposerSampling
+	}
+	return ""
+}
+
 type LastCommitInfo struct {
 	Round                int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
 	Votes                []VoteInfo `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
@@ -3016,6 +3094,124 @@ func (m *Evidence) GetTotalVotingPower() int64 {
 	return 0
 }
 
+type RequestEntropy struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestEntropy) Reset()         { *m = RequestEntropy{} }
+func (m *RequestEntropy) String() string { return proto.CompactTextString(m) }
+func (*RequestEntropy) ProtoMessage()    {}
+func (*RequestEntropy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1eaa49c51fa1ac, []int{42}
+}
+func (m *RequestEntropy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestEntropy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestEntropy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestEntropy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEntropy.Merge(m, src)
+}
+func (m *RequestEntropy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestEntropy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEntropy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestEntropy proto.InternalMessageInfo
+
+func (m *RequestEntropy) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ResponseEntropy struct {
+	Height                int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Entropy               BlockEntropy `protobuf:"bytes,2,opt,name=entropy,proto3" json:"entropy"`
+	TrivialEntropy        bool         `protobuf:"varint,3,opt,name=trivial_entropy,json=trivialEntropy,proto3" json:"trivial_entropy,omitempty"`
+	PreviousEntropyHeight int64        `protobuf:"varint,4,opt,name=previous_entropy_height,json=previousEntropyHeight,proto3" json:"previous_entropy_height,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}     `json:"-"`
+	XXX_unrecognized      []byte       `json:"-"`
+	XXX_sizecache         int32        `json:"-"`
+}
+
+func (m *ResponseEntropy) Reset()         { *m = ResponseEntropy{} }
+func (m *ResponseEntropy) String() string { return proto.CompactTextString(m) }
+func (*ResponseEntropy) ProtoMessage()    {}
+func (*ResponseEntropy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1eaa49c51fa1ac, []int{43}
+}
+func (m *ResponseEntropy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseEntropy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseEntropy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseEntropy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseEntropy.Merge(m, src)
+}
+func (m *ResponseEntropy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseEntropy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseEntropy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseEntropy proto.InternalMessageInfo
+
+func (m *ResponseEntropy) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ResponseEntropy) GetEntropy() BlockEntropy {
+	if m != nil {
+		return m.Entropy
+	}
+	return BlockEntropy{}
+}
+
+func (m *ResponseEntropy) GetTrivialEntropy() bool {
+	if m != nil {
+		return m.TrivialEntropy
+	}
+	return false
+}
+
+func (m *ResponseEntropy) GetPreviousEntropyHeight() int64 {
+	if m != nil {
+		return m.PreviousEntropyHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("tendermint.abci.types.CheckTxType", CheckTxType_name, CheckTxType_value)
 	golang_proto.RegisterEnum("tendermint.abci.types.CheckTxType", CheckTxType_name, CheckTxType_value)
@@ -3103,171 +3299,188 @@ func init() {
 	golang_proto.RegisterType((*PubKey)(nil), "tendermint.abci.types.PubKey")
 	proto.RegisterType((*Evidence)(nil), "tendermint.abci.types.Evidence")
 	golang_proto.RegisterType((*Evidence)(nil), "tendermint.abci.types.Evidence")
+	proto.RegisterType((*RequestEntropy)(nil), "tendermint.abci.types.RequestEntropy")
+	golang_proto.RegisterType((*RequestEntropy)(nil), "tendermint.abci.types.RequestEntropy")
+	proto.RegisterType((*ResponseEntropy)(nil), "tendermint.abci.types.ResponseEntropy")
+	golang_proto.RegisterType((*ResponseEntropy)(nil), "tendermint.abci.types.ResponseEntropy")
 }
 
 func init() { proto.RegisterFile("abci/types/types.proto", fileDescriptor_9f1eaa49c51fa1ac) }
 func init() { golang_proto.RegisterFile("abci/types/types.proto", fileDescriptor_9f1eaa49c51fa1ac) }
 
 var fileDescriptor_9f1eaa49c51fa1ac = []byte{
-	// 2521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x9f, 0xf6, 0xb7, 0x9f, 0x67, 0xc6, 0x4e, 0x65, 0xb2, 0xeb, 0x35, 0xbb, 0x33, 0x51, 0xcf,
-	0x26, 0x99, 0xec, 0x2e, 0x9e, 0x30, 0x68, 0xd1, 0x86, 0x44, 0x8b, 0xc6, 0x93, 0x2c, 0xb6, 0x36,
-	0xc9, 0xce, 0x76, 0x92, 0x21, 0x80, 0xb4, 0x4d, 0xd9, 0x5d, 0x69, 0xb7, 0xc6, 0xee, 0xee, 0xed,
-	0x2e, 0x3b, 0x36, 0xe2, 0x8a, 0x10, 0x12, 0x07, 0x0e, 0x20, 0xf1, 0x27, 0x70, 0x04, 0x89, 0xc3,
-	0x1e, 0x39, 0xee, 0x81, 0x03, 0x7f, 0x41, 0x80, 0x81, 0x13, 0xe2, 0x88, 0x10, 0x37, 0x50, 0x7d,
-	0xf4, 0x97, 0xc7, 0x1f, 0x9d, 0x90, 0x1b, 0x97, 0x99, 0xae, 0xaa, 0xf7, 0x5e, 0x75, 0xbd, 0xaa,
-	0xfa, 0xfd, 0xde, 0x7b, 0x6d, 0x78, 0x0d, 0x77, 0x7b, 0xd6, 0x3e, 0x9d, 0xba, 0xc4, 0x17, 0x7f,
-	0x9b, 0xae, 0xe7, 0x50, 0x07, 0x5d, 0xa2, 0xc4, 0x36, 0x88, 0x37, 0xb4, 0x6c, 0xda, 0x64, 0x22,
-	0x4d, 0x3e, 0xd8, 0xb8, 0x4a, 0xfb, 0x96, 0x67, 0xe8, 0x2e, 0xf6, 0xe8, 0x74, 0x9f, 0x4b, 0xee,
-	0x9b, 0x8e, 0xe9, 0x44, 0x4f, 0x42, 0xbd, 0xd1, 0xe8, 0x79, 0x53, 0x97, 0x3a, 0xfb, 0x43, 0xe2,
-	0x9d, 0x0e, 0x88, 0xfc, 0x27, 0xc7, 0x2e, 0x0e, 0xac, 0xae, 0xbf, 0x7f, 0x3a, 0x8e, 0xcf, 0xd7,
-	0xd8, 0x31, 0x1d, 0xc7, 0x1c, 0x10, 0x61, 0xb3, 0x3b, 0x7a, 0xba, 0x4f, 0xad, 0x21, 0xf1, 0x29,
-	0x1e, 0xba, 0x52, 0x60, 0x7b, 0x56, 0xc0, 0x18, 0x79, 0x98, 0x5a, 0x8e, 0x2d, 0xc6, 0xd5, 0x7f,
-	0xe5, 0xa1, 0xa8, 0x91, 0xcf, 0x47, 0xc4, 0xa7, 0xe8, 0x03, 0xc8, 0x91, 0x5e, 0xdf, 0xa9, 0x67,
-	0x2e, 0x2b, 0x7b, 0x95, 0x03, 0xb5, 0x39, 0x77, 0x2d, 0x4d, 0x29, 0x7d, 0xb7, 0xd7, 0x77, 0xda,
-	0x6b, 0x1a, 0xd7, 0x40, 0xb7, 0x20, 0xff, 0x74, 0x30, 0xf2, 0xfb, 0xf5, 0x2c, 0x57, 0xdd, 0x5d,
-	0xae, 0xfa, 0x11, 0x13, 0x6d, 0xaf, 0x69, 0x42, 0x87, 0x4d, 0x6b, 0xd9, 0x4f, 0x9d, 0x7a, 0x2e,
-	0xcd, 0xb4, 0x1d, 0xfb, 0x29, 0x9f, 0x96, 0x69, 0xa0, 0x36, 0x80, 0x4f, 0xa8, 0xee, 0xb8, 0x6c,
-	0x41, 0xf5, 0x3c, 0xd7, 0xbf, 0xb6, 0x5c, 0xff, 0x21, 0xa1, 0x9f, 0x70, 0xf1, 0xf6, 0x9a, 0x56,
-	0xf6, 0x83, 0x06, 0xb3, 0x64, 0xd9, 0x16, 0xd5, 0x7b, 0x7d, 0x6c, 0xd9, 0xf5, 0x42, 0x1a, 0x4b,
-	0x1d, 0xdb, 0xa2, 0x47, 0x4c, 0x9c, 0x59, 0xb2, 0x82, 0x06, 0x73, 0xc5, 0xe7, 0x23, 0xe2, 0x4d,
-	0xeb, 0xc5, 0x34, 0xae, 0xf8, 0x94, 0x89, 0x32, 0x57, 0x70, 0x1d, 0xf4, 0x31, 0x54, 0xba, 0xc4,
-	0xb4, 0x6c, 0xbd, 0x3b, 0x70, 0x7a, 0xa7, 0xf5, 0x12, 0x37, 0xb1, 0xb7, 0xdc, 0x44, 0x8b, 0x29,
-	0xb4, 0x98, 0x7c, 0x7b, 0x4d, 0x83, 0x6e, 0xd8, 0x42, 0x2d, 0x28, 0xf5, 0xfa, 0xa4, 0x77, 0xaa,
-	0xd3, 0x49, 0xbd, 0xcc, 0x2d, 0x5d, 0x59, 0x6e, 0xe9, 0x88, 0x49, 0x3f, 0x9a, 0xb4, 0xd7, 0xb4,
-	0x62, 0x4f, 0x3c, 0x32, 0xbf, 0x18, 0x64, 0x60, 0x8d, 0x89, 0xc7, 0xac, 0x5c, 0x4c, 0xe3, 0x97,
-	0x3b, 0x42, 0x9e, 0xdb, 0x29, 0x1b, 0x41, 0x03, 0xdd, 0x85, 0x32, 0xb1, 0x0d, 0xb9, 0xb0, 0x0a,
-	0x37, 0x74, 0x75, 0xc5, 0x09, 0xb3, 0x8d, 0x60, 0x59, 0x25, 0x22, 0x9f, 0xd1, 0x87, 0x50, 0xe8,
-	0x39, 0xc3, 0xa1, 0x45, 0xeb, 0xeb, 0xdc, 0xc6, 0xdb, 0x2b, 0x96, 0xc4, 0x65, 0xdb, 0x6b, 0x9a,
-	0xd4, 0x6a, 0x15, 0x21, 0x3f, 0xc6, 0x83, 0x11, 0x51, 0xaf, 0x41, 0x25, 0x76, 0x92, 0x51, 0x1d,
-	0x8a, 0x43, 0xe2, 0xfb, 0xd8, 0x24, 0x75, 0xe5, 0xb2, 0xb2, 0x57, 0xd6, 0x82, 0xa6, 0xba, 0x09,
-	0xeb, 0xf1, 0x73, 0xab, 0x0e, 0x43, 0x45, 0x76, 0x16, 0x99, 0xe2, 0x98, 0x78, 0x3e, 0x3b, 0x80,
-	0x52, 0x51, 0x36, 0xd1, 0x2e, 0x6c, 0xf0, 0xd5, 0xea, 0xc1, 0x38, 0xbb, 0x57, 0x39, 0x6d, 0x9d,
-	0x77, 0x9e, 0x48, 0xa1, 0x1d, 0xa8, 0xb8, 0x07, 0x6e, 0x28, 0x92, 0xe5, 0x22, 0xe0, 0x1e, 0xb8,
-	0x52, 0x40, 0xfd, 0x26, 0xd4, 0x66, 0x8f, 0x2e, 0xaa, 0x41, 0xf6, 0x94, 0x4c, 0xe5, 0x7c, 0xec,
-	0x11, 0x6d, 0xc9, 0x65, 0xf1, 0x39, 0xca, 0x9a, 0x5c, 0xe3, 0x6f, 0x32, 0xa1, 0x72, 0x78, 0x5a,
-	0xd9, 0x75, 0x63, 0x20, 0xc1, 0xb5, 0x2b, 0x07, 0x8d, 0xa6, 0x00, 0x88, 0x66, 0x00, 0x10, 0xcd,
-	0x47, 0x01, 0x82, 0xb4, 0x4a, 0x5f, 0x3e, 0xdf, 0x59, 0xfb, 0xf9, 0x9f, 0x76, 0x14, 0x8d, 0x6b,
-	0xa0, 0x37, 0xd8, 0x81, 0xc2, 0x96, 0xad, 0x5b, 0x86, 0x9c, 0xa7, 0xc8, 0xdb, 0x1d, 0x03, 0x7d,
-	0x0a, 0xb5, 0x9e, 0x63, 0xfb, 0xc4, 0xf6, 0x47, 0x3e, 0x83, 0x39, 0x3c, 0xf4, 0x25, 0x16, 0x2c,
-	0xda, 0xe4, 0xa3, 0x40, 0xfc, 0x98, 0x4b, 0x6b, 0xd5, 0x5e, 0xb2, 0x03, 0xdd, 0x03, 0x18, 0xe3,
-	0x81, 0x65, 0x60, 0xea, 0x78, 0x7e, 0x3d, 0x77, 0x39, 0xbb, 0xc4, 0xd8, 0x49, 0x20, 0xf8, 0xd8,
-	0x35, 0x30, 0x25, 0xad, 0x1c, 0x7b, 0x73, 0x2d, 0xa6, 0x8f, 0xae, 0x42, 0x15, 0xbb, 0xae, 0xee,
-	0x53, 0x4c, 0x89, 0xde, 0x9d, 0x52, 0xe2, 0x73, 0xbc, 0x58, 0xd7, 0x36, 0xb0, 0xeb, 0x3e, 0x64,
-	0xbd, 0x2d, 0xd6, 0xa9, 0x1a, 0xe1, 0x6e, 0xf3, 0xab, 0x89, 0x10, 0xe4, 0x0c, 0x4c, 0x31, 0xf7,
-	0xd6, 0xba, 0xc6, 0x9f, 0x59, 0x9f, 0x8b, 0x69, 0x5f, 0xfa, 0x80, 0x3f, 0xa3, 0xd7, 0xa0, 0xd0,
-	0x27, 0x96, 0xd9, 0xa7, 0x7c, 0xd9, 0x59, 0x4d, 0xb6, 0xd8, 0xc6, 0xb8, 0x9e, 0x33, 0x26, 0x1c,
-	0xdd, 0x4a, 0x9a, 0x68, 0xa8, 0xbf, 0xcc, 0xc0, 0x85, 0x73, 0xd7, 0x97, 0xd9, 0xed, 0x63, 0xbf,
-	0x1f, 0xcc, 0xc5, 0x9e, 0xd1, 0x2d, 0x66, 0x17, 0x1b, 0xc4, 0x93, 0xa8, 0xfc, 0xd6, 0x02, 0x0f,
-	0xb4, 0xb9, 0x90, 0x5c, 0xb8, 0x54, 0x41, 0x8f, 0xa1, 0x36, 0xc0, 0x3e, 0xd5, 0xc5, 0xd9, 0xd7,
-	0x39, 0xca, 0x66, 0x97, 0x22, 0xc1, 0x3d, 0x1c, 0xdc, 0x19, 0x76, 0xb8, 0xa5, 0xb9, 0xcd, 0x41,
-	0xa2, 0x17, 0x3d, 0x81, 0xad, 0xee, 0xf4, 0x87, 0xd8, 0xa6, 0x96, 0x4d, 0xf4, 0x73, 0x7b, 0xb4,
-	0xb3, 0xc0, 0xf4, 0xdd, 0xb1, 0x65, 0x10, 0xbb, 0x17, 0x6c, 0xce, 0xc5, 0xd0, 0x44, 0xb8, 0x79,
-	0xbe, 0xfa, 0x04, 0x36, 0x93, 0x58, 0x84, 0x36, 0x21, 0x43, 0x27, 0xd2, 0x23, 0x19, 0x3a, 0x41,
-	0xdf, 0x80, 0x1c, 0x33, 0xc7, 0xbd, 0xb1, 0xb9, 0x90, 0x2c, 0xa4, 0xf6, 0xa3, 0xa9, 0x4b, 0x34,
-	0x2e, 0xaf, 0xaa, 0xe1, 0x4d, 0x08, 0xf1, 0x69, 0xd6, 0xb6, 0x7a, 0x1d, 0xaa, 0x33, 0xd0, 0x13,
-	0xdb, 0x56, 0x25, 0xbe, 0xad, 0x6a, 0x15, 0x36, 0x12, 0x08, 0xa3, 0xfe, 0xa1, 0x00, 0x25, 0x8d,
-	0xf8, 0x2e, 0x3b, 0xc4, 0xa8, 0x0d, 0x65, 0x32, 0xe9, 0x11, 0x41, 0x4b, 0xca, 0x0a, 0x10, 0x17,
-	0x3a, 0x77, 0x03, 0x79, 0x86, 0x9a, 0xa1, 0x32, 0xba, 0x99, 0xa0, 0xe4, 0xdd, 0x55, 0x46, 0xe2,
-	0x9c, 0x7c, 0x3b, 0xc9, 0xc9, 0x6f, 0xaf, 0xd0, 0x9d, 0x21, 0xe5, 0x9b, 0x09, 0x52, 0x5e, 0x35,
-	0x71, 0x82, 0x95, 0x3b, 0x73, 0x58, 0x79, 0xd5, 0xf2, 0x17, 0xd0, 0x72, 0x67, 0x0e, 0x2d, 0xef,
-	0xad, 0x7c, 0x97, 0xb9, 0xbc, 0x7c, 0x3b, 0xc9, 0xcb, 0xab, 0xdc, 0x31, 0x43, 0xcc, 0xf7, 0xe6,
-	0x11, 0xf3, 0xf5, 0x15, 0x36, 0x16, 0x32, 0xf3, 0xd1, 0x39, 0x66, 0xbe, 0xba, 0xc2, 0xd4, 0x1c,
-	0x6a, 0xee, 0x24, 0xa8, 0x19, 0x52, 0xf9, 0x66, 0x01, 0x37, 0x7f, 0x74, 0x9e, 0x9b, 0xaf, 0xad,
-	0x3a, 0x6a, 0xf3, 0xc8, 0xf9, 0x5b, 0x33, 0xe4, 0x7c, 0x65, 0xd5, 0xaa, 0x16, 0xb2, 0xf3, 0x75,
-	0x86, 0x8f, 0x33, 0x37, 0x83, 0x61, 0x29, 0xf1, 0x3c, 0xc7, 0x93, 0xc4, 0x27, 0x1a, 0xea, 0x1e,
-	0x43, 0xec, 0xe8, 0xfc, 0x2f, 0x61, 0x72, 0x7e, 0x69, 0x63, 0xa7, 0x5d, 0xfd, 0x42, 0x89, 0x74,
-	0x39, 0xb2, 0xc5, 0xd1, 0xbe, 0x2c, 0xd1, 0x3e, 0x46, 0xf0, 0x99, 0x24, 0xc1, 0xef, 0x40, 0x85,
-	0x71, 0xca, 0x0c, 0x77, 0x63, 0x37, 0xe0, 0x6e, 0xf4, 0x0e, 0x5c, 0xe0, 0xf8, 0x2b, 0xc2, 0x00,
-	0x09, 0x24, 0x39, 0x0e, 0x24, 0x55, 0x36, 0x20, 0x3c, 0x28, 0x88, 0xe2, 0xab, 0x70, 0x31, 0x26,
-	0xcb, 0xec, 0x72, 0x2e, 0x10, 0x24, 0x55, 0x0b, 0xa5, 0x0f, 0x5d, 0xb7, 0x8d, 0xfd, 0xbe, 0x7a,
-	0x3f, 0x72, 0x50, 0x14, 0x17, 0x20, 0xc8, 0xf5, 0x1c, 0x43, 0xac, 0x7b, 0x43, 0xe3, 0xcf, 0x2c,
-	0x56, 0x18, 0x38, 0x26, 0x7f, 0xb9, 0xb2, 0xc6, 0x1e, 0x99, 0x54, 0x78, 0xb5, 0xcb, 0xe2, 0xce,
-	0xaa, 0xbf, 0x53, 0x22, 0x7b, 0x51, 0xa8, 0x30, 0x8f, 0xd5, 0x95, 0x57, 0xc9, 0xea, 0x99, 0xff,
-	0x8d, 0xd5, 0xd5, 0x7f, 0x2a, 0xd1, 0x96, 0x86, 0x7c, 0xfd, 0x72, 0x2e, 0x60, 0xa7, 0xcb, 0xb2,
-	0x0d, 0x32, 0xe1, 0x2e, 0xcf, 0x6a, 0xa2, 0x11, 0x84, 0x5a, 0x05, 0xbe, 0x0d, 0xc9, 0x50, 0xab,
-	0xc8, 0xfb, 0x44, 0x03, 0xbd, 0xcf, 0x79, 0xde, 0x79, 0x2a, 0xa1, 0x21, 0x41, 0x82, 0x22, 0xa9,
-	0x6b, 0xca, 0x6c, 0xee, 0x98, 0x89, 0x69, 0x42, 0x3a, 0xc6, 0x2f, 0xe5, 0x44, 0xd8, 0xf0, 0x26,
-	0x94, 0xd9, 0xab, 0xfb, 0x2e, 0xee, 0x11, 0x7e, 0xb7, 0xcb, 0x5a, 0xd4, 0xa1, 0x1a, 0x80, 0xce,
-	0x63, 0x0c, 0x7a, 0x00, 0x05, 0x32, 0x26, 0x36, 0x65, 0x7b, 0xc4, 0xdc, 0xfa, 0xe6, 0x42, 0x22,
-	0x26, 0x36, 0x6d, 0xd5, 0x99, 0x33, 0xff, 0xfe, 0x7c, 0xa7, 0x26, 0x74, 0xde, 0x73, 0x86, 0x16,
-	0x25, 0x43, 0x97, 0x4e, 0x35, 0x69, 0x45, 0xfd, 0x49, 0x86, 0xf1, 0x61, 0x02, 0x7f, 0xe6, 0xba,
-	0x37, 0xb8, 0x34, 0x99, 0x58, 0x88, 0x94, 0xce, 0xe5, 0x6f, 0x01, 0x98, 0xd8, 0xd7, 0x9f, 0x61,
-	0x9b, 0x12, 0x43, 0xfa, 0xbd, 0x6c, 0x62, 0xff, 0x3b, 0xbc, 0x83, 0xc5, 0x9b, 0x6c, 0x78, 0xe4,
-	0x13, 0x83, 0x6f, 0x40, 0x56, 0x2b, 0x9a, 0xd8, 0x7f, 0xec, 0x13, 0x23, 0xb6, 0xd6, 0xe2, 0xab,
-	0x58, 0x6b, 0xd2, 0xdf, 0xa5, 0x59, 0x7f, 0xff, 0x34, 0x13, 0xdd, 0x8e, 0x28, 0x7c, 0xf8, 0xff,
-	0xf4, 0xc5, 0x7f, 0x78, 0x4e, 0x91, 0x24, 0x01, 0xf4, 0x5d, 0xb8, 0x10, 0xde, 0x4a, 0x7d, 0xc4,
-	0x6f, 0x6b, 0x70, 0x0a, 0x5f, 0xec, 0x72, 0xd7, 0xc6, 0xc9, 0x6e, 0x1f, 0x7d, 0x06, 0xaf, 0xcf,
-	0x60, 0x50, 0x38, 0x41, 0xe6, 0x85, 0xa0, 0xe8, 0x52, 0x12, 0x8a, 0x02, 0xfb, 0x91, 0xf7, 0xb2,
-	0xaf, 0xc4, 0x7b, 0x3f, 0x80, 0x4b, 0xc6, 0xa9, 0xa9, 0x9f, 0x77, 0xc7, 0xcb, 0x64, 0x30, 0x17,
-	0x8d, 0x53, 0x73, 0x66, 0xc4, 0x57, 0x3b, 0x2c, 0x48, 0x8e, 0x13, 0xe8, 0xdc, 0x53, 0xb7, 0x0b,
-	0x1b, 0x1e, 0xa1, 0x2c, 0x5b, 0x4b, 0xe4, 0x25, 0xeb, 0xa2, 0x53, 0x90, 0x8e, 0xfa, 0x8b, 0x0c,
-	0x54, 0x67, 0xfc, 0x84, 0x3e, 0x80, 0xbc, 0x08, 0x04, 0x94, 0xa5, 0xf5, 0x18, 0xbe, 0xf1, 0xd2,
-	0xb5, 0x42, 0x01, 0x1d, 0x42, 0x89, 0xc8, 0x20, 0x5f, 0xee, 0xcd, 0x95, 0x15, 0xb9, 0x80, 0xd4,
-	0x0f, 0xd5, 0xd0, 0x1d, 0x28, 0x87, 0x9e, 0x5b, 0x91, 0x40, 0x86, 0x7e, 0x91, 0x46, 0x22, 0x45,
-	0xf4, 0x21, 0x14, 0x89, 0x4d, 0x3d, 0xc7, 0x9d, 0xca, 0xf8, 0x75, 0x51, 0xb4, 0x77, 0x57, 0x48,
-	0x49, 0x0b, 0x81, 0x92, 0x7a, 0x04, 0x95, 0xd8, 0xf2, 0xd0, 0x57, 0xa0, 0x3c, 0xc4, 0x13, 0x99,
-	0x35, 0x8a, 0x3c, 0xa0, 0x34, 0xc4, 0x13, 0x9e, 0x30, 0xa2, 0xd7, 0xa1, 0xc8, 0x06, 0x4d, 0x2c,
-	0xce, 0x63, 0x56, 0x2b, 0x0c, 0xf1, 0xe4, 0xdb, 0xd8, 0x57, 0x7f, 0xa6, 0xc0, 0x66, 0x72, 0x9d,
-	0xe8, 0x5d, 0x40, 0x4c, 0x16, 0x9b, 0x44, 0xb7, 0x47, 0x43, 0x41, 0xf5, 0x81, 0xc5, 0xea, 0x10,
-	0x4f, 0x0e, 0x4d, 0xf2, 0x60, 0x34, 0xe4, 0x53, 0xfb, 0xe8, 0x3e, 0xd4, 0x02, 0xe1, 0xa0, 0x66,
-	0x27, 0xbd, 0xfa, 0xc6, 0xb9, 0x9c, 0xfd, 0x8e, 0x14, 0x10, 0x29, 0xfb, 0xaf, 0x58, 0xca, 0xbe,
-	0x29, 0xec, 0x05, 0x23, 0xea, 0xfb, 0x50, 0x9d, 0xf1, 0x18, 0x52, 0x61, 0xc3, 0x1d, 0x75, 0xf5,
-	0x53, 0x32, 0xd5, 0xb9, 0x3b, 0xf8, 0x8d, 0x2d, 0x6b, 0x15, 0x77, 0xd4, 0xfd, 0x98, 0x4c, 0x59,
-	0xf2, 0xe4, 0xab, 0x37, 0x60, 0x23, 0xe1, 0x24, 0x1e, 0xf4, 0x10, 0xc7, 0xd6, 0x07, 0xc4, 0x36,
-	0x69, 0x5f, 0xbe, 0x3c, 0xb0, 0xae, 0x7b, 0xbc, 0x47, 0xed, 0xc1, 0x66, 0x32, 0x8b, 0x64, 0x8c,
-	0xe9, 0x39, 0x23, 0xdb, 0xe0, 0xc2, 0x79, 0x4d, 0x34, 0xd0, 0x2d, 0xc8, 0x8f, 0x1d, 0x71, 0x8d,
-	0x97, 0xa5, 0x8d, 0x27, 0x0e, 0x25, 0xb1, 0x5c, 0x54, 0xe8, 0xa8, 0x3e, 0xe4, 0xf9, 0x85, 0x64,
-	0x47, 0x9f, 0xe7, 0x83, 0x32, 0x62, 0x63, 0xcf, 0xe8, 0x04, 0x00, 0x53, 0xea, 0x59, 0xdd, 0x51,
-	0x64, 0xbe, 0x1e, 0x37, 0x3f, 0xb0, 0xba, 0x7e, 0xf3, 0x74, 0xdc, 0x3c, 0xc6, 0x96, 0xd7, 0x7a,
-	0x53, 0x5e, 0xe9, 0xad, 0x48, 0x27, 0x76, 0xad, 0x63, 0x96, 0xd4, 0xdf, 0xe6, 0xa1, 0x20, 0xf2,
-	0x6c, 0x76, 0xc2, 0xe2, 0x55, 0x9f, 0xca, 0xc1, 0xf6, 0xa2, 0xd7, 0x17, 0x52, 0xf2, 0xed, 0xc3,
-	0xd0, 0xf1, 0xea, 0x6c, 0x29, 0xa5, 0x55, 0x39, 0x7b, 0xbe, 0x53, 0xe4, 0x61, 0x57, 0xe7, 0x4e,
-	0x54, 0x57, 0x59, 0x54, 0x56, 0x08, 0x8a, 0x38, 0xb9, 0x17, 0x2e, 0xe2, 0xb4, 0x61, 0x23, 0x16,
-	0x67, 0x5a, 0x86, 0x4c, 0xd0, 0xb6, 0x97, 0x5d, 0xf3, 0xce, 0x1d, 0xf9, 0xfe, 0x95, 0x30, 0x0e,
-	0xed, 0x18, 0x68, 0x2f, 0x59, 0x5d, 0xe0, 0xe1, 0xaa, 0x88, 0x93, 0x62, 0x05, 0x03, 0x16, 0xac,
-	0xb2, 0x0b, 0xc4, 0x30, 0x49, 0x88, 0x88, 0xb0, 0xa9, 0xc4, 0x3a, 0xf8, 0xe0, 0x35, 0xa8, 0x46,
-	0x11, 0x9d, 0x10, 0x29, 0x09, 0x2b, 0x51, 0x37, 0x17, 0xbc, 0x01, 0x5b, 0x36, 0x99, 0x50, 0x7d,
-	0x56, 0xba, 0xcc, 0xa5, 0x11, 0x1b, 0x3b, 0x49, 0x6a, 0x5c, 0x81, 0xcd, 0x88, 0x3b, 0xb8, 0x2c,
-	0x88, 0x9a, 0x4f, 0xd8, 0xcb, 0xc5, 0xde, 0x80, 0x52, 0x18, 0x6f, 0x57, 0xb8, 0x40, 0x11, 0x8b,
-	0x30, 0x3b, 0x8c, 0xe0, 0x3d, 0xe2, 0x8f, 0x06, 0x54, 0x1a, 0x59, 0xe7, 0x32, 0x3c, 0x82, 0xd7,
-	0x44, 0x3f, 0x97, 0xdd, 0x85, 0x8d, 0x00, 0xc7, 0x84, 0xdc, 0x06, 0x97, 0x5b, 0x0f, 0x3a, 0xb9,
-	0xd0, 0x75, 0xa8, 0xb9, 0x9e, 0xe3, 0x3a, 0x3e, 0xf1, 0x74, 0x6c, 0x18, 0x1e, 0xf1, 0xfd, 0xfa,
-	0xa6, 0xb0, 0x17, 0xf4, 0x1f, 0x8a, 0x6e, 0x74, 0x14, 0xa1, 0x58, 0x75, 0x69, 0x16, 0xce, 0x37,
-	0x44, 0xde, 0xd2, 0xe0, 0xa0, 0x05, 0x50, 0xf6, 0x35, 0x28, 0x06, 0xd9, 0xc8, 0x16, 0xe4, 0x5b,
-	0x21, 0xb0, 0xe7, 0x34, 0xd1, 0x60, 0xd1, 0xc9, 0xa1, 0xeb, 0xca, 0xda, 0x24, 0x7b, 0x54, 0x07,
-	0x50, 0x94, 0xbb, 0x3e, 0xb7, 0x22, 0x75, 0x1f, 0xd6, 0x5d, 0xec, 0x31, 0x5f, 0xc4, 0xeb, 0x52,
-	0x8b, 0x10, 0xf6, 0x18, 0x7b, 0xf4, 0x21, 0xa1, 0x89, 0xf2, 0x54, 0x85, 0xeb, 0x8b, 0x2e, 0xf5,
-	0xc7, 0x0a, 0xac, 0xc7, 0x17, 0xc0, 0xce, 0x83, 0xe9, 0x39, 0x23, 0x57, 0xf7, 0x2d, 0xd3, 0xc6,
-	0x74, 0xe4, 0x11, 0x39, 0xfd, 0x26, 0xef, 0x7e, 0x18, 0xf4, 0x46, 0xb0, 0x22, 0x70, 0x57, 0xc2,
-	0xca, 0x0c, 0x3e, 0x65, 0x67, 0xf1, 0x09, 0x5d, 0x82, 0x02, 0x23, 0x68, 0xcb, 0x90, 0x99, 0x58,
-	0xde, 0x38, 0x35, 0x3b, 0x86, 0x7a, 0x13, 0x36, 0x12, 0xef, 0xca, 0xcc, 0x53, 0x87, 0xe2, 0x41,
-	0x80, 0x5a, 0xbc, 0x11, 0x7a, 0x24, 0x13, 0x79, 0x44, 0xbd, 0x05, 0xe5, 0xf0, 0xe0, 0xb1, 0x74,
-	0x31, 0xd8, 0x57, 0x45, 0x9e, 0x25, 0xb9, 0x9f, 0x5b, 0x90, 0x77, 0x9d, 0x67, 0xc4, 0x93, 0xef,
-	0x24, 0x1a, 0x2a, 0x89, 0xe1, 0xb2, 0x60, 0x78, 0x74, 0x1b, 0x8a, 0x12, 0x97, 0x25, 0xb8, 0x2c,
-	0x2a, 0xfa, 0x1d, 0x73, 0xa0, 0x0e, 0x8a, 0x7e, 0x02, 0xb6, 0xa3, 0x69, 0x32, 0xf1, 0x69, 0x7e,
-	0x04, 0xa5, 0x00, 0x49, 0x93, 0x24, 0x2b, 0x66, 0xb8, 0xbc, 0x8a, 0x64, 0xe5, 0x24, 0x31, 0x92,
-	0x7d, 0x07, 0x2e, 0xb0, 0x1d, 0x22, 0x86, 0x1e, 0xe1, 0x09, 0x9f, 0xb3, 0xa4, 0x55, 0xc5, 0xc0,
-	0xbd, 0x00, 0x2c, 0xd4, 0x1b, 0x50, 0x10, 0xef, 0x3a, 0x17, 0xaf, 0xe7, 0x84, 0x2f, 0xea, 0xdf,
-	0x14, 0x28, 0x05, 0xec, 0x39, 0x57, 0x29, 0xb1, 0x88, 0xcc, 0xcb, 0x2e, 0xe2, 0xd5, 0xe3, 0xeb,
-	0x7b, 0x80, 0xf8, 0x49, 0xd1, 0xc7, 0x0e, 0xb5, 0x6c, 0x53, 0x17, 0x7b, 0x21, 0xe2, 0xf9, 0x1a,
-	0x1f, 0x39, 0xe1, 0x03, 0xc7, 0xac, 0xff, 0x9d, 0x5d, 0xa8, 0xc4, 0x6a, 0x95, 0xa8, 0x08, 0xd9,
-	0x07, 0xe4, 0x59, 0x6d, 0x0d, 0x55, 0xa0, 0xa8, 0x11, 0x5e, 0xe9, 0xa9, 0x29, 0x07, 0xff, 0x28,
-	0x42, 0xf5, 0xb0, 0x75, 0xd4, 0x39, 0x74, 0xdd, 0x81, 0xd5, 0xe3, 0x74, 0x8e, 0x3e, 0x81, 0x1c,
-	0xaf, 0x76, 0xa4, 0xf8, 0x4a, 0xd7, 0x48, 0x53, 0x36, 0x44, 0x1a, 0xe4, 0x79, 0x51, 0x04, 0xa5,
-	0xf9, 0x78, 0xd7, 0x48, 0x55, 0x4d, 0x64, 0x2f, 0xc9, 0x0f, 0x5c, 0x8a, 0x6f, 0x7a, 0x8d, 0x34,
-	0x25, 0x46, 0xf4, 0x19, 0x94, 0xa3, 0x6a, 0x47, 0xda, 0x2f, 0x7d, 0x8d, 0xd4, 0xc5, 0x47, 0x66,
-	0x3f, 0xca, 0xef, 0xd2, 0x7e, 0xe7, 0x6a, 0xa4, 0xae, 0xba, 0xa1, 0x27, 0x50, 0x0c, 0x32, 0xe9,
-	0x74, 0xdf, 0xe2, 0x1a, 0x29, 0x0b, 0x83, 0x6c, 0xfb, 0x44, 0x01, 0x24, 0xcd, 0x07, 0xc7, 0x46,
-	0xaa, 0xea, 0x27, 0x7a, 0x0c, 0x05, 0x99, 0x60, 0xa4, 0xfa, 0xca, 0xd6, 0x48, 0x57, 0xee, 0x63,
-	0x4e, 0x8e, 0x4a, 0x4c, 0x69, 0x3f, 0xb2, 0x36, 0x52, 0x97, 0x7d, 0x11, 0x06, 0x88, 0x55, 0x45,
-	0x52, 0x7f, 0x3d, 0x6d, 0xa4, 0x2f, 0xe7, 0xa2, 0xef, 0x43, 0x29, 0xcc, 0x7d, 0x53, 0x7e, 0xc5,
-	0x6c, 0xa4, 0xad, 0xa8, 0xb6, 0x3a, 0xff, 0xfe, 0xcb, 0xb6, 0xf2, 0xeb, 0xb3, 0x6d, 0xe5, 0x8b,
-	0xb3, 0x6d, 0xe5, 0xcb, 0xb3, 0x6d, 0xe5, 0x8f, 0x67, 0xdb, 0xca, 0x9f, 0xcf, 0xb6, 0x95, 0xdf,
-	0xff, 0x75, 0x5b, 0xf9, 0xde, 0xbb, 0xa6, 0x45, 0xfb, 0xa3, 0x6e, 0xb3, 0xe7, 0x0c, 0xf7, 0x23,
-	0x83, 0xf1, 0xc7, 0xe8, 0xa7, 0x09, 0xdd, 0x02, 0x07, 0xac, 0xaf, 0xff, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xe9, 0xd8, 0x00, 0x50, 0xaf, 0x20, 0x00, 0x00,
+	// 2722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
+	0x15, 0x9f, 0xb6, 0xc7, 0x5f, 0xcf, 0x9f, 0x53, 0xbb, 0x9b, 0x38, 0x66, 0x33, 0xb3, 0xea, 0x4d,
+	0x76, 0x67, 0x93, 0x30, 0x13, 0x36, 0x4a, 0x94, 0x90, 0x28, 0x68, 0x3c, 0xbb, 0x61, 0xac, 0xec,
+	0x26, 0x9b, 0xde, 0xec, 0x10, 0x40, 0x4a, 0x53, 0x76, 0xd7, 0xb6, 0x5b, 0xb6, 0xbb, 0x3b, 0xdd,
+	0x6d, 0x67, 0x8c, 0xb8, 0x21, 0x84, 0x90, 0x38, 0x70, 0xe0, 0xc0, 0x9f, 0xc0, 0x11, 0x24, 0x0e,
+	0x39, 0x72, 0xcc, 0x01, 0x09, 0xfe, 0x82, 0x00, 0x0b, 0x27, 0xe0, 0x88, 0x10, 0x37, 0xd0, 0xab,
+	0x0f, 0xbb, 0xdb, 0xe3, 0x8f, 0xce, 0xb2, 0x37, 0x2e, 0x33, 0x5d, 0xaf, 0x7e, 0xef, 0x55, 0xd5,
+	0xab, 0xaa, 0x57, 0xbf, 0x7a, 0x65, 0x78, 0x8a, 0x76, 0x7b, 0xce, 0x61, 0x34, 0xf5, 0x59, 0x28,
+	0xfe, 0x1e, 0xf8, 0x81, 0x17, 0x79, 0xe4, 0x52, 0xc4, 0x5c, 0x8b, 0x05, 0x23, 0xc7, 0x8d, 0x0e,
+	0x10, 0x72, 0xc0, 0x2b, 0x5b, 0xd7, 0xa2, 0xbe, 0x13, 0x58, 0xa6, 0x4f, 0x83, 0x68, 0x7a, 0xc8,
+	0x91, 0x87, 0xb6, 0x67, 0x7b, 0xf3, 0x2f, 0xa1, 0xde, 0x6a, 0xf5, 0x82, 0xa9, 0x1f, 0x79, 0x87,
+	0x23, 0x16, 0x0c, 0x86, 0x4c, 0xfe, 0x93, 0x75, 0x17, 0x86, 0x4e, 0x37, 0x3c, 0x1c, 0x4c, 0xe2,
+	0xed, 0xb5, 0xf6, 0x6c, 0xcf, 0xb3, 0x87, 0x4c, 0xd8, 0xec, 0x8e, 0x1f, 0x1e, 0x46, 0xce, 0x88,
+	0x85, 0x11, 0x1d, 0xf9, 0x12, 0xb0, 0xbb, 0x08, 0xb0, 0xc6, 0x01, 0x8d, 0x1c, 0xcf, 0x15, 0xf5,
+	0xfa, 0xbf, 0x72, 0x50, 0x30, 0xd8, 0x27, 0x63, 0x16, 0x46, 0xe4, 0x75, 0xd8, 0x66, 0xbd, 0xbe,
+	0xd7, 0xcc, 0x5c, 0xd1, 0xf6, 0xcb, 0x37, 0xf5, 0x83, 0xa5, 0x63, 0x39, 0x90, 0xe8, 0xdb, 0xbd,
+	0xbe, 0x77, 0xb2, 0x65, 0x70, 0x0d, 0xf2, 0x26, 0xe4, 0x1e, 0x0e, 0xc7, 0x61, 0xbf, 0x99, 0xe5,
+	0xaa, 0x57, 0xd7, 0xab, 0xbe, 0x83, 0xd0, 0x93, 0x2d, 0x43, 0xe8, 0x60, 0xb3, 0x8e, 0xfb, 0xd0,
+	0x6b, 0x6e, 0xa7, 0x69, 0xb6, 0xe3, 0x3e, 0xe4, 0xcd, 0xa2, 0x06, 0x39, 0x01, 0x08, 0x59, 0x64,
+	0x7a, 0x3e, 0x0e, 0xa8, 0x99, 0xe3, 0xfa, 0xd7, 0xd7, 0xeb, 0xdf, 0x67, 0xd1, 0xfb, 0x1c, 0x7e,
+	0xb2, 0x65, 0x94, 0x42, 0x55, 0x40, 0x4b, 0x8e, 0xeb, 0x44, 0x66, 0xaf, 0x4f, 0x1d, 0xb7, 0x99,
+	0x4f, 0x63, 0xa9, 0xe3, 0x3a, 0xd1, 0x31, 0xc2, 0xd1, 0x92, 0xa3, 0x0a, 0xe8, 0x8a, 0x4f, 0xc6,
+	0x2c, 0x98, 0x36, 0x0b, 0x69, 0x5c, 0xf1, 0x01, 0x42, 0xd1, 0x15, 0x5c, 0x87, 0xbc, 0x0b, 0xe5,
+	0x2e, 0xb3, 0x1d, 0xd7, 0xec, 0x0e, 0xbd, 0xde, 0xa0, 0x59, 0xe4, 0x26, 0xf6, 0xd7, 0x9b, 0x68,
+	0xa3, 0x42, 0x1b, 0xf1, 0x27, 0x5b, 0x06, 0x74, 0x67, 0x25, 0xd2, 0x86, 0x62, 0xaf, 0xcf, 0x7a,
+	0x03, 0x33, 0x3a, 0x6b, 0x96, 0xb8, 0xa5, 0xe7, 0xd7, 0x5b, 0x3a, 0x46, 0xf4, 0x87, 0x67, 0x27,
+	0x5b, 0x46, 0xa1, 0x27, 0x3e, 0xd1, 0x2f, 0x16, 0x1b, 0x3a, 0x13, 0x16, 0xa0, 0x95, 0x0b, 0x69,
+	0xfc, 0x72, 0x4b, 0xe0, 0xb9, 0x9d, 0x92, 0xa5, 0x0a, 0xe4, 0x36, 0x94, 0x98, 0x6b, 0xc9, 0x81,
+	0x95, 0xb9, 0xa1, 0x6b, 0x1b, 0x56, 0x98, 0x6b, 0xa9, 0x61, 0x15, 0x99, 0xfc, 0x26, 0x6f, 0x43,
+	0xbe, 0xe7, 0x8d, 0x46, 0x4e, 0xd4, 0xac, 0x70, 0x1b, 0xcf, 0x6d, 0x18, 0x12, 0xc7, 0x9e, 0x6c,
+	0x19, 0x52, 0xab, 0x5d, 0x80, 0xdc, 0x84, 0x0e, 0xc7, 0x4c, 0xbf, 0x0e, 0xe5, 0xd8, 0x4a, 0x26,
+	0x4d, 0x28, 0x8c, 0x58, 0x18, 0x52, 0x9b, 0x35, 0xb5, 0x2b, 0xda, 0x7e, 0xc9, 0x50, 0x45, 0xbd,
+	0x06, 0x95, 0xf8, 0xba, 0xd5, 0x47, 0x50, 0x8e, 0xad, 0x45, 0x54, 0x9c, 0xb0, 0x20, 0xc4, 0x05,
+	0x28, 0x15, 0x65, 0x91, 0x5c, 0x85, 0x2a, 0x1f, 0xad, 0xa9, 0xea, 0x71, 0x5f, 0x6d, 0x1b, 0x15,
+	0x2e, 0x3c, 0x95, 0xa0, 0x3d, 0x28, 0xfb, 0x37, 0xfd, 0x19, 0x24, 0xcb, 0x21, 0xe0, 0xdf, 0xf4,
+	0x25, 0x40, 0xff, 0x3a, 0x34, 0x16, 0x97, 0x2e, 0x69, 0x40, 0x76, 0xc0, 0xa6, 0xb2, 0x3d, 0xfc,
+	0x24, 0x17, 0xe5, 0xb0, 0x78, 0x1b, 0x25, 0x43, 0x8e, 0xf1, 0x57, 0x19, 0x68, 0x2c, 0xae, 0x56,
+	0xdc, 0x6e, 0x18, 0x24, 0xb8, 0x76, 0xf9, 0x66, 0xeb, 0x40, 0x04, 0x88, 0x03, 0x15, 0x20, 0x0e,
+	0x3e, 0x54, 0x11, 0xa4, 0x5d, 0xfc, 0xfc, 0x8b, 0xbd, 0xad, 0x9f, 0xfd, 0x71, 0x4f, 0x33, 0xb8,
+	0x06, 0x79, 0x06, 0x17, 0x14, 0x75, 0x5c, 0xd3, 0xb1, 0x64, 0x3b, 0x05, 0x5e, 0xee, 0x58, 0xe4,
+	0x03, 0x68, 0xf4, 0x3c, 0x37, 0x64, 0x6e, 0x38, 0x0e, 0x31, 0xcc, 0xd1, 0x51, 0xd8, 0xcc, 0xae,
+	0x9d, 0xe4, 0x63, 0x05, 0xbf, 0xc7, 0xd1, 0x46, 0xbd, 0x97, 0x14, 0x90, 0x3b, 0x00, 0x13, 0x3a,
+	0x74, 0x2c, 0x1a, 0x79, 0x41, 0xd8, 0xdc, 0xbe, 0x92, 0x5d, 0x63, 0xec, 0x54, 0x01, 0x1f, 0xf8,
+	0x16, 0x8d, 0x58, 0x7b, 0x1b, 0x7b, 0x6e, 0xc4, 0xf4, 0xc9, 0x35, 0xa8, 0x53, 0xdf, 0x37, 0xc3,
+	0x88, 0x46, 0xcc, 0xec, 0x4e, 0x23, 0x16, 0xf2, 0x78, 0x51, 0x31, 0xaa, 0xd4, 0xf7, 0xef, 0xa3,
+	0xb4, 0x8d, 0x42, 0xdd, 0x82, 0x4a, 0x7c, 0x6b, 0x12, 0x02, 0xdb, 0x16, 0x8d, 0x28, 0xf7, 0x56,
+	0xc5, 0xe0, 0xdf, 0x28, 0xf3, 0x69, 0xd4, 0x97, 0x3e, 0xe0, 0xdf, 0xe4, 0x29, 0xc8, 0xf7, 0x99,
+	0x63, 0xf7, 0x23, 0x3e, 0xec, 0xac, 0x21, 0x4b, 0x38, 0x31, 0x7e, 0xe0, 0x4d, 0x18, 0x8f, 0x6e,
+	0x45, 0x43, 0x14, 0xf4, 0x1f, 0x66, 0x61, 0xe7, 0xdc, 0xf6, 0x45, 0xbb, 0x7d, 0x1a, 0xf6, 0x55,
+	0x5b, 0xf8, 0x4d, 0xde, 0x44, 0xbb, 0xd4, 0x62, 0x81, 0x8c, 0xca, 0xcf, 0xae, 0xf0, 0xc0, 0x09,
+	0x07, 0xc9, 0x81, 0x4b, 0x15, 0xf2, 0x00, 0x1a, 0x43, 0x1a, 0x46, 0xa6, 0x58, 0xfb, 0x26, 0x8f,
+	0xb2, 0xd9, 0xb5, 0x91, 0xe0, 0x0e, 0x55, 0x7b, 0x06, 0x17, 0xb7, 0x34, 0x57, 0x1b, 0x26, 0xa4,
+	0xe4, 0x23, 0xb8, 0xd8, 0x9d, 0x7e, 0x9f, 0xba, 0x91, 0xe3, 0x32, 0xf3, 0xdc, 0x1c, 0xed, 0xad,
+	0x30, 0x7d, 0x7b, 0xe2, 0x58, 0xcc, 0xed, 0xa9, 0xc9, 0xb9, 0x30, 0x33, 0x71, 0x3a, 0x9f, 0xa5,
+	0x63, 0x28, 0x30, 0x37, 0x0a, 0x3c, 0x7f, 0xda, 0xcc, 0xad, 0x0d, 0x9f, 0xdc, 0x61, 0xb7, 0x05,
+	0x54, 0x1a, 0x54, 0x9a, 0xe4, 0x3a, 0xd4, 0xa3, 0xc0, 0x99, 0x38, 0x74, 0x68, 0x2a, 0x63, 0x79,
+	0xee, 0xfc, 0x9a, 0x14, 0x4b, 0x3d, 0xfd, 0x23, 0xa8, 0x25, 0x23, 0x1f, 0xa9, 0x41, 0x26, 0x3a,
+	0x93, 0xfe, 0xcf, 0x44, 0x67, 0xe4, 0x35, 0xd8, 0xc6, 0xf6, 0xb8, 0xef, 0x6b, 0x2b, 0x8f, 0x26,
+	0xa9, 0xfd, 0xe1, 0xd4, 0x67, 0x06, 0xc7, 0xeb, 0x3a, 0x34, 0x16, 0xa3, 0xe1, 0xa2, 0x6d, 0xfd,
+	0x06, 0xd4, 0x17, 0x02, 0x5d, 0x6c, 0x11, 0x69, 0xf1, 0x45, 0xa4, 0xd7, 0xa1, 0x9a, 0x88, 0x67,
+	0xfa, 0xef, 0xf2, 0x50, 0x34, 0x58, 0xe8, 0xe3, 0x96, 0x21, 0x27, 0x50, 0x62, 0x67, 0x3d, 0x26,
+	0x0e, 0x41, 0x6d, 0xc3, 0x91, 0x21, 0x74, 0x6e, 0x2b, 0x3c, 0xc6, 0xe8, 0x99, 0x32, 0x79, 0x23,
+	0x41, 0x00, 0xae, 0x6e, 0x32, 0x12, 0x67, 0x00, 0x6f, 0x25, 0x19, 0xc0, 0x73, 0x1b, 0x74, 0x17,
+	0x28, 0xc0, 0x1b, 0x09, 0x0a, 0xb0, 0xa9, 0xe1, 0x04, 0x07, 0xe8, 0x2c, 0xe1, 0x00, 0x9b, 0x86,
+	0xbf, 0x82, 0x04, 0x74, 0x96, 0x90, 0x80, 0xfd, 0x8d, 0x7d, 0x59, 0xca, 0x02, 0xde, 0x4a, 0xb2,
+	0x80, 0x4d, 0xee, 0x58, 0xa0, 0x01, 0x77, 0x96, 0xd1, 0x80, 0x1b, 0x1b, 0x6c, 0xac, 0xe4, 0x01,
+	0xc7, 0xe7, 0x78, 0xc0, 0xb5, 0x0d, 0xa6, 0x96, 0x10, 0x81, 0x4e, 0x82, 0x08, 0x40, 0x2a, 0xdf,
+	0xac, 0x60, 0x02, 0xef, 0x9c, 0x67, 0x02, 0xd7, 0x37, 0x2d, 0xb5, 0x65, 0x54, 0xe0, 0x1b, 0x0b,
+	0x54, 0xe0, 0xf9, 0x4d, 0xa3, 0x5a, 0xc9, 0x05, 0x6e, 0xc0, 0x8e, 0x02, 0xcd, 0x76, 0x06, 0x46,
+	0x6e, 0x16, 0x04, 0x5e, 0x20, 0x8f, 0x59, 0x51, 0xd0, 0xf7, 0xa1, 0x32, 0x83, 0xae, 0xe7, 0x0d,
+	0x7c, 0xd3, 0xc6, 0x56, 0xbb, 0xfe, 0x99, 0x06, 0x95, 0xf8, 0x12, 0x4e, 0x9c, 0x2d, 0x25, 0x79,
+	0xb6, 0xc4, 0xe8, 0x44, 0x26, 0x49, 0x27, 0xf6, 0xa0, 0x8c, 0x27, 0xd8, 0x02, 0x53, 0xa0, 0xbe,
+	0x62, 0x0a, 0xe4, 0x05, 0xd8, 0xe1, 0xd1, 0x5e, 0x90, 0x0e, 0x19, 0x48, 0xb6, 0x79, 0x20, 0xa9,
+	0x63, 0x85, 0xf0, 0x20, 0x17, 0x93, 0xaf, 0xc2, 0x85, 0x18, 0x16, 0xed, 0xf2, 0x93, 0x47, 0x1c,
+	0x89, 0x8d, 0x19, 0xfa, 0xc8, 0xf7, 0x4f, 0x68, 0xd8, 0xd7, 0xef, 0xc2, 0xce, 0xb9, 0xbd, 0x83,
+	0xdd, 0xef, 0x79, 0x96, 0x18, 0x77, 0xd5, 0xe0, 0xdf, 0xc8, 0x4c, 0x86, 0x9e, 0xcd, 0x3b, 0x57,
+	0x32, 0xf0, 0x13, 0x51, 0xb3, 0xad, 0x5d, 0x12, 0x7b, 0x56, 0xff, 0x8d, 0x06, 0x3b, 0xe7, 0x36,
+	0xd0, 0x52, 0x0e, 0xa1, 0x3d, 0x49, 0x0e, 0x91, 0xf9, 0xdf, 0x38, 0x84, 0xfe, 0x4f, 0x0d, 0xaa,
+	0x89, 0x1d, 0xfb, 0xf8, 0x2e, 0xc0, 0xd5, 0xe5, 0xb8, 0x16, 0x3b, 0xe3, 0x2e, 0xcf, 0x1a, 0xa2,
+	0xa0, 0x88, 0x5d, 0x9e, 0x4f, 0x43, 0x92, 0xd8, 0x15, 0xb8, 0x4c, 0x14, 0xc8, 0xab, 0x9c, 0x55,
+	0x78, 0x0f, 0x65, 0x68, 0x48, 0x1c, 0xb9, 0xe2, 0x0a, 0x79, 0x20, 0xef, 0x8e, 0xf7, 0x10, 0x66,
+	0x08, 0x74, 0xec, 0x7c, 0x29, 0x25, 0x48, 0xca, 0x65, 0x28, 0x61, 0xd7, 0x43, 0x9f, 0xf6, 0x18,
+	0xdf, 0xdb, 0x25, 0x63, 0x2e, 0xd0, 0x2d, 0x20, 0xe7, 0x63, 0x0c, 0x79, 0x0f, 0xf2, 0x6c, 0xc2,
+	0xdc, 0x08, 0xe7, 0x08, 0xdd, 0x7a, 0x79, 0xe5, 0xb1, 0xcf, 0xdc, 0xa8, 0xdd, 0x44, 0x67, 0xfe,
+	0xed, 0x8b, 0xbd, 0x86, 0xd0, 0x79, 0xc9, 0x1b, 0x39, 0x11, 0x1b, 0xf9, 0xd1, 0xd4, 0x90, 0x56,
+	0xf4, 0x1f, 0x67, 0xa0, 0xae, 0x9a, 0x51, 0xc7, 0xf1, 0x32, 0xf7, 0xaa, 0x4d, 0x93, 0x89, 0x11,
+	0xb2, 0x74, 0x2e, 0x7f, 0x16, 0xc0, 0xa6, 0xa1, 0xf9, 0x29, 0x75, 0x23, 0x66, 0x49, 0xbf, 0x97,
+	0x6c, 0x1a, 0x7e, 0x8b, 0x0b, 0x90, 0xdd, 0x62, 0xf5, 0x38, 0x64, 0x16, 0x9f, 0x80, 0xac, 0x51,
+	0xb0, 0x69, 0xf8, 0x20, 0x64, 0x56, 0x6c, 0xac, 0x85, 0x27, 0x31, 0xd6, 0xa4, 0xbf, 0x8b, 0x8b,
+	0xfe, 0xfe, 0x49, 0x06, 0x76, 0xce, 0x85, 0xd0, 0xff, 0x53, 0x5f, 0xfc, 0x87, 0xdf, 0x60, 0x92,
+	0x87, 0x00, 0xf9, 0x36, 0xec, 0xcc, 0x76, 0xa5, 0x39, 0xe6, 0xbb, 0x55, 0xad, 0xc2, 0x2f, 0xb7,
+	0xb9, 0x1b, 0x93, 0xa4, 0x38, 0x24, 0x1f, 0xc3, 0xd3, 0x0b, 0x31, 0x68, 0xd6, 0x40, 0xe6, 0x4b,
+	0x85, 0xa2, 0x4b, 0xc9, 0x50, 0xa4, 0xec, 0xcf, 0xbd, 0x97, 0x7d, 0x22, 0xde, 0xfb, 0x1e, 0x5c,
+	0xb2, 0x06, 0xb6, 0x79, 0xde, 0x1d, 0x8f, 0x73, 0x5f, 0xba, 0x60, 0x0d, 0xec, 0x85, 0x9a, 0x50,
+	0xef, 0x40, 0x4d, 0x4d, 0x80, 0x38, 0x40, 0x97, 0xae, 0xba, 0xab, 0x50, 0x0d, 0x58, 0x84, 0x77,
+	0xc3, 0xc4, 0x2d, 0xa8, 0x22, 0x84, 0xe2, 0xd0, 0xd1, 0x7f, 0x9e, 0x81, 0xfa, 0x82, 0x9f, 0xc8,
+	0xeb, 0x90, 0x13, 0x44, 0x40, 0x5b, 0x9b, 0xfd, 0xe1, 0x13, 0x2f, 0x5d, 0x2b, 0x14, 0xc8, 0x11,
+	0x14, 0x99, 0xbc, 0x52, 0x34, 0x33, 0x6b, 0x09, 0x80, 0xba, 0x79, 0x48, 0xfd, 0x99, 0x1a, 0xb9,
+	0x05, 0xa5, 0x99, 0xe7, 0x36, 0x5c, 0x57, 0x67, 0x7e, 0x91, 0x46, 0xe6, 0x8a, 0xe4, 0xed, 0xf9,
+	0xa5, 0x65, 0x7b, 0x2d, 0xdb, 0x93, 0xf7, 0x0e, 0x69, 0x41, 0x29, 0xe9, 0xc7, 0x50, 0x8e, 0x0d,
+	0x8f, 0x7c, 0x05, 0x4a, 0x23, 0x7a, 0x26, 0xef, 0xa8, 0xe2, 0x1e, 0x50, 0x1c, 0xd1, 0x33, 0x7e,
+	0x3d, 0x25, 0x4f, 0x43, 0x01, 0x2b, 0x6d, 0x2a, 0xd6, 0x63, 0xd6, 0xc8, 0x8f, 0xe8, 0xd9, 0x37,
+	0x69, 0xa8, 0xff, 0x54, 0x83, 0x5a, 0x72, 0x9c, 0xe4, 0x45, 0x20, 0x88, 0xa5, 0x36, 0x33, 0xdd,
+	0xf1, 0x48, 0x1c, 0xf5, 0xca, 0x62, 0x7d, 0x44, 0xcf, 0x8e, 0x6c, 0xf6, 0xde, 0x78, 0xc4, 0x9b,
+	0x0e, 0xc9, 0x5d, 0x68, 0x28, 0xb0, 0xca, 0x10, 0x4a, 0xaf, 0x3e, 0x73, 0x2e, 0x43, 0x70, 0x4b,
+	0x02, 0x44, 0x82, 0xe0, 0x17, 0x98, 0x20, 0xa8, 0x09, 0x7b, 0xaa, 0x46, 0x7f, 0x15, 0xea, 0x0b,
+	0x1e, 0x23, 0x3a, 0x54, 0xfd, 0x71, 0xd7, 0x1c, 0xb0, 0xa9, 0xc9, 0xdd, 0xc1, 0x77, 0x6c, 0xc9,
+	0x28, 0xfb, 0xe3, 0xee, 0xbb, 0x6c, 0x8a, 0x97, 0xa7, 0x50, 0xff, 0x7b, 0x06, 0xaa, 0x09, 0x2f,
+	0x71, 0xd6, 0xc3, 0x3c, 0xd7, 0x1c, 0x32, 0xd7, 0x8e, 0xfa, 0xb2, 0xf7, 0x80, 0xa2, 0x3b, 0x5c,
+	0x42, 0x5e, 0x02, 0x82, 0x3b, 0x40, 0x5c, 0xec, 0x13, 0x5d, 0xcf, 0x1a, 0x0d, 0x6b, 0x60, 0xf3,
+	0xbb, 0xbd, 0xea, 0x17, 0xb9, 0x0d, 0x7b, 0x88, 0x76, 0x22, 0x26, 0x04, 0x33, 0x0d, 0xd3, 0x71,
+	0x7b, 0x01, 0xa3, 0x21, 0x93, 0x2b, 0xf7, 0xb2, 0x35, 0xb0, 0x3b, 0x0a, 0xa5, 0xd4, 0x3b, 0x12,
+	0x43, 0x5e, 0x81, 0xa7, 0xd0, 0x0c, 0x7a, 0x6c, 0xa1, 0x61, 0xc1, 0xb7, 0x70, 0x27, 0xdd, 0xa5,
+	0x67, 0xc9, 0xb6, 0xaf, 0x41, 0x1d, 0x95, 0x02, 0x86, 0xf7, 0x15, 0x8b, 0x0d, 0xe9, 0x54, 0x06,
+	0xde, 0xaa, 0x35, 0xb0, 0x0d, 0x94, 0xde, 0x42, 0x21, 0x0e, 0x59, 0xe2, 0xfa, 0x34, 0x60, 0xf2,
+	0xee, 0x0a, 0x02, 0x83, 0x12, 0x8c, 0xce, 0xca, 0x8b, 0x9c, 0x16, 0x94, 0x8c, 0xc2, 0x40, 0x78,
+	0x90, 0xbc, 0x08, 0x3b, 0x7e, 0xe0, 0xf9, 0x5e, 0xc8, 0x02, 0x33, 0xa4, 0x23, 0x7f, 0xe8, 0xb8,
+	0xb6, 0x8c, 0xaa, 0x0d, 0x55, 0x71, 0x5f, 0xca, 0xf5, 0x1e, 0xd4, 0x92, 0xf7, 0x7d, 0x64, 0x1b,
+	0x81, 0x37, 0x76, 0x2d, 0xee, 0xe7, 0x9c, 0x21, 0x0a, 0x98, 0xd2, 0x9c, 0x78, 0x22, 0x04, 0xae,
+	0xbb, 0xe0, 0x9f, 0x7a, 0x11, 0x8b, 0x65, 0x0d, 0x84, 0x8e, 0x1e, 0x42, 0x8e, 0x07, 0x33, 0x0c,
+	0x1b, 0xbc, 0xc7, 0x92, 0xed, 0xe2, 0x37, 0x39, 0x05, 0xa0, 0x51, 0x14, 0x38, 0xdd, 0xf1, 0xdc,
+	0x7c, 0x33, 0x6e, 0x1e, 0x73, 0xde, 0x07, 0x83, 0xc9, 0xc1, 0x3d, 0xea, 0x04, 0xed, 0xcb, 0x32,
+	0x1c, 0x5e, 0x9c, 0xeb, 0xc4, 0x42, 0x62, 0xcc, 0x92, 0xfe, 0xeb, 0x1c, 0xe4, 0x45, 0x46, 0x04,
+	0x77, 0x67, 0x3c, 0x3f, 0x57, 0xbe, 0xb9, 0xbb, 0xaa, 0xfb, 0x02, 0xa5, 0xb2, 0x09, 0x52, 0x89,
+	0x5c, 0x5b, 0x4c, 0x7a, 0xb5, 0xcb, 0x8f, 0xbe, 0xd8, 0x2b, 0x70, 0xca, 0xda, 0xb9, 0x35, 0xcf,
+	0x80, 0xad, 0x4a, 0x00, 0xa9, 0x74, 0xdb, 0xf6, 0x97, 0x4e, 0xb7, 0x9d, 0x40, 0x35, 0xc6, 0xd1,
+	0x1d, 0xab, 0x99, 0x5b, 0xdb, 0x7f, 0xbe, 0x91, 0x3b, 0xb7, 0x64, 0xff, 0xcb, 0x33, 0x0e, 0xdf,
+	0xb1, 0xc8, 0x7e, 0x32, 0x0f, 0xc4, 0xa9, 0xbe, 0xe0, 0x98, 0xb1, 0xd4, 0x0e, 0x12, 0x7d, 0x0c,
+	0x3e, 0x18, 0xcf, 0x05, 0x44, 0x50, 0xce, 0x22, 0x0a, 0x78, 0xe5, 0x75, 0xa8, 0xcf, 0xd9, 0xb0,
+	0x80, 0x14, 0x85, 0x95, 0xb9, 0x98, 0x03, 0x5f, 0x86, 0x8b, 0x2e, 0x3b, 0x8b, 0xcc, 0x45, 0x74,
+	0x89, 0xa3, 0x09, 0xd6, 0x9d, 0x26, 0x35, 0x9e, 0x87, 0xda, 0xfc, 0xdc, 0xe5, 0x58, 0x10, 0xd9,
+	0xb9, 0x99, 0x94, 0xc3, 0x9e, 0x81, 0xe2, 0xec, 0xae, 0x52, 0xe6, 0x80, 0x02, 0x15, 0x57, 0x94,
+	0xd9, 0xed, 0x27, 0x60, 0xe1, 0x78, 0x18, 0x49, 0x23, 0x15, 0x8e, 0xe1, 0xb7, 0x1f, 0x43, 0xc8,
+	0x39, 0xf6, 0x2a, 0x54, 0xd5, 0x19, 0x20, 0x70, 0x55, 0x8e, 0xab, 0x28, 0x21, 0x07, 0xdd, 0x80,
+	0xd9, 0x8e, 0x31, 0xa9, 0x65, 0x05, 0x2c, 0x0c, 0x9b, 0x35, 0x61, 0x4f, 0xc9, 0x8f, 0x84, 0x38,
+	0x9e, 0xb6, 0xaa, 0x3f, 0x6e, 0xda, 0x4a, 0xff, 0x1a, 0x14, 0xd4, 0x4d, 0xee, 0x22, 0xe4, 0xda,
+	0xb3, 0x43, 0x71, 0xdb, 0x10, 0x05, 0x64, 0x76, 0x47, 0xbe, 0x2f, 0xb3, 0xc8, 0xf8, 0xa9, 0x0f,
+	0xa1, 0x20, 0x67, 0x7d, 0x69, 0xee, 0xf0, 0x2e, 0x54, 0xf0, 0xc5, 0x29, 0x34, 0x13, 0x19, 0xc4,
+	0x55, 0xa7, 0xd3, 0x3d, 0x1a, 0x60, 0x8a, 0x39, 0x91, 0x48, 0x2c, 0x73, 0x7d, 0x21, 0xd2, 0x7f,
+	0xa4, 0x41, 0x25, 0x3e, 0x00, 0x5c, 0x0f, 0x76, 0xe0, 0x8d, 0x7d, 0x33, 0x74, 0x6c, 0x97, 0x46,
+	0xe3, 0x80, 0xc9, 0xe6, 0x6b, 0x5c, 0x7c, 0x5f, 0x49, 0xe7, 0x61, 0x45, 0x84, 0x65, 0x51, 0x58,
+	0x0c, 0xed, 0xd9, 0x73, 0xa1, 0xfd, 0x12, 0xe4, 0x79, 0xb0, 0xb6, 0x64, 0x54, 0xcd, 0x61, 0x4c,
+	0xb6, 0xf4, 0x37, 0xa0, 0x9a, 0xe8, 0x2b, 0x9a, 0x8f, 0xbc, 0x88, 0x0e, 0x55, 0xd4, 0xe2, 0x85,
+	0x99, 0x47, 0x32, 0x73, 0x8f, 0xe8, 0x6f, 0x42, 0x69, 0xb6, 0xf0, 0xf0, 0xaa, 0xad, 0xe6, 0x55,
+	0x93, 0x6b, 0x49, 0x14, 0xd1, 0xa0, 0xef, 0x7d, 0xca, 0x02, 0xd9, 0x27, 0x51, 0xd0, 0x19, 0xd4,
+	0x17, 0xd8, 0x11, 0x79, 0x0b, 0x0a, 0xf2, 0x4c, 0x6b, 0x6a, 0x6b, 0xd3, 0xb3, 0xf7, 0xf8, 0x21,
+	0xa7, 0xd2, 0xb3, 0xe2, 0xc8, 0x9b, 0x37, 0x93, 0x89, 0x37, 0xf3, 0x03, 0x28, 0xaa, 0x48, 0x9a,
+	0x24, 0x28, 0xa2, 0x85, 0x2b, 0x9b, 0x08, 0x8a, 0x6c, 0x64, 0xae, 0x88, 0x5b, 0x03, 0x67, 0x88,
+	0x59, 0xe6, 0x3c, 0x9e, 0xf0, 0x36, 0x8b, 0x46, 0x5d, 0x54, 0xdc, 0x51, 0xc1, 0x42, 0x7f, 0x19,
+	0xf2, 0xa2, 0xaf, 0x4b, 0xe3, 0xf5, 0x12, 0xea, 0xa7, 0xff, 0x55, 0x83, 0xa2, 0x62, 0x1e, 0x4b,
+	0x95, 0x12, 0x83, 0xc8, 0x3c, 0xee, 0x20, 0x9e, 0x7c, 0x7c, 0x7d, 0x09, 0x08, 0x5f, 0x29, 0xe6,
+	0xc4, 0x8b, 0x1c, 0xd7, 0x36, 0xc5, 0x5c, 0x88, 0x23, 0xb9, 0xc1, 0x6b, 0x4e, 0x79, 0xc5, 0x3d,
+	0x3e, 0x2d, 0xfb, 0xb3, 0x64, 0xb1, 0x5a, 0xfe, 0xab, 0xb2, 0xb5, 0xbf, 0xd7, 0xe6, 0x37, 0xd9,
+	0x0d, 0xd8, 0x78, 0xe4, 0xc8, 0x3c, 0xc9, 0x84, 0x77, 0x76, 0x59, 0xc2, 0x9b, 0xbc, 0x06, 0x4f,
+	0xfb, 0x01, 0x9b, 0x38, 0xde, 0x38, 0x54, 0xc8, 0x64, 0x9e, 0xe8, 0x92, 0xaa, 0x96, 0x1a, 0x82,
+	0xb8, 0xbf, 0x70, 0x15, 0xca, 0xb1, 0x1c, 0x37, 0x29, 0x40, 0xf6, 0x3d, 0xf6, 0x69, 0x63, 0x8b,
+	0x94, 0xf1, 0xed, 0x98, 0x67, 0x08, 0x1b, 0xda, 0xcd, 0x7f, 0x14, 0xa0, 0x7e, 0xd4, 0x3e, 0xee,
+	0x1c, 0xf9, 0xfe, 0xd0, 0xe9, 0x09, 0xca, 0xf3, 0x3e, 0x6c, 0xf3, 0x2c, 0x59, 0x8a, 0xb7, 0xe4,
+	0x56, 0x9a, 0x74, 0x33, 0x31, 0x20, 0xc7, 0x93, 0x69, 0x24, 0xcd, 0x13, 0x73, 0x2b, 0x55, 0x16,
+	0x1a, 0x3b, 0xc9, 0x37, 0x5b, 0x8a, 0x97, 0xe7, 0x56, 0x9a, 0xd4, 0x34, 0xf9, 0x18, 0x4a, 0xf3,
+	0x2c, 0x59, 0xda, 0xf7, 0xe8, 0x56, 0xea, 0xa4, 0x35, 0xda, 0x9f, 0xe7, 0x05, 0xd2, 0xbe, 0xc6,
+	0xb6, 0x52, 0x67, 0x6b, 0xc9, 0x47, 0x50, 0x50, 0x19, 0x98, 0x74, 0x2f, 0xc6, 0xad, 0x94, 0x09,
+	0x65, 0x9c, 0x3e, 0x91, 0x38, 0x4b, 0xf3, 0x2c, 0xde, 0x4a, 0x95, 0x35, 0x27, 0x0f, 0x20, 0x2f,
+	0x2f, 0xa6, 0xa9, 0xde, 0x82, 0x5b, 0xe9, 0xd2, 0xc4, 0xe8, 0xe4, 0x79, 0x6a, 0x32, 0xed, 0x4f,
+	0x01, 0x5a, 0xa9, 0x9f, 0x0b, 0x08, 0x05, 0x88, 0x65, 0xd3, 0x52, 0xbf, 0xf1, 0xb7, 0xd2, 0x3f,
+	0x03, 0x90, 0xef, 0x42, 0x71, 0x96, 0x33, 0x49, 0xf9, 0xd6, 0xde, 0x4a, 0x9b, 0x89, 0x6f, 0x77,
+	0xfe, 0xfd, 0xe7, 0x5d, 0xed, 0x97, 0x8f, 0x76, 0xb5, 0xcf, 0x1e, 0xed, 0x6a, 0x9f, 0x3f, 0xda,
+	0xd5, 0xfe, 0xf0, 0x68, 0x57, 0xfb, 0xd3, 0xa3, 0x5d, 0xed, 0xb7, 0x7f, 0xd9, 0xd5, 0xbe, 0xf3,
+	0xa2, 0xed, 0x44, 0xfd, 0x71, 0xf7, 0xa0, 0xe7, 0x8d, 0x0e, 0xe7, 0x06, 0xe3, 0x9f, 0xf3, 0x1f,
+	0xd0, 0x74, 0xf3, 0x3c, 0x58, 0xbf, 0xf2, 0xdf, 0x01, 0x00, 0x62, 0x9e, 0xff, 0x8c, 0x55, 0x23,
+	0x00, 0x00,
 }
 
 func (this *Request) Equal(that interface{}) bool {
@@ -3797,6 +4010,12 @@ func (this *RequestBeginBlock) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Entropy.Equal(&that1.Entropy) {
+		return false
+	}
+	if this.TrivialEntropy != that1.TrivialEntropy {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4833,6 +5052,27 @@ func (this *EntropyParams) Equal(that interface{}) bool {
 	if this.AeonLength != that1.AeonLength {
 		return false
 	}
+	if this.DkgStateDuration != that1.DkgStateDuration {
+		return false
+	}
+	if this.DkgIterationDurationIncrease != that1.DkgIterationDurationIncrease {
+		return false
+	}
+	if this.DkgMaxStateDuration != that1.DkgMaxStateDuration {
+		return false
+	}
+	if this.DkgResetDelay != that1.DkgResetDelay {
+		return false
+	}
+	if this.DkgReshare != that1.DkgReshare {
+		return false
+	}
+	if this.KeyType != that1.KeyType {
+		return false
+	}
+	if this.ProposerSampling != that1.ProposerSampling {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -5262,6 +5502,69 @@ func (this *Evidence) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RequestEntropy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestEntropy)
+	if !ok {
+		that2, ok := that.(RequestEntropy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ResponseEntropy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseEntropy)
+	if !ok {
+		that2, ok := that.(ResponseEntropy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Entropy.Equal(&that1.Entropy) {
+		return false
+	}
+	if this.TrivialEntropy != that1.TrivialEntropy {
+		return false
+	}
+	if this.PreviousEntropyHeight != that1.PreviousEntropyHeight {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -6273,6 +6576,26 @@ func (m *RequestBeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TrivialEntropy {
+		i--
+		if m.TrivialEntropy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Entropy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.ByzantineValidators) > 0 {
 		for iNdEx := len(m.ByzantineValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7538,12 +7861,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err35 != nil {
-		return 0, err35
+	n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err36 != nil {
+		return 0, err36
 	}
-	i -= n35
-	i = encodeVarintTypes(dAtA, i, uint64(n35))
+	i -= n36
+	i = encodeVarintTypes(dAtA, i, uint64(n36))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -7614,6 +7937,50 @@ func (m *EntropyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ProposerSampling) > 0 {
+		i -= len(m.ProposerSampling)
+		copy(dAtA[i:], m.ProposerSampling)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposerSampling)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DkgReshare {
+		i--
+		if m.DkgReshare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DkgResetDelay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DkgResetDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DkgMaxStateDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DkgMaxStateDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DkgIterationDurationIncrease != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DkgIterationDurationIncrease))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DkgStateDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DkgStateDuration))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.AeonLength != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.AeonLength))
 		i--
@@ -7823,12 +8190,12 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x2a
-	n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err38 != nil {
-		return 0, err38
+	n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err39 != nil {
+		return 0, err39
 	}
-	i -= n38
-	i = encodeVarintTypes(dAtA, i, uint64(n38))
+	i -= n39
+	i = encodeVarintTypes(dAtA, i, uint64(n39))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -8223,12 +8590,12 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err43 != nil {
-		return 0, err43
+	n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err44 != nil {
+		return 0, err44
 	}
-	i -= n43
-	i = encodeVarintTypes(dAtA, i, uint64(n43))
+	i -= n44
+	i = encodeVarintTypes(dAtA, i, uint64(n44))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -8256,19 +8623,108 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RequestEntropy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedRequest(r randyTypes, easy bool) *Request {
-	this := &Request{}
+
+func (m *RequestEntropy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestEntropy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseEntropy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseEntropy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseEntropy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PreviousEntropyHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PreviousEntropyHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrivialEntropy {
+		i--
+		if m.TrivialEntropy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Entropy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedRequest(r randyTypes, easy bool) *Request {
+	this := &Request{}
 	oneofNumber_Value := []int32{2, 3, 4, 5, 6, 7, 8, 9, 11, 12, 19}[r.Intn(11)]
 	switch oneofNumber_Value {
 	case 2:
@@ -8458,17 +8914,20 @@ func NewPopulatedRequestBeginBlock(r randyTypes, easy bool) *RequestBeginBlock {
 			this.ByzantineValidators[i] = *v10
 		}
 	}
+	v11 := NewPopulatedBlockEntropy(r, easy)
+	this.Entropy = *v11
+	this.TrivialEntropy = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 7)
 	}
 	return this
 }
 
 func NewPopulatedRequestCheckTx(r randyTypes, easy bool) *RequestCheckTx {
 	this := &RequestCheckTx{}
-	v11 := r.Intn(100)
-	this.Tx = make([]byte, v11)
-	for i := 0; i < v11; i++ {
+	v12 := r.Intn(100)
+	this.Tx = make([]byte, v12)
+	for i := 0; i < v12; i++ {
 		this.Tx[i] = byte(r.Intn(256))
 	}
 	this.Type = CheckTxType([]int32{0, 1}[r.Intn(2)])
@@ -8480,9 +8939,9 @@ func NewPopulatedRequestCheckTx(r randyTypes, easy bool) *RequestCheckTx {
 
 func NewPopulatedRequestDeliverTx(r randyTypes, easy bool) *RequestDeliverTx {
 	this := &RequestDeliverTx{}
-	v12 := r.Intn(100)
-	this.Tx = make([]byte, v12)
-	for i := 0; i < v12; i++ {
+	v13 := r.Intn(100)
+	this.Tx = make([]byte, v13)
+	for i := 0; i < v13; i++ {
 		this.Tx[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8641,9 +9100,9 @@ func NewPopulatedResponseInfo(r randyTypes, easy bool) *ResponseInfo {
 	if r.Intn(2) == 0 {
 		this.LastBlockHeight *= -1
 	}
-	v13 := r.Intn(100)
-	this.LastBlockAppHash = make([]byte, v13)
-	for i := 0; i < v13; i++ {
+	v14 := r.Intn(100)
+	this.LastBlockAppHash = make([]byte, v14)
+	for i := 0; i < v14; i++ {
 		this.LastBlockAppHash[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8669,11 +9128,11 @@ func NewPopulatedResponseInitChain(r randyTypes, easy bool) *ResponseInitChain {
 		this.ConsensusParams = NewPopulatedConsensusParams(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Validators = make([]ValidatorUpdate, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedValidatorUpdate(r, easy)
-			this.Validators[i] = *v15
+		v15 := r.Intn(5)
+		this.Validators = make([]ValidatorUpdate, v15)
+		for i := 0; i < v15; i++ {
+			v16 := NewPopulatedValidatorUpdate(r, easy)
+			this.Validators[i] = *v16
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8691,14 +9150,14 @@ func NewPopulatedResponseQuery(r randyTypes, easy bool) *ResponseQuery {
 	if r.Intn(2) == 0 {
 		this.Index *= -1
 	}
-	v16 := r.Intn(100)
-	this.Key = make([]byte, v16)
-	for i := 0; i < v16; i++ {
-		this.Key[i] = byte(r.Intn(256))
-	}
 	v17 := r.Intn(100)
-	this.Value = make([]byte, v17)
+	this.Key = make([]byte, v17)
 	for i := 0; i < v17; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	v18 := r.Intn(100)
+	this.Value = make([]byte, v18)
+	for i := 0; i < v18; i++ {
 		this.Value[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
@@ -8718,11 +9177,11 @@ func NewPopulatedResponseQuery(r randyTypes, easy bool) *ResponseQuery {
 func NewPopulatedResponseBeginBlock(r randyTypes, easy bool) *ResponseBeginBlock {
 	this := &ResponseBeginBlock{}
 	if r.Intn(5) != 0 {
-		v18 := r.Intn(5)
-		this.Events = make([]Event, v18)
-		for i := 0; i < v18; i++ {
-			v19 := NewPopulatedEvent(r, easy)
-			this.Events[i] = *v19
+		v19 := r.Intn(5)
+		this.Events = make([]Event, v19)
+		for i := 0; i < v19; i++ {
+			v20 := NewPopulatedEvent(r, easy)
+			this.Events[i] = *v20
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8734,9 +9193,9 @@ func NewPopulatedResponseBeginBlock(r randyTypes, easy bool) *ResponseBeginBlock
 func NewPopulatedResponseCheckTx(r randyTypes, easy bool) *ResponseCheckTx {
 	this := &ResponseCheckTx{}
 	this.Code = uint32(r.Uint32())
-	v20 := r.Intn(100)
-	this.Data = make([]byte, v20)
-	for i := 0; i < v20; i++ {
+	v21 := r.Intn(100)
+	this.Data = make([]byte, v21)
+	for i := 0; i < v21; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	this.Log = string(randStringTypes(r))
@@ -8750,11 +9209,11 @@ func NewPopulatedResponseCheckTx(r randyTypes, easy bool) *ResponseCheckTx {
 		this.GasUsed *= -1
 	}
 	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.Events = make([]Event, v21)
-		for i := 0; i < v21; i++ {
-			v22 := NewPopulatedEvent(r, easy)
-			this.Events[i] = *v22
+		v22 := r.Intn(5)
+		this.Events = make([]Event, v22)
+		for i := 0; i < v22; i++ {
+			v23 := NewPopulatedEvent(r, easy)
+			this.Events[i] = *v23
 		}
 	}
 	this.Codespace = string(randStringTypes(r))
@@ -8767,9 +9226,9 @@ func NewPopulatedResponseCheckTx(r randyTypes, easy bool) *ResponseCheckTx {
 func NewPopulatedResponseDeliverTx(r randyTypes, easy bool) *ResponseDeliverTx {
 	this := &ResponseDeliverTx{}
 	this.Code = uint32(r.Uint32())
-	v23 := r.Intn(100)
-	this.Data = make([]byte, v23)
-	for i := 0; i < v23; i++ {
+	v24 := r.Intn(100)
+	this.Data = make([]byte, v24)
+	for i := 0; i < v24; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	this.Log = string(randStringTypes(r))
@@ -8783,11 +9242,11 @@ func NewPopulatedResponseDeliverTx(r randyTypes, easy bool) *ResponseDeliverTx {
 		this.GasUsed *= -1
 	}
 	if r.Intn(5) != 0 {
-		v24 := r.Intn(5)
-		this.Events = make([]Event, v24)
-		for i := 0; i < v24; i++ {
-			v25 := NewPopulatedEvent(r, easy)
-			this.Events[i] = *v25
+		v25 := r.Intn(5)
+		this.Events = make([]Event, v25)
+		for i := 0; i < v25; i++ {
+			v26 := NewPopulatedEvent(r, easy)
+			this.Events[i] = *v26
 		}
 	}
 	this.Codespace = string(randStringTypes(r))
@@ -8800,30 +9259,30 @@ func NewPopulatedResponseDeliverTx(r randyTypes, easy bool) *ResponseDeliverTx {
 func NewPopulatedResponseEndBlock(r randyTypes, easy bool) *ResponseEndBlock {
 	this := &ResponseEndBlock{}
 	if r.Intn(5) != 0 {
-		v26 := r.Intn(5)
-		this.ValidatorUpdates = make([]ValidatorUpdate, v26)
-		for i := 0; i < v26; i++ {
-			v27 := NewPopulatedValidatorUpdate(r, easy)
-			this.ValidatorUpdates[i] = *v27
+		v27 := r.Intn(5)
+		this.ValidatorUpdates = make([]ValidatorUpdate, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedValidatorUpdate(r, easy)
+			this.ValidatorUpdates[i] = *v28
 		}
 	}
 	if r.Intn(5) != 0 {
 		this.ConsensusParamUpdates = NewPopulatedConsensusParams(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v28 := r.Intn(5)
-		this.Events = make([]Event, v28)
-		for i := 0; i < v28; i++ {
-			v29 := NewPopulatedEvent(r, easy)
-			this.Events[i] = *v29
+		v29 := r.Intn(5)
+		this.Events = make([]Event, v29)
+		for i := 0; i < v29; i++ {
+			v30 := NewPopulatedEvent(r, easy)
+			this.Events[i] = *v30
 		}
 	}
 	if r.Intn(5) != 0 {
-		v30 := r.Intn(5)
-		this.DkgValidatorUpdates = make([]ValidatorUpdate, v30)
-		for i := 0; i < v30; i++ {
-			v31 := NewPopulatedValidatorUpdate(r, easy)
-			this.DkgValidatorUpdates[i] = *v31
+		v31 := r.Intn(5)
+		this.DkgValidatorUpdates = make([]ValidatorUpdate, v31)
+		for i := 0; i < v31; i++ {
+			v32 := NewPopulatedValidatorUpdate(r, easy)
+			this.DkgValidatorUpdates[i] = *v32
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8834,9 +9293,9 @@ func NewPopulatedResponseEndBlock(r randyTypes, easy bool) *ResponseEndBlock {
 
 func NewPopulatedResponseCommit(r randyTypes, easy bool) *ResponseCommit {
 	this := &ResponseCommit{}
-	v32 := r.Intn(100)
-	this.Data = make([]byte, v32)
-	for i := 0; i < v32; i++ {
+	v33 := r.Intn(100)
+	this.Data = make([]byte, v33)
+	for i := 0; i < v33; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8887,8 +9346,8 @@ func NewPopulatedEvidenceParams(r randyTypes, easy bool) *EvidenceParams {
 	if r.Intn(2) == 0 {
 		this.MaxAgeNumBlocks *= -1
 	}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.MaxAgeDuration = *v33
+	v34 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MaxAgeDuration = *v34
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
@@ -8897,9 +9356,9 @@ func NewPopulatedEvidenceParams(r randyTypes, easy bool) *EvidenceParams {
 
 func NewPopulatedValidatorParams(r randyTypes, easy bool) *ValidatorParams {
 	this := &ValidatorParams{}
-	v34 := r.Intn(10)
-	this.PubKeyTypes = make([]string, v34)
-	for i := 0; i < v34; i++ {
+	v35 := r.Intn(10)
+	this.PubKeyTypes = make([]string, v35)
+	for i := 0; i < v35; i++ {
 		this.PubKeyTypes[i] = string(randStringTypes(r))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8914,8 +9373,27 @@ func NewPopulatedEntropyParams(r randyTypes, easy bool) *EntropyParams {
 	if r.Intn(2) == 0 {
 		this.AeonLength *= -1
 	}
+	this.DkgStateDuration = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DkgStateDuration *= -1
+	}
+	this.DkgIterationDurationIncrease = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DkgIterationDurationIncrease *= -1
+	}
+	this.DkgMaxStateDuration = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DkgMaxStateDuration *= -1
+	}
+	this.DkgResetDelay = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DkgResetDelay *= -1
+	}
+	this.DkgReshare = bool(bool(r.Intn(2) == 0))
+	this.KeyType = string(randStringTypes(r))
+	this.ProposerSampling = string(randStringTypes(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 9)
 	}
 	return this
 }
@@ -8927,11 +9405,11 @@ func NewPopulatedLastCommitInfo(r randyTypes, easy bool) *LastCommitInfo {
 		this.Round *= -1
 	}
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(5)
-		this.Votes = make([]VoteInfo, v35)
-		for i := 0; i < v35; i++ {
-			v36 := NewPopulatedVoteInfo(r, easy)
-			this.Votes[i] = *v36
+		v36 := r.Intn(5)
+		this.Votes = make([]VoteInfo, v36)
+		for i := 0; i < v36; i++ {
+			v37 := NewPopulatedVoteInfo(r, easy)
+			this.Votes[i] = *v37
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8944,11 +9422,11 @@ func NewPopulatedEvent(r randyTypes, easy bool) *Event {
 	this := &Event{}
 	this.Type = string(randStringTypes(r))
 	if r.Intn(5) != 0 {
-		v37 := r.Intn(5)
-		this.Attributes = make([]kv.Pair, v37)
-		for i := 0; i < v37; i++ {
-			v38 := kv.NewPopulatedPair(r, easy)
-			this.Attributes[i] = *v38
+		v38 := r.Intn(5)
+		this.Attributes = make([]kv.Pair, v38)
+		for i := 0; i < v38; i++ {
+			v39 := kv.NewPopulatedPair(r, easy)
+			this.Attributes[i] = *v39
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -8959,64 +9437,64 @@ func NewPopulatedEvent(r randyTypes, easy bool) *Event {
 
 func NewPopulatedHeader(r randyTypes, easy bool) *Header {
 	this := &Header{}
-	v39 := NewPopulatedVersion(r, easy)
-	this.Version = *v39
+	v40 := NewPopulatedVersion(r, easy)
+	this.Version = *v40
 	this.ChainID = string(randStringTypes(r))
 	this.Height = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.Height *= -1
 	}
-	v40 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v40
-	v41 := NewPopulatedBlockID(r, easy)
-	this.LastBlockId = *v41
-	v42 := r.Intn(100)
-	this.LastCommitHash = make([]byte, v42)
-	for i := 0; i < v42; i++ {
-		this.LastCommitHash[i] = byte(r.Intn(256))
-	}
+	v41 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v41
+	v42 := NewPopulatedBlockID(r, easy)
+	this.LastBlockId = *v42
 	v43 := r.Intn(100)
-	this.DataHash = make([]byte, v43)
+	this.LastCommitHash = make([]byte, v43)
 	for i := 0; i < v43; i++ {
-		this.DataHash[i] = byte(r.Intn(256))
+		this.LastCommitHash[i] = byte(r.Intn(256))
 	}
 	v44 := r.Intn(100)
-	this.ValidatorsHash = make([]byte, v44)
+	this.DataHash = make([]byte, v44)
 	for i := 0; i < v44; i++ {
-		this.ValidatorsHash[i] = byte(r.Intn(256))
+		this.DataHash[i] = byte(r.Intn(256))
 	}
 	v45 := r.Intn(100)
-	this.NextValidatorsHash = make([]byte, v45)
+	this.ValidatorsHash = make([]byte, v45)
 	for i := 0; i < v45; i++ {
-		this.NextValidatorsHash[i] = byte(r.Intn(256))
+		this.ValidatorsHash[i] = byte(r.Intn(256))
 	}
 	v46 := r.Intn(100)
-	this.ConsensusHash = make([]byte, v46)
+	this.NextValidatorsHash = make([]byte, v46)
 	for i := 0; i < v46; i++ {
-		this.ConsensusHash[i] = byte(r.Intn(256))
+		this.NextValidatorsHash[i] = byte(r.Intn(256))
 	}
 	v47 := r.Intn(100)
-	this.AppHash = make([]byte, v47)
+	this.ConsensusHash = make([]byte, v47)
 	for i := 0; i < v47; i++ {
-		this.AppHash[i] = byte(r.Intn(256))
+		this.ConsensusHash[i] = byte(r.Intn(256))
 	}
 	v48 := r.Intn(100)
-	this.LastResultsHash = make([]byte, v48)
+	this.AppHash = make([]byte, v48)
 	for i := 0; i < v48; i++ {
-		this.LastResultsHash[i] = byte(r.Intn(256))
+		this.AppHash[i] = byte(r.Intn(256))
 	}
 	v49 := r.Intn(100)
-	this.EvidenceHash = make([]byte, v49)
+	this.LastResultsHash = make([]byte, v49)
 	for i := 0; i < v49; i++ {
-		this.EvidenceHash[i] = byte(r.Intn(256))
+		this.LastResultsHash[i] = byte(r.Intn(256))
 	}
 	v50 := r.Intn(100)
-	this.ProposerAddress = make([]byte, v50)
+	this.EvidenceHash = make([]byte, v50)
 	for i := 0; i < v50; i++ {
+		this.EvidenceHash[i] = byte(r.Intn(256))
+	}
+	v51 := r.Intn(100)
+	this.ProposerAddress = make([]byte, v51)
+	for i := 0; i < v51; i++ {
 		this.ProposerAddress[i] = byte(r.Intn(256))
 	}
-	v51 := NewPopulatedBlockEntropy(r, easy)
-	this.Entropy = *v51
+	v52 := NewPopulatedBlockEntropy(r, easy)
+	this.Entropy = *v52
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedTypes(r, 16)
 	}
@@ -9035,13 +9513,13 @@ func NewPopulatedVersion(r randyTypes, easy bool) *Version {
 
 func NewPopulatedBlockID(r randyTypes, easy bool) *BlockID {
 	this := &BlockID{}
-	v52 := r.Intn(100)
-	this.Hash = make([]byte, v52)
-	for i := 0; i < v52; i++ {
+	v53 := r.Intn(100)
+	this.Hash = make([]byte, v53)
+	for i := 0; i < v53; i++ {
 		this.Hash[i] = byte(r.Intn(256))
 	}
-	v53 := NewPopulatedPartSetHeader(r, easy)
-	this.PartsHeader = *v53
+	v54 := NewPopulatedPartSetHeader(r, easy)
+	this.PartsHeader = *v54
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
@@ -9050,9 +9528,9 @@ func NewPopulatedBlockID(r randyTypes, easy bool) *BlockID {
 
 func NewPopulatedBlockEntropy(r randyTypes, easy bool) *BlockEntropy {
 	this := &BlockEntropy{}
-	v54 := r.Intn(100)
-	this.GroupSignature = make([]byte, v54)
-	for i := 0; i < v54; i++ {
+	v55 := r.Intn(100)
+	this.GroupSignature = make([]byte, v55)
+	for i := 0; i < v55; i++ {
 		this.GroupSignature[i] = byte(r.Intn(256))
 	}
 	this.Round = int64(r.Int63())
@@ -9079,9 +9557,9 @@ func NewPopulatedPartSetHeader(r randyTypes, easy bool) *PartSetHeader {
 	if r.Intn(2) == 0 {
 		this.Total *= -1
 	}
-	v55 := r.Intn(100)
-	this.Hash = make([]byte, v55)
-	for i := 0; i < v55; i++ {
+	v56 := r.Intn(100)
+	this.Hash = make([]byte, v56)
+	for i := 0; i < v56; i++ {
 		this.Hash[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -9092,9 +9570,9 @@ func NewPopulatedPartSetHeader(r randyTypes, easy bool) *PartSetHeader {
 
 func NewPopulatedValidator(r randyTypes, easy bool) *Validator {
 	this := &Validator{}
-	v56 := r.Intn(100)
-	this.Address = make([]byte, v56)
-	for i := 0; i < v56; i++ {
+	v57 := r.Intn(100)
+	this.Address = make([]byte, v57)
+	for i := 0; i < v57; i++ {
 		this.Address[i] = byte(r.Intn(256))
 	}
 	this.Power = int64(r.Int63())
@@ -9109,8 +9587,8 @@ func NewPopulatedValidator(r randyTypes, easy bool) *Validator {
 
 func NewPopulatedValidatorUpdate(r randyTypes, easy bool) *ValidatorUpdate {
 	this := &ValidatorUpdate{}
-	v57 := NewPopulatedPubKey(r, easy)
-	this.PubKey = *v57
+	v58 := NewPopulatedPubKey(r, easy)
+	this.PubKey = *v58
 	this.Power = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.Power *= -1
@@ -9123,8 +9601,8 @@ func NewPopulatedValidatorUpdate(r randyTypes, easy bool) *ValidatorUpdate {
 
 func NewPopulatedVoteInfo(r randyTypes, easy bool) *VoteInfo {
 	this := &VoteInfo{}
-	v58 := NewPopulatedValidator(r, easy)
-	this.Validator = *v58
+	v59 := NewPopulatedValidator(r, easy)
+	this.Validator = *v59
 	this.SignedLastBlock = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
@@ -9135,9 +9613,9 @@ func NewPopulatedVoteInfo(r randyTypes, easy bool) *VoteInfo {
 func NewPopulatedPubKey(r randyTypes, easy bool) *PubKey {
 	this := &PubKey{}
 	this.Type = string(randStringTypes(r))
-	v59 := r.Intn(100)
-	this.Data = make([]byte, v59)
-	for i := 0; i < v59; i++ {
+	v60 := r.Intn(100)
+	this.Data = make([]byte, v60)
+	for i := 0; i < v60; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -9149,14 +9627,14 @@ func NewPopulatedPubKey(r randyTypes, easy bool) *PubKey {
 func NewPopulatedEvidence(r randyTypes, easy bool) *Evidence {
 	this := &Evidence{}
 	this.Type = string(randStringTypes(r))
-	v60 := NewPopulatedValidator(r, easy)
-	this.Validator = *v60
+	v61 := NewPopulatedValidator(r, easy)
+	this.Validator = *v61
 	this.Height = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.Height *= -1
 	}
-	v61 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v61
+	v62 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v62
 	this.TotalVotingPower = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.TotalVotingPower *= -1
@@ -9167,6 +9645,37 @@ func NewPopulatedEvidence(r randyTypes, easy bool) *Evidence {
 	return this
 }
 
+func NewPopulatedRequestEntropy(r randyTypes, easy bool) *RequestEntropy {
+	this := &RequestEntropy{}
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedResponseEntropy(r randyTypes, easy bool) *ResponseEntropy {
+	this := &ResponseEntropy{}
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	v63 := NewPopulatedBlockEntropy(r, easy)
+	this.Entropy = *v63
+	this.TrivialEntropy = bool(bool(r.Intn(2) == 0))
+	this.PreviousEntropyHeight = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.PreviousEntropyHeight *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
+	}
+	return this
+}
+
 type randyTypes interface {
 	Float32() float32
 	Float64() float64
@@ -9186,9 +9695,9 @@ func randUTF8RuneTypes(r randyTypes) rune {
 	return rune(ru + 61)
 }
 func randStringTypes(r randyTypes) string {
-	v62 := r.Intn(100)
-	tmps := make([]rune, v62)
-	for i := 0; i < v62; i++ {
+	v64 := r.Intn(100)
+	tmps := make([]rune, v64)
+	for i := 0; i < v64; i++ {
 		tmps[i] = randUTF8RuneTypes(r)
 	}
 	return string(tmps)
@@ -9210,11 +9719,11 @@ func randFieldTypes(dAtA []byte, r randyTypes, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
-		v63 := r.Int63()
+		v65 := r.Int63()
 		if r.Intn(2) == 0 {
-			v63 *= -1
+			v65 *= -1
 		}
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(v63))
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(v65))
 	case 1:
 		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -9534,6 +10043,11 @@ func (m *RequestBeginBlock) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = m.Entropy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TrivialEntropy {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10172,6 +10686,29 @@ func (m *EntropyParams) Size() (n int) {
 	if m.AeonLength != 0 {
 		n += 1 + sovTypes(uint64(m.AeonLength))
 	}
+	if m.DkgStateDuration != 0 {
+		n += 1 + sovTypes(uint64(m.DkgStateDuration))
+	}
+	if m.DkgIterationDurationIncrease != 0 {
+		n += 1 + sovTypes(uint64(m.DkgIterationDurationIncrease))
+	}
+	if m.DkgMaxStateDuration != 0 {
+		n += 1 + sovTypes(uint64(m.DkgMaxStateDuration))
+	}
+	if m.DkgResetDelay != 0 {
+		n += 1 + sovTypes(uint64(m.DkgResetDelay))
+	}
+	if m.DkgReshare {
+		n += 2
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProposerSampling)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10463,6 +11000,44 @@ func (m *Evidence) Size() (n int) {
 	return n
 }
 
+func (m *RequestEntropy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseEntropy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.Entropy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TrivialEntropy {
+		n += 2
+	}
+	if m.PreviousEntropyHeight != 0 {
+		n += 1 + sovTypes(uint64(m.PreviousEntropyHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -11835,41 +12410,94 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
+			if err := m.Entropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrivialEntropy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrivialEntropy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -15111,6 +15739,166 @@ func (m *EntropyParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgStateDuration", wireType)
+			}
+			m.DkgStateDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgStateDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgIterationDurationIncrease", wireType)
+			}
+			m.DkgIterationDurationIncrease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgIterationDurationIncrease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgMaxStateDuration", wireType)
+			}
+			m.DkgMaxStateDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgMaxStateDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgResetDelay", wireType)
+			}
+			m.DkgResetDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgResetDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgReshare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DkgReshare = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSampling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSampling = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -17001,6 +17789,224 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestEntropy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestEntropy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestEntropy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseEntropy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseEntropy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseEntropy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrivialEntropy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrivialEntropy = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEntropyHeight", wireType)
+			}
+			m.PreviousEntropyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEntropyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
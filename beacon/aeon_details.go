@@ -2,10 +2,8 @@ package beacon
 
 import (
 	"fmt"
-	"io/ioutil"
 
-	tmos "github.com/tendermint/tendermint/libs/os"
-	"github.com/tendermint/tendermint/libs/tempfile"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -20,6 +18,31 @@ func newAeonExecUnit(keyType string, generator string, keys DKGKeyInformation, q
 	}
 }
 
+// aeonExecUnitFromOutput creates the aeon execution unit for the public dkg output and private key. An empty
+// private key gives an execution unit which can only verify signatures
+func aeonExecUnitFromOutput(output *types.DKGOutput, privateKey string) BaseAeon {
+	keys := NewDKGKeyInformation()
+	keys.SetGroup_public_key(output.GroupPublicKey)
+	keys.SetPrivate_key(privateKey)
+	keyShares := NewStringVector()
+	for i := 0; i < len(output.PublicKeyShares); i++ {
+		keyShares.Add(output.PublicKeyShares[i])
+	}
+	keys.SetPublic_key_shares(keyShares)
+	qual := NewIntVector()
+	for i := 0; i < len(output.Qual); i++ {
+		qual.Add(output.Qual[i])
+	}
+
+	keyType := output.KeyType
+	if len(keyType) == 0 {
+		// If no key type in file, attempt to use the default type specified in beacon_setup_service.hpp
+		keyType = GetAeonType()
+	}
+
+	return newAeonExecUnit(keyType, output.Generator, keys, qual)
+}
+
 // aeonDetails stores entropy generation details for each aeon
 type aeonDetails struct {
 	privValidator   types.PrivValidator
@@ -38,26 +61,7 @@ func LoadAeonDetails(aeonDetailsFile *AeonDetailsFile, validators *types.Validat
 		return keylessAeonDetails(aeonDetailsFile.PublicInfo.Start, aeonDetailsFile.PublicInfo.End)
 	}
 
-	keys := NewDKGKeyInformation()
-	keys.SetGroup_public_key(aeonDetailsFile.PublicInfo.GroupPublicKey)
-	keys.SetPrivate_key(aeonDetailsFile.PrivateKey)
-	keyShares := NewStringVector()
-	for i := 0; i < len(aeonDetailsFile.PublicInfo.PublicKeyShares); i++ {
-		keyShares.Add(aeonDetailsFile.PublicInfo.PublicKeyShares[i])
-	}
-	keys.SetPublic_key_shares(keyShares)
-	qual := NewIntVector()
-	for i := 0; i < len(aeonDetailsFile.PublicInfo.Qual); i++ {
-		qual.Add(aeonDetailsFile.PublicInfo.Qual[i])
-	}
-
-	keyType := aeonDetailsFile.PublicInfo.KeyType
-	if len(keyType) == 0 {
-		// If no key type in file, attempt to use the default type specified in beacon_setup_service.hpp
-		keyType = GetAeonType()
-	}
-
-	aeonExecUnit := newAeonExecUnit(keyType, aeonDetailsFile.PublicInfo.Generator, keys, qual)
+	aeonExecUnit := aeonExecUnitFromOutput(&aeonDetailsFile.PublicInfo, aeonDetailsFile.PrivateKey)
 	aeonDetails, _ := newAeonDetails(privVal, aeonDetailsFile.PublicInfo.ValidatorHeight, validators, aeonExecUnit,
 		aeonDetailsFile.PublicInfo.Start, aeonDetailsFile.PublicInfo.End)
 	return aeonDetails
@@ -116,40 +120,55 @@ func keylessAeonDetails(aeonStart int64, aeonEnd int64) *aeonDetails {
 	}
 }
 
-func (aeon *aeonDetails) dkgOutput() *DKGOutput {
+func (aeon *aeonDetails) dkgOutput() *types.DKGOutput {
 	if aeon.aeonExecUnit == nil {
-		return &DKGOutput{
+		return &types.DKGOutput{
 			Start: aeon.Start,
 			End:   aeon.End,
 		}
 	}
-	output := DKGOutput{
-		KeyType:         aeon.aeonExecUnit.Name(),
-		GroupPublicKey:  aeon.aeonExecUnit.GroupPublicKey(),
-		Generator:       aeon.aeonExecUnit.Generator(),
-		PublicKeyShares: make([]string, len(aeon.validators.Validators)),
+	output := types.DKGOutput{
 		ValidatorHeight: aeon.validatorHeight,
-		Qual:            make([]uint, len(aeon.validators.Validators)),
 		Start:           aeon.Start,
 		End:             aeon.End,
 	}
-	publicKeyShares := aeon.aeonExecUnit.PublicKeyShares()
+	setOutputKeys(&output, aeon.aeonExecUnit, len(aeon.validators.Validators))
+	return &output
+}
+
+// setOutputKeys sets the public keys of output to those of aeonExecUnit, for numValidators
+func setOutputKeys(output *types.DKGOutput, aeonExecUnit BaseAeon, numValidators int) {
+	output.KeyType = aeonExecUnit.Name()
+	output.GroupPublicKey = aeonExecUnit.GroupPublicKey()
+	output.Generator = aeonExecUnit.Generator()
+	output.PublicKeyShares = make([]string, numValidators)
+	output.Qual = make([]uint, numValidators)
+	publicKeyShares := aeonExecUnit.PublicKeyShares()
 	for i := 0; i < int(publicKeyShares.Size()); i++ {
 		output.PublicKeyShares[i] = publicKeyShares.Get(i)
 	}
-	qual := aeon.aeonExecUnit.Qual()
+	qual := aeonExecUnit.Qual()
 	for i := 0; i < int(qual.Size()); i++ {
 		output.Qual[i] = qual.Get(i)
 	}
-	return &output
 }
 
 func (aeon *aeonDetails) IsKeyless() bool {
 	return aeon.aeonExecUnit == nil
 }
 
-// Save a number of aeonDetails to a file
-func saveAeons(filePath string, aeons ...*aeonDetails) {
+// Save a number of aeonDetails to a file, encrypted with keyEncryptionKey if not empty
+func saveAeons(filePath string, keyEncryptionKey []byte, aeons ...*aeonDetails) {
+	saveAeonQueue(filePath, keyEncryptionKey, aeonDetailsFiles(filePath, true, aeons))
+}
+
+// Save a number of aeonDetails to a file without their private keys, encrypted with
+// keyEncryptionKey if not empty
+func savePublicAeons(filePath string, keyEncryptionKey []byte, aeons ...*aeonDetails) {
+	saveAeonQueue(filePath, keyEncryptionKey, aeonDetailsFiles(filePath, false, aeons))
+}
+
+func aeonDetailsFiles(filePath string, withPrivateKeys bool, aeons []*aeonDetails) []*AeonDetailsFile {
 
 	var aeonQueue []*AeonDetailsFile
 
@@ -162,20 +181,20 @@ func saveAeons(filePath string, aeons ...*aeonDetails) {
 		aeonFile := AeonDetailsFile{
 			PublicInfo: *aeon.dkgOutput(),
 		}
-		if aeon.aeonExecUnit != nil {
+		if aeon.aeonExecUnit != nil && withPrivateKeys {
 			aeonFile.PrivateKey = aeon.aeonExecUnit.PrivateKey()
 		}
 
 		aeonQueue = append(aeonQueue, &aeonFile)
 	}
 
-	saveAeonQueue(filePath, aeonQueue)
+	return aeonQueue
 }
 
 // AeonDetailsFile is struct for saving aeon keys to file
 type AeonDetailsFile struct {
-	PublicInfo DKGOutput `json:"public_info"`
-	PrivateKey string    `json:"private_key"`
+	PublicInfo types.DKGOutput `json:"public_info"`
+	PrivateKey string          `json:"private_key"`
 }
 
 func (aeonFile *AeonDetailsFile) IsForSamePeriod(other *AeonDetailsFile) bool {
@@ -186,29 +205,35 @@ func (aeonFile *AeonDetailsFile) IsForSamePeriod(other *AeonDetailsFile) bool {
 	return false
 }
 
+// DKGID returns the id of the dkg which generated the aeon
+func (aeonFile *AeonDetailsFile) DKGID() int64 {
+	return dkgID(aeonFile.PublicInfo.ValidatorHeight)
+}
+
 // Save creates json with aeon details
-func saveAeonQueue(outFile string, aeonFiles []*AeonDetailsFile) {
+func saveAeonQueue(outFile string, keyEncryptionKey []byte, aeonFiles []*AeonDetailsFile) {
 	jsonBytes, err := cdc.MarshalJSONIndent(aeonFiles, "", "  ")
 	if err != nil {
 		panic(err)
 	}
-	err = tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
+	err = keyfile.WriteFile(outFile, jsonBytes, keyEncryptionKey)
 	if err != nil {
 		panic(err)
 	}
 }
 
-// LoadAeonDetailsFile creates a queue of AeonDetailsFiles from json
-func LoadAeonDetailsFiles(filePath string) ([]*AeonDetailsFile, error) {
-	jsonBytes, err := ioutil.ReadFile(filePath)
+// LoadAeonDetailsFile creates a queue of AeonDetailsFiles from json, decrypting the file with
+// keyEncryptionKey if it is encrypted
+func LoadAeonDetailsFiles(filePath string, keyEncryptionKey []byte) ([]*AeonDetailsFile, error) {
+	jsonBytes, err := keyfile.ReadFile(filePath, keyEncryptionKey)
 	if err != nil {
-		tmos.Exit(err.Error())
+		return nil, err
 	}
 	var aeonQueue []*AeonDetailsFile
 
 	err = cdc.UnmarshalJSON(jsonBytes, &aeonQueue)
 	if err != nil {
-		tmos.Exit(fmt.Sprintf("Error reading AeonDetailsFiles from %v: %v\n", filePath, err))
+		return nil, fmt.Errorf("error reading AeonDetailsFiles from %v: %v", filePath, err)
 	}
 
 	for _, aeon := range aeonQueue {
@@ -230,34 +255,3 @@ func (aeonFile *AeonDetailsFile) ValidateBasic() error {
 	}
 	return nil
 }
-
-// DKGOutput is struct for broadcasting dkg completion info
-type DKGOutput struct {
-	KeyType         string   `json:"key_type"`
-	GroupPublicKey  string   `json:"group_public_key"`
-	PublicKeyShares []string `json:"public_key_shares"`
-	Generator       string   `json:"generator"`
-	ValidatorHeight int64    `json:"validator_height"`
-	Qual            []uint   `json:"qual"`
-	Start           int64    `json:"start"`
-	End             int64    `json:"end"`
-}
-
-// ValidateBasic for basic validity checking of dkg output
-func (output *DKGOutput) ValidateBasic() error {
-	if len(output.GroupPublicKey) != 0 {
-		if len(output.Generator) == 0 {
-			return fmt.Errorf("Empty generator")
-		}
-		if output.ValidatorHeight <= 0 {
-			return fmt.Errorf("Invalid validator height %v", output.ValidatorHeight)
-		}
-		if len(output.Qual) == 0 || len(output.Qual) != len(output.PublicKeyShares) {
-			return fmt.Errorf("Mismatch in qual size %v and public key shares %v", len(output.Qual), len(output.PublicKeyShares))
-		}
-	}
-	if output.Start <= 0 || output.End < output.Start {
-		return fmt.Errorf("Invalid start %v or end %v", output.Start, output.End)
-	}
-	return nil
-}
@@ -1,10 +1,14 @@
 package beacon
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -77,9 +81,9 @@ func TestAeonDetailsSaveLoad(t *testing.T) {
 	aeonKeys := testAeonFromFile("test_keys/validator_0_of_4.txt")
 	newAeon, _ := newAeonDetails(privVals[0], 1, state.Validators, aeonKeys, 1, 10)
 
-	saveAeons(config.EntropyKeyFile(), newAeon)
+	saveAeons(config.EntropyKeyFile(), nil, newAeon)
 
-	aeonDetailsFiles, err := LoadAeonDetailsFiles(config.EntropyKeyFile())
+	aeonDetailsFiles, err := LoadAeonDetailsFiles(config.EntropyKeyFile(), nil)
 	assert.Equal(t, nil, err)
 	duplicateAeon := LoadAeonDetails(aeonDetailsFiles[0], state.Validators, privVals[0])
 	assert.Equal(t, newAeon.validatorHeight, duplicateAeon.validatorHeight)
@@ -99,11 +103,38 @@ func TestAeonDetailsNoKeys(t *testing.T) {
 
 	newAeon := keylessAeonDetails(1, 10)
 	assert.True(t, newAeon.aeonExecUnit == nil)
-	saveAeons(config.EntropyKeyFile(), newAeon)
+	saveAeons(config.EntropyKeyFile(), nil, newAeon)
 
-	keyFiles, err := LoadAeonDetailsFiles(config.BaseConfig.EntropyKeyFile())
+	keyFiles, err := LoadAeonDetailsFiles(config.BaseConfig.EntropyKeyFile(), nil)
 	assert.Equal(t, nil, err)
 	assert.NotPanics(t, func() {
 		LoadAeonDetails(keyFiles[0], nil, nil)
 	})
 }
+
+func TestAeonDetailsSaveLoadEncrypted(t *testing.T) {
+	config := cfg.ResetTestRoot("encrypted_aeon_details_test")
+
+	state, privVals := groupTestSetup(4)
+	aeonKeys := testAeonFromFile("test_keys/validator_0_of_4.txt")
+	newAeon, _ := newAeonDetails(privVals[0], 1, state.Validators, aeonKeys, 1, 10)
+
+	keyEncryptionKey := []byte("key-encryption-key")
+	saveAeons(config.EntropyKeyFile(), keyEncryptionKey, newAeon)
+
+	// Private key is not written in plaintext
+	fileBytes, err := ioutil.ReadFile(config.EntropyKeyFile())
+	require.NoError(t, err)
+	assert.True(t, keyfile.IsEncrypted(fileBytes))
+	assert.False(t, strings.Contains(string(fileBytes), aeonKeys.PrivateKey()))
+
+	_, err = LoadAeonDetailsFiles(config.EntropyKeyFile(), nil)
+	assert.Equal(t, keyfile.ErrNoKeyEncryptionKey, err)
+	_, err = LoadAeonDetailsFiles(config.EntropyKeyFile(), []byte("wrong-key"))
+	assert.Error(t, err)
+
+	aeonDetailsFiles, err := LoadAeonDetailsFiles(config.EntropyKeyFile(), keyEncryptionKey)
+	require.NoError(t, err)
+	duplicateAeon := LoadAeonDetails(aeonDetailsFiles[0], state.Validators, privVals[0])
+	assert.Equal(t, newAeon.aeonExecUnit.PrivateKey(), duplicateAeon.aeonExecUnit.PrivateKey())
+}
@@ -0,0 +1,164 @@
+package beacon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// AeonKeySigner implements types.DKGSigner with aeon key shares held in memory. It is intended
+// to be served by a remote signer, so that nodes do not load these keys, nor the shares from
+// which they are computed
+type AeonKeySigner struct {
+	mtx   sync.Mutex
+	aeons map[int64]*aeonKeyShare // keyed by dkg id
+	dkg   *localDKGService        // latest dkg iteration run for the node
+
+	keyFile          string // aeons computed by dkgs are saved to this file, if set
+	keyEncryptionKey []byte
+}
+
+type aeonKeyShare struct {
+	aeonFile     *AeonDetailsFile
+	aeonExecUnit BaseAeon
+	index        uint
+}
+
+var _ types.DKGSigner = (*AeonKeySigner)(nil)
+
+// NewAeonKeySigner returns a new AeonKeySigner without any aeons
+func NewAeonKeySigner() *AeonKeySigner {
+	return &AeonKeySigner{
+		aeons: make(map[int64]*aeonKeyShare),
+	}
+}
+
+// AddAeon adds the key share of an aeon loaded from file. Returns an error if the aeon has no
+// private key or the private key does not match any of its public key shares
+func (signer *AeonKeySigner) AddAeon(aeonFile *AeonDetailsFile) error {
+	if err := aeonFile.ValidateBasic(); err != nil {
+		return err
+	}
+	if aeonFile.PublicInfo.IsKeyless() || len(aeonFile.PrivateKey) == 0 {
+		return fmt.Errorf("aeon for dkg %v has no private key", aeonFile.DKGID())
+	}
+
+	aeonExecUnit := aeonExecUnitFromOutput(&aeonFile.PublicInfo, aeonFile.PrivateKey)
+	index := 0
+	for index < len(aeonFile.PublicInfo.PublicKeyShares) && !aeonExecUnit.CheckIndex(uint(index)) {
+		index++
+	}
+	if index == len(aeonFile.PublicInfo.PublicKeyShares) {
+		return fmt.Errorf("private key of aeon for dkg %v does not match its public key shares", aeonFile.DKGID())
+	}
+
+	signer.mtx.Lock()
+	defer signer.mtx.Unlock()
+
+	signer.aeons[aeonFile.DKGID()] = &aeonKeyShare{aeonFile: aeonFile, aeonExecUnit: aeonExecUnit, index: uint(index)}
+	return nil
+}
+
+// SetKeyFile sets the file to which the aeons held are saved when a dkg computes new keys,
+// encrypted with keyEncryptionKey if not empty
+func (signer *AeonKeySigner) SetKeyFile(filePath string, keyEncryptionKey []byte) {
+	signer.mtx.Lock()
+	defer signer.mtx.Unlock()
+
+	signer.keyFile = filePath
+	signer.keyEncryptionKey = keyEncryptionKey
+}
+
+// SignAeonShare computes the signature share of message with the key share of the aeon generated by
+// the dkg with dkgID
+func (signer *AeonKeySigner) SignAeonShare(dkgID int64, message string) (string, error) {
+	signer.mtx.Lock()
+	defer signer.mtx.Unlock()
+
+	aeon, ok := signer.aeons[dkgID]
+	if !ok {
+		return "", fmt.Errorf("no key share for aeon of dkg %v", dkgID)
+	}
+	return aeon.aeonExecUnit.Sign(message, aeon.index), nil
+}
+
+// DKGStep runs step of the latest dkg iteration started by the node. The key share computed by
+// the dkg is kept to sign aeon shares, and only its public keys are returned.
+func (signer *AeonKeySigner) DKGStep(chainID string, step *types.DKGStep) (*types.DKGStepResult, error) {
+	signer.mtx.Lock()
+	defer signer.mtx.Unlock()
+
+	if step.Type == types.DKGStepStart {
+		return signer.startDKG(chainID, step)
+	}
+	dkg := signer.dkg
+	if dkg == nil || dkg.chainID != chainID || dkg.dkgID != step.DKGID || dkg.iteration != step.Iteration {
+		return nil, fmt.Errorf("dkg %v iteration %v not started", step.DKGID, step.Iteration)
+	}
+	if step.Type == types.DKGStepComputeKeys {
+		return signer.computeKeys(step)
+	}
+	return dkg.runStep(step)
+}
+
+// startDKG starts a new dkg iteration, replacing the previous one. Starting the current iteration
+// again, after the node restarts, keeps its state
+func (signer *AeonKeySigner) startDKG(chainID string, step *types.DKGStep) (*types.DKGStepResult, error) {
+	if dkg := signer.dkg; dkg != nil && dkg.chainID == chainID {
+		if dkg.dkgID == step.DKGID && dkg.iteration == step.Iteration {
+			return &types.DKGStepResult{EncryptionKey: dkg.EncryptionKey()}, nil
+		}
+		if step.DKGID < dkg.dkgID || (step.DKGID == dkg.dkgID && step.Iteration < dkg.iteration) {
+			return nil, fmt.Errorf("dkg %v iteration %v is older than dkg %v iteration %v", step.DKGID,
+				step.Iteration, dkg.dkgID, dkg.iteration)
+		}
+	}
+	if step.Index >= step.CabinetSize || step.Threshold == 0 || step.Threshold > step.CabinetSize {
+		return nil, fmt.Errorf("invalid dkg parameters")
+	}
+	if step.KeyType != GetBLS_AEON() && step.KeyType != GetGLOW_AEON() {
+		return nil, fmt.Errorf("unknown key type %v", step.KeyType)
+	}
+
+	if signer.dkg != nil {
+		signer.dkg.Delete()
+	}
+	signer.dkg = newLocalDKGService(chainID, step.DKGID, step.Iteration, step.CabinetSize, step.Threshold,
+		step.Index, step.KeyType)
+	return &types.DKGStepResult{EncryptionKey: signer.dkg.EncryptionKey()}, nil
+}
+
+// computeKeys computes the keys of the aeon with the heights of the step output. The key share
+// is added to the aeons held if this node is qualified
+func (signer *AeonKeySigner) computeKeys(step *types.DKGStep) (*types.DKGStepResult, error) {
+	if step.Output == nil {
+		return nil, fmt.Errorf("no aeon heights")
+	}
+	aeonExecUnit := signer.dkg.ComputePublicKeys()
+	output := *step.Output
+	setOutputKeys(&output, aeonExecUnit, int(signer.dkg.cabinetSize))
+	if err := output.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if aeonExecUnit.CanSign() && aeonExecUnit.CheckIndex(signer.dkg.index) {
+		aeonFile := &AeonDetailsFile{PublicInfo: output, PrivateKey: aeonExecUnit.PrivateKey()}
+		signer.aeons[step.DKGID] = &aeonKeyShare{aeonFile: aeonFile, aeonExecUnit: aeonExecUnit, index: signer.dkg.index}
+		signer.saveAeons()
+	}
+	return &types.DKGStepResult{Output: &output}, nil
+}
+
+// saveAeons saves the aeons held to the key file, if set
+func (signer *AeonKeySigner) saveAeons() {
+	if len(signer.keyFile) == 0 {
+		return
+	}
+	aeonFiles := make([]*AeonDetailsFile, 0, len(signer.aeons))
+	for _, aeon := range signer.aeons {
+		aeonFiles = append(aeonFiles, aeon.aeonFile)
+	}
+	sort.Slice(aeonFiles, func(i, j int) bool { return aeonFiles[i].DKGID() < aeonFiles[j].DKGID() })
+	saveAeonQueue(signer.keyFile, signer.keyEncryptionKey, aeonFiles)
+}
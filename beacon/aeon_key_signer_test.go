@@ -0,0 +1,38 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAeonKeySignerSignAeonShare(t *testing.T) {
+	state, privVals := groupTestSetup(4)
+	aeonKeys := testAeonFromFile("test_keys/validator_0_of_4.txt")
+	for _, val := range privVals {
+		index, _ := state.Validators.GetByAddress(val.GetPubKey().Address())
+		if index != 0 {
+			continue
+		}
+		aeon, _ := newAeonDetails(val, 1, state.Validators, aeonKeys, 1, 10)
+		signer := NewAeonKeySigner()
+
+		// Keyless aeons can not be added
+		assert.Error(t, signer.AddAeon(&AeonDetailsFile{PublicInfo: *keylessAeonDetails(1, 10).dkgOutput()}))
+		// Nor can aeons without private key
+		assert.Error(t, signer.AddAeon(&AeonDetailsFile{PublicInfo: *aeon.dkgOutput()}))
+
+		require.NoError(t, signer.AddAeon(&AeonDetailsFile{PublicInfo: *aeon.dkgOutput(),
+			PrivateKey: aeonKeys.PrivateKey()}))
+		message := "message"
+		share, err := signer.SignAeonShare(dkgID(1), message)
+		require.NoError(t, err)
+		assert.Equal(t, aeonKeys.Sign(message, 0), share)
+		assert.True(t, aeonKeys.Verify(message, share, 0))
+
+		_, err = signer.SignAeonShare(dkgID(2), message)
+		assert.Error(t, err)
+		break
+	}
+}
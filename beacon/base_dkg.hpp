@@ -32,28 +32,20 @@ namespace fetch {
 namespace beacon {    
 
 /**
- * This class defines the functions required for the DKG
+ * This class defines the functions of the DKG used by the beacon setup service, independent of
+ * the group of the verification keys so that the key scheme can be chosen at run time
  */
-
-template<class CryptoVerificationKey>
-class BaseDkg {
+class DkgInterface {
 public:
-  using PrivateKey = mcl::PrivateKey;
-  using Signature = mcl::Signature;
-  using GroupPublicKey = mcl::GroupPublicKey;
-  using VerificationKey = CryptoVerificationKey;
-  using MessagePayload = std::string;
   using CabinetIndex     = uint32_t;
   using Share            = std::string;
   using Coefficient      = std::string;
   using ComplaintAnswer  = std::pair<CabinetIndex, std::pair<Share, Share>>;
   using ExposedShare     = std::pair<CabinetIndex, std::pair<Share, Share>>;
   using SharesExposedMap = std::unordered_map<CabinetIndex, std::pair<Share, Share>>;
+  using Polynomials      = std::unordered_map<CabinetIndex, std::pair<Share, Share>>;
 
-  virtual ~BaseDkg() = default;
-  virtual VerificationKey GetGroupG() const = 0;
-  virtual VerificationKey GetGroupH() const = 0;
-  virtual PrivateKey GetZeroFr() const = 0;
+  virtual ~DkgInterface() = default;
   virtual void NewCabinet(CabinetIndex cabinet_size, CabinetIndex threshold, CabinetIndex index) = 0;
   virtual void GenerateCoefficients() = 0;
   virtual std::vector<Coefficient> GetQualCoefficients() = 0;
@@ -63,8 +55,46 @@ public:
   virtual bool RunReconstruction() = 0;
   virtual void ComputePublicKeys() = 0;
   virtual std::shared_ptr<BaseAeon> GetDkgOutput() const = 0;
+
+  virtual std::vector<Coefficient> GetCoefficients() = 0;
+  virtual Polynomials GetPolynomials() const = 0;
+  virtual bool SetPolynomials(Polynomials const &polynomials) = 0;
+  virtual std::pair<Share, Share> GetOwnShares(CabinetIndex const &receiver_index) = 0;
+  virtual std::pair<Share, Share> GetReceivedShares(CabinetIndex const &owner) = 0;
+  virtual void AddShares(CabinetIndex const &from_index, std::pair<Share, Share> const &shares) = 0;
+  virtual void AddCoefficients(CabinetIndex const &from_index, std::vector<Coefficient> const &coefficients) = 0;
+  virtual std::set<CabinetIndex> ComputeComplaints(std::set<CabinetIndex> const &coeff_received) = 0;
+  virtual bool VerifyComplaintAnswer(CabinetIndex const &from_index, ComplaintAnswer const &answer) = 0;
+  virtual void SetQual(std::set<CabinetIndex> qual) = 0;
+  virtual void ComputeSecretShare() = 0;
+  virtual void AddReconstructionShare(CabinetIndex const &index) = 0;
+  virtual void VerifyReconstructionShare(CabinetIndex const &from, ExposedShare const &share) = 0;
+  virtual bool InQual(CabinetIndex const &index) const = 0;
+  virtual std::set<CabinetIndex> const &qual() const = 0;
+  virtual CabinetIndex cabinet_index() const = 0;
+  virtual CabinetIndex polynomial_degree() const = 0;
+  virtual CabinetIndex cabinet_size() const = 0;
+};
+
+/**
+ * This class defines the functions required for the DKG
+ */
+
+template<class CryptoVerificationKey>
+class BaseDkg : public DkgInterface {
+public:
+  using PrivateKey = mcl::PrivateKey;
+  using Signature = mcl::Signature;
+  using GroupPublicKey = mcl::GroupPublicKey;
+  using VerificationKey = CryptoVerificationKey;
+  using MessagePayload = std::string;
+
+  virtual ~BaseDkg() = default;
+  virtual VerificationKey GetGroupG() const = 0;
+  virtual VerificationKey GetGroupH() const = 0;
+  virtual PrivateKey GetZeroFr() const = 0;
   
-  std::vector<Coefficient> GetCoefficients()
+  std::vector<Coefficient> GetCoefficients() override
   {
     std::vector<Coefficient> coefficients;
     for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
@@ -74,27 +104,62 @@ public:
     return coefficients;
   }
 
-  std::pair<Share, Share> GetOwnShares(CabinetIndex const &receiver_index)
+  /// Coefficient k of the secret polynomials a_i and b_i of this member, mapped from k
+  Polynomials GetPolynomials() const override
+  {
+    Polynomials polynomials;
+    for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
+    {
+      polynomials.insert({k, {a_i_[k].ToString(), b_i_[k].ToString()}});
+    }
+    return polynomials;
+  }
+
+  /// Replaces the secret polynomials of this member, recomputing our coefficients and shares
+  bool SetPolynomials(Polynomials const &polynomials) override
+  {
+    if (polynomials.size() != polynomial_degree_ + 1)
+    {
+      return false;
+    }
+    std::vector<PrivateKey> a_i(polynomial_degree_ + 1, GetZeroFr());
+    std::vector<PrivateKey> b_i(polynomial_degree_ + 1, GetZeroFr());
+    for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
+    {
+      auto it = polynomials.find(k);
+      if (it == polynomials.end() || !a_i[k].FromString(it->second.first) ||
+          !b_i[k].FromString(it->second.second))
+      {
+        return false;
+      }
+    }
+    a_i_ = std::move(a_i);
+    b_i_ = std::move(b_i);
+    ComputeCoefficientsAndShares();
+    return true;
+  }
+
+  std::pair<Share, Share> GetOwnShares(CabinetIndex const &receiver_index) override
   {
     std::pair<Share, Share> shares_j{s_ij_[cabinet_index_][receiver_index].ToString(),
                                    sprime_ij_[cabinet_index_][receiver_index].ToString()};
     return shares_j;
   }
 
-  std::pair<Share, Share> GetReceivedShares(CabinetIndex const &owner)
+  std::pair<Share, Share> GetReceivedShares(CabinetIndex const &owner) override
   {
     std::pair<Share, Share> shares_j{s_ij_[owner][cabinet_index_].ToString(),
                                    sprime_ij_[owner][cabinet_index_].ToString()};
     return shares_j;
   }
 
-  void AddShares(CabinetIndex const &from_index, std::pair<Share, Share> const &shares) {
+  void AddShares(CabinetIndex const &from_index, std::pair<Share, Share> const &shares) override {
     s_ij_[from_index][cabinet_index_].FromString(shares.first);
     sprime_ij_[from_index][cabinet_index_].FromString(shares.second);
  }
 
   void AddCoefficients(CabinetIndex const &from_index,
-                                    std::vector<Coefficient> const &coefficients) {
+                                    std::vector<Coefficient> const &coefficients) override {
     if (coefficients.size() == polynomial_degree_ + 1)
     {
       for (CabinetIndex i = 0; i <= polynomial_degree_; ++i)
@@ -104,7 +169,7 @@ public:
     }
   }
 
-  std::set<CabinetIndex> ComputeComplaints(std::set<CabinetIndex> const &coeff_received)
+  std::set<CabinetIndex> ComputeComplaints(std::set<CabinetIndex> const &coeff_received) override
   {
     std::set<CabinetIndex> complaints;
     for (auto &i : coeff_received)
@@ -125,7 +190,7 @@ public:
     return complaints;
   }
 
-  bool VerifyComplaintAnswer(CabinetIndex const &from_index, ComplaintAnswer const &answer) {
+  bool VerifyComplaintAnswer(CabinetIndex const &from_index, ComplaintAnswer const &answer) override {
     CabinetIndex reporter_index = answer.first;
     PrivateKey s, sprime;
     VerificationKey lhsG, rhsG;
@@ -151,12 +216,12 @@ public:
     return false;
   }
 
-  void SetQual(std::set<CabinetIndex> qual)
+  void SetQual(std::set<CabinetIndex> qual) override
   {
     qual_ = std::move(qual);
   }
 
-  void ComputeSecretShare() 
+  void ComputeSecretShare() override
   {
     secret_share_.SetZero();
     xprime_i_.SetZero();
@@ -166,7 +231,7 @@ public:
     }
   }
 
-  void AddReconstructionShare(CabinetIndex const &index) 
+  void AddReconstructionShare(CabinetIndex const &index) override
   {
     if (reconstruction_shares.find(index) == reconstruction_shares.end())
     {
@@ -176,7 +241,7 @@ public:
     reconstruction_shares.at(index).second[cabinet_index_] = s_ij_[index][cabinet_index_];
   }
 
-  void VerifyReconstructionShare(CabinetIndex const &from, ExposedShare const &share) 
+  void VerifyReconstructionShare(CabinetIndex const &from, ExposedShare const &share) override
   {
     CabinetIndex victim_index = share.first;
     VerificationKey lhs, rhs;
@@ -195,22 +260,22 @@ public:
 
   /// Property methods
   /// @{
-  bool InQual(CabinetIndex const &index) const
+  bool InQual(CabinetIndex const &index) const override
   {
     return std::find(qual_.begin(), qual_.end(), index) != qual_.end();
   }
-  std::set<CabinetIndex> const &qual() const
+  std::set<CabinetIndex> const &qual() const override
   {
     return qual_;
   }
-  CabinetIndex cabinet_index() const {
+  CabinetIndex cabinet_index() const override {
     return cabinet_index_;
   }
-  CabinetIndex polynomial_degree() const
+  CabinetIndex polynomial_degree() const override
   {
     return polynomial_degree_;
   }
-  CabinetIndex cabinet_size() const
+  CabinetIndex cabinet_size() const override
   {
     return cabinet_size_;
   }
@@ -227,6 +292,7 @@ protected:
   CabinetIndex cabinet_index_;      ///< Index of our address in cabinet_
 
   // Temporary variables in DKG
+  std::vector<PrivateKey> a_i_, b_i_;  ///< Secret polynomials of this member
   PrivateKey xprime_i_;
   std::vector<std::vector<PrivateKey> > s_ij_, sprime_ij_;
   std::vector<std::vector<VerificationKey>> C_ik_;
@@ -239,6 +305,9 @@ protected:
 
   BaseDkg() = default;
 
+  /// Computes our coefficients and the shares for each member from the secret polynomials
+  virtual void ComputeCoefficientsAndShares() = 0;
+
   void AddReconstructionShare(CabinetIndex const &from_index,
                                            std::pair<CabinetIndex, Share> const &share) 
   {
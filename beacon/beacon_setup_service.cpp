@@ -33,14 +33,26 @@ namespace beacon {
 static constexpr char const *LOGGING_NAME = "BeaconSetupService";
 
 BeaconSetupService::BeaconSetupService(Identifier cabinet_size, CabinetIndex threshold,
-                                       Identifier index)
+                                       Identifier index, std::string const &aeon_type)
 {
   if (index < 0 || index >= cabinet_size)
   {
     return;
   }
 
-  beacon_ = std::make_unique<DkgImplemention>();
+  if (aeon_type == BLS_AEON)
+  {
+    beacon_ = std::make_unique<BlsDkg>();
+  }
+  else if (aeon_type == GLOW_AEON)
+  {
+    beacon_ = std::make_unique<GlowDkg>();
+  }
+  else
+  {
+    Log(LogLevel::ERROR, LOGGING_NAME, "BeaconSetupService: unknown aeon type " + aeon_type);
+    return;
+  }
   beacon_->NewCabinet(cabinet_size, threshold, index);
   complaints_manager_.ResetCabinet(index, threshold);
   complaint_answers_manager_.ResetCabinet();
@@ -153,13 +165,21 @@ bool BeaconSetupService::RunReconstruction()
   return beacon_->RunReconstruction();
 }
 
-BeaconSetupService::AeonExecUnit BeaconSetupService::ComputePublicKeys()
+/**
+ * Computes the output of the dkg, returning a copy of the aeon execution unit owned by the caller
+ */
+BaseAeon *BeaconSetupService::ComputePublicKeys()
 {
   std::lock_guard<std::mutex> lock(mutex_);
   beacon_->ComputePublicKeys();
-  auto aeon_ptr = std::dynamic_pointer_cast<AeonExecUnit>(beacon_-> GetDkgOutput());
-  assert(aeon_ptr);
-  return *aeon_ptr;
+  auto output = beacon_->GetDkgOutput();
+  if (auto bls_aeon = std::dynamic_pointer_cast<BlsAeon>(output))
+  {
+    return new BlsAeon(*bls_aeon);
+  }
+  auto glow_aeon = std::dynamic_pointer_cast<GlowAeon>(output);
+  assert(glow_aeon);
+  return new GlowAeon(*glow_aeon);
 }
 
 BeaconSetupService::SerialisedMsg BeaconSetupService::GetCoefficients()
@@ -255,6 +275,36 @@ BeaconSetupService::SerialisedMsg BeaconSetupService::GetReconstructionShares()
   ;
 }
 
+/**
+ * Get the secret polynomials of this member, from which our coefficients and shares are
+ * computed. Saved so that a node restarting during the DKG sends the same coefficients and shares
+ */
+BeaconSetupService::SerialisedMsg BeaconSetupService::GetPolynomials()
+{
+  std::lock_guard<std::mutex> lock(mutex_);
+  return serialisers::Serialise(beacon_->GetPolynomials());
+}
+
+/**
+ * Replace the generated secret polynomials with ones saved by an earlier run of the same DKG,
+ * recomputing our coefficients and shares. Must be called before coefficients and shares are sent
+ *
+ * @param msg Serialised polynomials from GetPolynomials
+ * @return Whether the polynomials were restored
+ */
+bool BeaconSetupService::RestorePolynomials(SerialisedMsg const &msg)
+{
+  std::lock_guard<std::mutex> lock(mutex_);
+
+  DkgInterface::Polynomials polynomials;
+  if (!serialisers::Deserialise(msg, polynomials))
+  {
+    Log(LogLevel::ERROR, LOGGING_NAME, "RestorePolynomials: failed to deserialise polynomials");
+    return false;
+  }
+  return beacon_->SetPolynomials(polynomials);
+}
+
 /**
  * Handler for submit shares used for members to send individual pairs of
  * secret shares to other cabinet members
@@ -530,5 +580,69 @@ bool BeaconSetupService::CheckQualComplaints()
   return true;
 }
 
+namespace {
+
+template <class DkgImplemention>
+bool VerifyComplaintAnswerForDkg(std::string const &coefficients, std::string const &answers,
+                                 uint32_t reporter, uint32_t threshold)
+{
+  std::vector<std::string>             coefficient_strings;
+  BeaconSetupService::SharesExposedMap exposed_shares;
+  if (!serialisers::Deserialise(coefficients, coefficient_strings) ||
+      !serialisers::Deserialise(answers, exposed_shares))
+  {
+    return true;
+  }
+  auto answer = exposed_shares.find(reporter);
+  if (answer == exposed_shares.end() || coefficient_strings.size() != threshold)
+  {
+    return true;
+  }
+
+  std::vector<typename DkgImplemention::VerificationKey> commitments(coefficient_strings.size());
+  for (std::size_t k = 0; k < coefficient_strings.size(); ++k)
+  {
+    if (!commitments[k].FromString(coefficient_strings[k]))
+    {
+      return true;
+    }
+  }
+
+  DkgImplemention                     dkg_implementation;
+  typename DkgImplemention::Base const &dkg = dkg_implementation;
+  typename DkgImplemention::PrivateKey s, sprime;
+  if (!s.FromString(answer->second.first) || !sprime.FromString(answer->second.second))
+  {
+    return false;
+  }
+  typename DkgImplemention::VerificationKey lhs, rhs;
+  rhs = mcl::ComputeRHS(reporter, commitments);
+  lhs = mcl::ComputeLHS(dkg.GetGroupG(), dkg.GetGroupH(), s, sprime);
+  return lhs == rhs && !lhs.isZero();
+}
+
+}  // namespace
+
+/**
+ * Checks the pair of shares exposed for reporter in a complaint answers message against the
+ * coefficients broadcast by the sender of the answers. Returns false only if the exposed shares
+ * are shown to be inconsistent with the coefficients, which proves the complaint was valid
+ *
+ * @param coefficients Serialised coefficients of the sender
+ * @param answers Serialised complaint answers of the sender
+ * @param reporter Index of the member which complained against the sender
+ * @param threshold Threshold of the dkg
+ * @param aeon_type Type of aeon generated by the dkg
+ */
+bool VerifyComplaintAnswer(std::string const &coefficients, std::string const &answers, uint32_t reporter,
+                           uint32_t threshold, std::string const &aeon_type)
+{
+  if (aeon_type == BLS_AEON)
+  {
+    return VerifyComplaintAnswerForDkg<BlsDkg>(coefficients, answers, reporter, threshold);
+  }
+  return VerifyComplaintAnswerForDkg<GlowDkg>(coefficients, answers, reporter, threshold);
+}
+
 }  // namespace beacon
 }  // namespace fetch
@@ -26,14 +26,17 @@ namespace beacon {
 
 #define GLOW 1
 
+// Aeon type assumed for keys saved without a type, from before the type was chosen on chain
 #ifdef GLOW
 const std::string AeonType = GLOW_AEON;
 #else
 const std::string AeonType = BLS_AEON;
 #endif
 
-// DKG mmplementation is switched depending on whether GLOW is defined. 
-// Default is the DFinity/BLS implementation.
+class DkgInterface;
+
+// DKG implementation is chosen by the aeon type passed on construction, which is either
+// BLS_AEON for the DFinity/BLS implementation or GLOW_AEON
 class BeaconSetupService
 {
 public:
@@ -43,15 +46,9 @@ public:
   using Share            = std::string;
   using Coefficient      = std::string;
   using SharesExposedMap = std::unordered_map<Identifier, std::pair<Share, Share>>;
-#ifdef GLOW
-using DkgImplemention = class GlowDkg;
-using AeonExecUnit    = GlowAeon;
-#else
-using DkgImplemention = class BlsDkg;
-using AeonExecUnit    = BlsAeon;
-#endif
 
-  BeaconSetupService(Identifier cabinet_size, CabinetIndex threshold, Identifier index);
+  BeaconSetupService(Identifier cabinet_size, CabinetIndex threshold, Identifier index,
+                     std::string const &aeon_type);
   BeaconSetupService(BeaconSetupService const &) = delete;
   BeaconSetupService(BeaconSetupService &&)      = delete;
   ~BeaconSetupService();
@@ -77,6 +74,12 @@ using AeonExecUnit    = BlsAeon;
   SerialisedMsg GetReconstructionShares();
   /// @}
 
+  /// @name For saving and restoring the secret polynomials
+  /// @{
+  SerialisedMsg GetPolynomials();
+  bool          RestorePolynomials(SerialisedMsg const &msg);
+  /// @}
+
   /// @name Handlers for messages
   /// @{
   void OnShares(SerialisedMsg const &msg, const Identifier &from);
@@ -91,7 +94,7 @@ using AeonExecUnit    = BlsAeon;
   CabinetIndex            BuildQual();
   bool                    CheckQualComplaints();
   bool                    RunReconstruction();
-  AeonExecUnit            ComputePublicKeys();
+  BaseAeon               *ComputePublicKeys();
 
 private:
   // Managing complaints
@@ -101,7 +104,7 @@ private:
 
     // Members below protected by mutex
   mutable std::mutex               mutex_;
-  std::unique_ptr<DkgImplemention> beacon_;
+  std::unique_ptr<DkgInterface> beacon_;
 
   // Counters for types of messages received
   std::set<CabinetIndex>                   shares_received_;
@@ -117,5 +120,11 @@ private:
   uint32_t QualSize();
   /// @}
 };
+
+/// Checks the shares exposed for reporter in complaint answers against the sender's coefficients,
+/// in a dkg for aeon_type
+bool VerifyComplaintAnswer(std::string const &coefficients, std::string const &answers, uint32_t reporter,
+                           uint32_t threshold, std::string const &aeon_type);
+
 }  // namespace beacon
 }  // namespace fetch
@@ -102,6 +102,8 @@ void BlsDkg::NewCabinet(CabinetIndex cabinet_size, CabinetIndex threshold, Cabin
   mcl::Init(this->A_ik_, cabinet_size_, polynomial_degree_ + 1);
   mcl::Init(this->secret_commitments_, cabinet_size_, cabinet_size_);
   mcl::Init(temp_qual_coeffs_, polynomial_degree_ + 1);
+  mcl::Init(this->a_i_, polynomial_degree_ + 1);
+  mcl::Init(this->b_i_, polynomial_degree_ + 1);
 
   this->qual_.clear();
   this->reconstruction_shares.clear();
@@ -109,23 +111,25 @@ void BlsDkg::NewCabinet(CabinetIndex cabinet_size, CabinetIndex threshold, Cabin
 
 void BlsDkg::GenerateCoefficients()
 {
-  std::vector<PrivateKey> a_i(polynomial_degree_ + 1, GetZeroFr());
-  std::vector<PrivateKey> b_i(polynomial_degree_ + 1, GetZeroFr());
   for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
   {
-    a_i[k].Random();
-    b_i[k].Random();
+    this->a_i_[k].Random();
+    this->b_i_[k].Random();
   }
+  ComputeCoefficientsAndShares();
+}
 
+void BlsDkg::ComputeCoefficientsAndShares()
+{
   for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
   {
     this->C_ik_[cabinet_index_][k] =
-        mcl::ComputeLHS(temp_qual_coeffs_[k], GetGroupG(), GetGroupH(), a_i[k], b_i[k]);
+        mcl::ComputeLHS(temp_qual_coeffs_[k], GetGroupG(), GetGroupH(), this->a_i_[k], this->b_i_[k]);
   }
 
   for (CabinetIndex l = 0; l < cabinet_size_; l++)
   {
-    mcl::ComputeShares(this->s_ij_[cabinet_index_][l], this->sprime_ij_[cabinet_index_][l], a_i, b_i, l);
+    mcl::ComputeShares(this->s_ij_[cabinet_index_][l], this->sprime_ij_[cabinet_index_][l], this->a_i_, this->b_i_, l);
   }
 }
 
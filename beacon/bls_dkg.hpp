@@ -44,6 +44,9 @@ public:
   void ComputePublicKeys() override;
   std::shared_ptr<BaseAeon> GetDkgOutput() const override;
 
+protected:
+  void ComputeCoefficientsAndShares() override;
+
 private:
   std::vector<VerificationKey> temp_qual_coeffs_;
 
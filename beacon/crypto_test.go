@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/tendermint/tendermint/beacon/verifier"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -48,12 +50,92 @@ func TestCryptoNonValidator(t *testing.T) {
 
 func TestHonestDkg(t *testing.T) {
 	cabinetSize := uint(3)
-	threshold := uint(2)
+	outputs := runHonestDkg(t, cabinetSize, 2)
+
+	// Check all group public keys agree with threshold signing
+	message := "TestMessage"
+	sigShares := NewIntStringMap()
+	defer DeleteIntStringMap(sigShares)
+	for index := uint(0); index < cabinetSize; index++ {
+		signature := outputs[index].Sign(message, index)
+		for index1 := uint(0); index1 < cabinetSize; index1++ {
+			if index != index1 {
+				assert.True(t, outputs[index1].Verify(message, signature, index))
+			}
+		}
+		sigShares.Set(index, signature)
+	}
+	groupSig := outputs[0].ComputeGroupSignature(sigShares)
+	for index := uint(0); index < cabinetSize; index++ {
+		assert.True(t, outputs[index].VerifyGroupSignature(message, groupSig))
+	}
+}
+
+// TestGoVerifierMatchesMcl checks the pure Go verifier accepts exactly the signatures accepted by the
+// aeon execution units, for BLS aeons from the test keys and for aeons from a dkg
+func TestGoVerifierMatchesMcl(t *testing.T) {
+	blsAeons := make([]BaseAeon, 4)
+	for index := range blsAeons {
+		blsAeons[index] = testAeonFromFile("test_keys/validator_" + strconv.Itoa(index) + "_of_4.txt")
+	}
+	dkgAeons := runHonestDkg(t, 3, 2)
+	assert.Equal(t, GetAeonType(), verifier.DefaultAeonType)
+
+	for _, aeons := range [][]BaseAeon{blsAeons, dkgAeons} {
+		output := testDKGOutput(aeons[0])
+		mclVerifier := NewEntropyVerifier()
+		goVerifier := verifier.NewAeonVerifier()
+
+		for _, message := range []string{"TestMessage", string(types.InitialEntropy(output.GroupPublicKey))} {
+			sigShares := NewIntStringMap()
+			for index := range aeons {
+				signature := aeons[index].Sign(message, uint(index))
+				for index1 := range aeons {
+					assert.Equal(t, index == index1,
+						mclVerifier.VerifySignatureShare(output, message, signature, uint(index1)))
+					assert.Equal(t, index == index1,
+						goVerifier.VerifySignatureShare(output, message, signature, uint(index1)))
+				}
+				assert.False(t, goVerifier.VerifySignatureShare(output, message+"1", signature, uint(index)))
+				sigShares.Set(uint(index), signature)
+			}
 
+			groupSig := []byte(aeons[0].ComputeGroupSignature(sigShares))
+			DeleteIntStringMap(sigShares)
+			assert.True(t, mclVerifier.VerifyGroupSignature(output, message, groupSig))
+			assert.True(t, goVerifier.VerifyGroupSignature(output, message, groupSig))
+			assert.False(t, goVerifier.VerifyGroupSignature(output, message+"1", groupSig))
+		}
+	}
+}
+
+// testDKGOutput returns the public dkg output of an aeon execution unit
+func testDKGOutput(aeonExecUnit BaseAeon) *types.DKGOutput {
+	output := &types.DKGOutput{
+		KeyType:         aeonExecUnit.Name(),
+		GroupPublicKey:  aeonExecUnit.GroupPublicKey(),
+		Generator:       aeonExecUnit.Generator(),
+		ValidatorHeight: 1,
+		Start:           1,
+		End:             10,
+	}
+	publicKeyShares := aeonExecUnit.PublicKeyShares()
+	for i := 0; i < int(publicKeyShares.Size()); i++ {
+		output.PublicKeyShares = append(output.PublicKeyShares, publicKeyShares.Get(i))
+	}
+	qual := aeonExecUnit.Qual()
+	for i := 0; i < int(qual.Size()); i++ {
+		output.Qual = append(output.Qual, qual.Get(i))
+	}
+	return output
+}
+
+// runHonestDkg runs the dkg between cabinetSize honest beacon managers and returns their outputs
+func runHonestDkg(t *testing.T, cabinetSize uint, threshold uint) []BaseAeon {
 	// Set up two honest beacon managers
 	beaconManagers := make([]BeaconSetupService, cabinetSize)
 	for index := uint(0); index < cabinetSize; index++ {
-		beaconManagers[index] = NewBeaconSetupService(cabinetSize, threshold, index)
+		beaconManagers[index] = NewBeaconSetupService(cabinetSize, threshold, index, GetAeonType())
 	}
 
 	// Distribute shares
@@ -151,25 +233,7 @@ func TestHonestDkg(t *testing.T) {
 		assert.True(t, beaconManagers[index].RunReconstruction())
 		outputs[index] = beaconManagers[index].ComputePublicKeys()
 	}
-
-	// Check all group public keys agree with threshold signing
-	message := "TestMessage"
-	sigShares := NewIntStringMap()
-	defer DeleteIntStringMap(sigShares)
-	for index := uint(0); index < cabinetSize; index++ {
-		signature := outputs[index].Sign(message, index)
-		for index1 := uint(0); index1 < cabinetSize; index1++ {
-			if index != index1 {
-				assert.True(t, outputs[index1].Verify(message, signature, index))
-			}
-		}
-		sigShares.Set(index, signature)
-	}
-	groupSig := outputs[0].ComputeGroupSignature(sigShares)
-	for index := uint(0); index < cabinetSize; index++ {
-		assert.True(t, outputs[index].VerifyGroupSignature(message, groupSig))
-	}
-
+	return outputs
 }
 
 func testAeonFromFile(filename string) BaseAeon {
@@ -10,7 +10,6 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	bits "github.com/tendermint/tendermint/libs/bits"
 	"github.com/tendermint/tendermint/libs/service"
-	tmnoise "github.com/tendermint/tendermint/noise"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -33,12 +32,46 @@ const (
 
 	// Number of dkg states with non-zero duration
 	dkgStatesWithDuration = int64(dkgFinish) - 1
-	// Multplier for increasing state duration on next dkg iteration
-	dkgIterationDurationMultiplier = float64(0.5)
-	maxDKGStateDuration            = int64(400)
-	dkgResetDelay                  = int64(2)
 )
 
+// dkgMessageStates are the last states in which messages of each type are processed
+var dkgMessageStates = map[types.DKGMessageType]dkgState{
+	types.DKGEncryptionKey:       waitForEncryptionKeys,
+	types.DKGShare:               waitForCoefficientsAndShares,
+	types.DKGCoefficient:         waitForCoefficientsAndShares,
+	types.DKGComplaint:           waitForComplaints,
+	types.DKGComplaintAnswer:     waitForComplaintAnswers,
+	types.DKGQualCoefficient:     waitForQualCoefficients,
+	types.DKGQualComplaint:       waitForQualComplaints,
+	types.DKGReconstructionShare: waitForReconstructionShares,
+	types.DKGDryRun:              waitForDryRun,
+
+	types.DKGReshareCoefficient:     waitForCoefficientsAndShares,
+	types.DKGReshareShare:           waitForCoefficientsAndShares,
+	types.DKGReshareComplaint:       waitForComplaints,
+	types.DKGReshareComplaintAnswer: waitForComplaintAnswers,
+}
+
+var dkgStateNames = map[dkgState]string{
+	dkgStart:                     "dkgStart",
+	waitForEncryptionKeys:        "waitForEncryptionKeys",
+	waitForCoefficientsAndShares: "waitForCoefficientsAndShares",
+	waitForComplaints:            "waitForComplaints",
+	waitForComplaintAnswers:      "waitForComplaintAnswers",
+	waitForQualCoefficients:      "waitForQualCoefficients",
+	waitForQualComplaints:        "waitForQualComplaints",
+	waitForReconstructionShares:  "waitForReconstructionShares",
+	waitForDryRun:                "waitForDryRun",
+	dkgFinish:                    "dkgFinish",
+}
+
+func (s dkgState) String() string {
+	if name, ok := dkgStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
 type state struct {
 	durationMultiplier int64
 	onEntry            func()
@@ -89,7 +122,7 @@ type DistributedKeyGeneration struct {
 	validators      types.ValidatorSet
 	threshold       uint
 	currentAeonEnd  int64
-	aeonLength      int64
+	params          types.EntropyParams
 	stateDuration   int64
 	aeonKeys        *aeonDetails
 	onFailState     func(int64)
@@ -97,26 +130,41 @@ type DistributedKeyGeneration struct {
 	startHeight   int64
 	states        map[dkgState]*state
 	currentState  dkgState
-	beaconService BeaconSetupService
+	beaconService dkgService
+	aeonSigner    types.DKGSigner // runs the steps with secret state, if set
 
-	dryRunKeys       map[string]DKGOutput
+	dryRunKeys       map[string]types.DKGOutput
 	dryRunSignatures map[string]map[string]string
 	dryRunCount      *bits.BitArray
 
 	sendMsgCallback       func(tx *types.DKGMessage)
 	dkgCompletionCallback func(*aeonDetails)
 
-	encryptionKey        noise.DHKey
+	payloads               *dkgPayloads // payloads of messages gossiped off chain
+	sendPayloadCallback    func(msg *types.DKGMessage, payload string)
+	requestPayloadCallback func(payloadHash []byte)
+
 	encryptionPublicKeys map[uint][]byte
+	sharesReceived       *bits.BitArray
+	resharing            *dkgReshare // set if resharing the keys of the current aeon
+
+	checkpointFile string // secret state is saved to this file on each state change, if set
+	checkpointKey  []byte // key-encryption key of the checkpoint, which is not encrypted if empty
+	checkpoint     *dkgCheckpoint
 
-	metrics *Metrics
+	metrics  *Metrics
+	eventBus types.BeaconEventPublisher
 }
 
 // NewDistributedKeyGeneration runs the DKG from messages encoded in transactions
 func NewDistributedKeyGeneration(beaconConfig *cfg.BeaconConfig, chain string,
-	privVal types.PrivValidator, dhKey noise.DHKey, validatorHeight int64, vals types.ValidatorSet,
-	aeonEnd int64, aeonLength int64) *DistributedKeyGeneration {
-	dkgThreshold := uint(len(vals.Validators)/2 + 1)
+	privVal types.PrivValidator, validatorHeight int64, vals types.ValidatorSet,
+	aeonEnd int64, params types.EntropyParams) *DistributedKeyGeneration {
+	if len(params.KeyType) == 0 {
+		// Params saved before the key type was chosen on chain use the default type
+		params.KeyType = GetAeonType()
+	}
+	dkgThreshold := types.DKGThreshold(len(vals.Validators))
 	dkg := &DistributedKeyGeneration{
 		config:               beaconConfig,
 		chainID:              chain,
@@ -127,24 +175,26 @@ func NewDistributedKeyGeneration(beaconConfig *cfg.BeaconConfig, chain string,
 		valToIndex:           make(map[string]uint),
 		validators:           vals,
 		currentAeonEnd:       aeonEnd,
-		aeonLength:           aeonLength,
+		params:               params,
 		threshold:            dkgThreshold,
 		startHeight:          validatorHeight,
 		states:               make(map[dkgState]*state),
 		currentState:         dkgStart,
-		dryRunKeys:           make(map[string]DKGOutput),
+		dryRunKeys:           make(map[string]types.DKGOutput),
 		dryRunSignatures:     make(map[string]map[string]string),
 		dryRunCount:          bits.NewBitArray(vals.Size()),
-		encryptionKey:        dhKey,
 		encryptionPublicKeys: make(map[uint][]byte),
+		sharesReceived:       bits.NewBitArray(vals.Size()),
+		payloads:             newDKGPayloads(),
 		metrics:              NopMetrics(),
+		eventBus:             types.NopEventBus{},
 	}
 	dkg.BaseService = *service.NewBaseService(nil, "DKG", dkg)
 
 	if dkg.index() < 0 {
 		dkg.Logger.Debug("startNewDKG: not in validators", "height", dkg.validatorHeight)
 	} else {
-		dkg.beaconService = NewBeaconSetupService(uint(len(dkg.validators.Validators)), uint(dkg.threshold), uint(dkg.index()))
+		dkg.beaconService = dkg.newBeaconService()
 	}
 	// Set validator address to index
 	for index, val := range dkg.validators.Validators {
@@ -160,18 +210,57 @@ func NewDistributedKeyGeneration(beaconConfig *cfg.BeaconConfig, chain string,
 	return dkg
 }
 
-// Estimate of dkg run times from local computations
+// Estimate of dkg run times from local computations, scaling the state duration set in the entropy
+// params with the number of validators
 func (dkg *DistributedKeyGeneration) setInitialStateDuration() {
-	numVal := len(dkg.validators.Validators)
+	numVal := int64(len(dkg.validators.Validators))
 	if numVal <= 100 {
-		dkg.stateDuration = 5
+		dkg.stateDuration = dkg.params.DKGStateDuration
 	} else if numVal <= 200 {
-		dkg.stateDuration = 10
+		dkg.stateDuration = 2 * dkg.params.DKGStateDuration
 	} else {
-		dkg.stateDuration = int64(numVal)
+		dkg.stateDuration = numVal * dkg.params.DKGStateDuration / types.DefaultEntropyParams().DKGStateDuration
 	}
 }
 
+// newBeaconService returns the service running the steps of the current iteration which use
+// secret state, in the aeon signer if set
+func (dkg *DistributedKeyGeneration) newBeaconService() dkgService {
+	if dkg.aeonSigner != nil {
+		return newRemoteDKGService(dkg.aeonSigner, dkg.chainID, dkg.dkgID, dkg.dkgIteration,
+			uint(dkg.validators.Size()), dkg.threshold, uint(dkg.index()), dkg.params.KeyType, dkg.Logger)
+	}
+	return newLocalDKGService(dkg.chainID, dkg.dkgID, dkg.dkgIteration, uint(dkg.validators.Size()),
+		dkg.threshold, uint(dkg.index()), dkg.params.KeyType)
+}
+
+// localService returns the service of the current iteration if its secret state is held by this
+// node, or nil
+func (dkg *DistributedKeyGeneration) localService() *localDKGService {
+	service, _ := dkg.beaconService.(*localDKGService)
+	return service
+}
+
+// SetAeonSigner sets the signer which runs the steps of the dkg using secret state, and keeps the
+// private key computed. Must be called before the dkg is started.
+func (dkg *DistributedKeyGeneration) SetAeonSigner(aeonSigner types.DKGSigner) {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	dkg.aeonSigner = aeonSigner
+	if dkg.index() >= 0 {
+		dkg.beaconService.Delete()
+		dkg.beaconService = dkg.newBeaconService()
+	}
+	dkg.setStates()
+}
+
+// observer returns true if the dkg only waits for the output of the other validators, which it
+// does if this node is not a validator, or if it would reshare keys held by the aeon signer
+func (dkg *DistributedKeyGeneration) observer() bool {
+	return dkg.index() < 0 || (dkg.resharing != nil && dkg.aeonSigner != nil)
+}
+
 // SetSendMsgCallback sets the function for the DKG to send transactions to the mempool
 func (dkg *DistributedKeyGeneration) SetSendMsgCallback(callback func(msg *types.DKGMessage)) {
 	dkg.mtx.Lock()
@@ -191,17 +280,32 @@ func (dkg *DistributedKeyGeneration) attachMetrics(metrics *Metrics) {
 	dkg.metrics = metrics
 }
 
+// SetEventBus sets the event bus on which dkg state changes, completion and failures are published
+func (dkg *DistributedKeyGeneration) SetEventBus(eventBus types.BeaconEventPublisher) {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	dkg.eventBus = eventBus
+}
+
 func (dkg *DistributedKeyGeneration) setStates() {
+	dkg.states = make(map[dkgState]*state)
 	dkg.states[dkgStart] = newState(0, nil, func() bool {
 		err := dkg.Start()
 		return err == nil
 	}, nil)
 
-	if dkg.index() < 0 {
-		dkg.states[waitForDryRun] = newState(dkgStatesWithDuration,
+	if dkg.observer() {
+		durationMultiplier := dkgStatesWithDuration
+		if dkg.resharing != nil {
+			durationMultiplier = reshareStatesWithDuration
+		}
+		dkg.states[waitForDryRun] = newState(durationMultiplier,
 			nil,
 			dkg.checkDryRuns,
 			dkg.receivedAllDryRuns)
+	} else if dkg.resharing != nil {
+		dkg.setReshareStates()
 	} else {
 		dkg.states[waitForEncryptionKeys] = newState(1,
 			dkg.sendEncryptionKey,
@@ -246,10 +350,10 @@ func (dkg *DistributedKeyGeneration) OnReset() error {
 	dkg.dkgIteration++
 	dkg.metrics.DKGFailures.Add(1)
 	// Reset start time
-	dkg.startHeight = dkg.startHeight + dkg.duration() + dkgResetDelay
+	dkg.startHeight = dkg.startHeight + dkg.duration() + dkg.params.DKGResetDelay
 	// Increase dkg time
-	newStateDuration := dkg.stateDuration + int64(float64(dkg.stateDuration)*dkgIterationDurationMultiplier)
-	if newStateDuration <= maxDKGStateDuration {
+	newStateDuration := dkg.stateDuration + dkg.stateDuration*dkg.params.DKGIterationDurationIncrease/100
+	if newStateDuration <= dkg.params.DKGMaxStateDuration {
 		dkg.stateDuration = newStateDuration
 	}
 	// Dispatch empty keys to entropy generator. +1 need at the end of aeonEnd because consensus needs entropy for next block
@@ -259,16 +363,22 @@ func (dkg *DistributedKeyGeneration) OnReset() error {
 	}
 	// Reset beaconService
 	if dkg.index() >= 0 {
-		DeleteBeaconSetupService(dkg.beaconService)
-		dkg.beaconService = NewBeaconSetupService(uint(len(dkg.valToIndex)), dkg.threshold, uint(dkg.index()))
-		dkg.setStates()
+		dkg.beaconService.Delete()
+		dkg.beaconService = dkg.newBeaconService()
 	}
+	// Fall back to a full dkg if resharing failed
+	dkg.resharing = nil
+	dkg.setStates()
 	// Reset dkg details
 	dkg.encryptionPublicKeys = make(map[uint][]byte)
-	dkg.dryRunKeys = make(map[string]DKGOutput)
+	dkg.sharesReceived = bits.NewBitArray(dkg.validators.Size())
+	dkg.dryRunKeys = make(map[string]types.DKGOutput)
 	dkg.dryRunSignatures = make(map[string]map[string]string)
 	dkg.dryRunCount = bits.NewBitArray(dkg.validators.Size())
+	dkg.payloads = newDKGPayloads()
 	dkg.aeonKeys = nil
+	// Restore the state of the new iteration if the node restarted during it
+	dkg.restoreCheckpoint()
 	return nil
 }
 
@@ -281,8 +391,8 @@ func (dkg *DistributedKeyGeneration) OnBlock(blockHeight int64, trxs []*types.DK
 		dkg.checkTransition(blockHeight)
 		return
 	}
-	// Process transactions
-	for _, trx := range trxs {
+	// Process transactions, after those in previous blocks which were waiting for their payload
+	for _, trx := range append(dkg.payloads.popPending(), trxs...) {
 		// Decode transaction
 		msg := trx
 
@@ -292,55 +402,45 @@ func (dkg *DistributedKeyGeneration) OnBlock(blockHeight int64, trxs []*types.DK
 			dkg.Logger.Debug("OnBlock: check msg", "height", blockHeight, "from", msg.FromAddress, "err", err)
 			continue
 		}
+		if dkg.currentState > dkgMessageStates[msg.Type] {
+			continue
+		}
+		msg, ok := dkg.withPayload(msg)
+		if !ok {
+			dkg.Logger.Debug("OnBlock: waiting for payload", "height", blockHeight, "from", trx.FromAddress,
+				"hash", trx.PayloadHash)
+			continue
+		}
 
 		switch msg.Type {
 		case types.DKGEncryptionKey:
-			if dkg.currentState > waitForEncryptionKeys {
+			if len(msg.Data) != noise.DH25519.DHLen() {
+				dkg.Logger.Debug("OnBlock: invalid encryption key", "height", blockHeight, "from", msg.FromAddress)
 				continue
 			}
 			if _, ok := dkg.encryptionPublicKeys[uint(index)]; !ok {
 				dkg.encryptionPublicKeys[uint(index)] = []byte(msg.Data)
 			}
 		case types.DKGShare:
-			if dkg.currentState > waitForCoefficientsAndShares {
-				continue
-			}
 			dkg.onShares(msg.Data, uint(index))
+			dkg.sharesReceived.SetIndex(index, true)
 		case types.DKGCoefficient:
-			if dkg.currentState > waitForCoefficientsAndShares {
-				continue
-			}
 			dkg.beaconService.OnCoefficients(msg.Data, uint(index))
 		case types.DKGComplaint:
-			if dkg.currentState > waitForComplaints {
-				continue
-			}
 			dkg.beaconService.OnComplaints(msg.Data, uint(index))
 		case types.DKGComplaintAnswer:
-			if dkg.currentState > waitForComplaintAnswers {
-				continue
-			}
 			dkg.beaconService.OnComplaintAnswers(msg.Data, uint(index))
 		case types.DKGQualCoefficient:
-			if dkg.currentState > waitForQualCoefficients {
-				continue
-			}
 			dkg.beaconService.OnQualCoefficients(msg.Data, uint(index))
 		case types.DKGQualComplaint:
-			if dkg.currentState > waitForQualComplaints {
-				continue
-			}
 			dkg.beaconService.OnQualComplaints(msg.Data, uint(index))
 		case types.DKGReconstructionShare:
-			if dkg.currentState > waitForReconstructionShares {
-				continue
-			}
 			dkg.beaconService.OnReconstructionShares(msg.Data, uint(index))
 		case types.DKGDryRun:
-			if dkg.currentState > waitForDryRun {
-				continue
-			}
 			dkg.onDryRun(msg.Data, string(val.Address))
+		case types.DKGReshareCoefficient, types.DKGReshareShare, types.DKGReshareComplaint,
+			types.DKGReshareComplaintAnswer:
+			dkg.onReshareMessage(msg, uint(index))
 		default:
 			dkg.Logger.Error("OnBlock: unknown DKGMessage", "type", msg.Type)
 		}
@@ -349,6 +449,35 @@ func (dkg *DistributedKeyGeneration) OnBlock(blockHeight int64, trxs []*types.DK
 	dkg.checkTransition(blockHeight)
 }
 
+// status returns a summary of the progress of the dkg
+func (dkg *DistributedKeyGeneration) status() *types.DKGStatus {
+	dkg.mtx.RLock()
+	defer dkg.mtx.RUnlock()
+
+	status := &types.DKGStatus{
+		DKGID:           dkg.dkgID,
+		Iteration:       dkg.dkgIteration,
+		State:           dkg.currentState.String(),
+		ValidatorHeight: dkg.validatorHeight,
+		StartHeight:     dkg.startHeight,
+		EncryptionKeys:  make([]crypto.Address, 0),
+		Shares:          make([]crypto.Address, 0),
+		DryRuns:         make([]crypto.Address, 0),
+	}
+	for index, val := range dkg.validators.Validators {
+		if _, ok := dkg.encryptionPublicKeys[uint(index)]; ok {
+			status.EncryptionKeys = append(status.EncryptionKeys, val.Address)
+		}
+		if dkg.sharesReceived.GetIndex(index) {
+			status.Shares = append(status.Shares, val.Address)
+		}
+		if dkg.dryRunCount.GetIndex(index) {
+			status.DryRuns = append(status.DryRuns, val.Address)
+		}
+	}
+	return status
+}
+
 func (dkg *DistributedKeyGeneration) index() int {
 	index, _ := dkg.validators.GetByAddress(dkg.privValidator.GetPubKey().Address())
 	return index
@@ -395,6 +524,12 @@ func (dkg *DistributedKeyGeneration) checkTransition(blockHeight int64) {
 			dkg.Logger.Error("checkTransition: failed onExit", "height", blockHeight, "state", dkg.currentState, "iteration", dkg.dkgIteration)
 			if dkg.currentState == waitForDryRun {
 				// If exit function for dry run failed then reset and restart DKG
+				dkg.eventBus.PublishEventDKGFailed(types.EventDataDKGFailed{
+					DKGID:       dkg.dkgID,
+					Iteration:   dkg.dkgIteration,
+					BlockHeight: blockHeight,
+					State:       dkg.currentState.String(),
+				})
 				dkg.Stop()
 				dkg.Reset()
 			} else {
@@ -402,18 +537,40 @@ func (dkg *DistributedKeyGeneration) checkTransition(blockHeight int64) {
 			}
 			return
 		}
-		if dkg.currentState == dkgStart && dkg.index() < 0 {
+		if dkg.currentState == dkgStart && dkg.observer() {
 			// If not in validators skip straight to waiting for DKG output
 			dkg.proceedToNextState(waitForDryRun, false, blockHeight)
 			return
 		}
-		dkg.proceedToNextState(dkg.currentState+1, true, blockHeight)
+		dkg.proceedToNextState(dkg.nextState(), true, blockHeight)
 	}
 }
 
+// nextState returns the state following the current one, skipping states not run by the dkg
+func (dkg *DistributedKeyGeneration) nextState() dkgState {
+	next := dkg.currentState + 1
+	for next < dkgFinish {
+		if _, haveState := dkg.states[next]; haveState {
+			break
+		}
+		next++
+	}
+	return next
+}
+
 func (dkg *DistributedKeyGeneration) proceedToNextState(nextState dkgState, runOnEntry bool, blockHeight int64) {
 	dkg.currentState = nextState
 	dkg.metrics.DKGState.Set(float64(dkg.currentState))
+	dkg.eventBus.PublishEventDKGStateChange(types.EventDataDKGStateChange{
+		DKGID:       dkg.dkgID,
+		Iteration:   dkg.dkgIteration,
+		BlockHeight: blockHeight,
+		State:       dkg.currentState.String(),
+	})
+	// Save secret state before sending messages which depend on it
+	if dkg.currentState != dkgFinish {
+		dkg.saveCheckpoint()
+	}
 	if runOnEntry {
 		dkg.states[dkg.currentState].onEntry()
 	}
@@ -422,6 +579,16 @@ func (dkg *DistributedKeyGeneration) proceedToNextState(nextState dkgState, runO
 }
 
 func (dkg *DistributedKeyGeneration) newDKGMessage(msgType types.DKGMessageType, data string, toAddress crypto.Address) *types.DKGMessage {
+	return dkg.signedDKGMessage(msgType, data, nil, toAddress)
+}
+
+// newDKGCommitment returns a message committing to payload, which is gossiped off chain
+func (dkg *DistributedKeyGeneration) newDKGCommitment(msgType types.DKGMessageType, payload string, toAddress crypto.Address) *types.DKGMessage {
+	return dkg.signedDKGMessage(msgType, "", types.DKGPayloadHash(payload), toAddress)
+}
+
+func (dkg *DistributedKeyGeneration) signedDKGMessage(msgType types.DKGMessageType, data string, payloadHash []byte,
+	toAddress crypto.Address) *types.DKGMessage {
 	if toAddress == nil {
 		toAddress = []byte{}
 	}
@@ -432,6 +599,7 @@ func (dkg *DistributedKeyGeneration) newDKGMessage(msgType types.DKGMessageType,
 		FromAddress:  dkg.privValidator.GetPubKey().Address(),
 		ToAddress:    toAddress,
 		Data:         data,
+		PayloadHash:  payloadHash,
 	}
 	err := dkg.privValidator.SignDKGMessage(dkg.chainID, newMsg)
 	if err != nil {
@@ -441,16 +609,27 @@ func (dkg *DistributedKeyGeneration) newDKGMessage(msgType types.DKGMessageType,
 }
 
 func (dkg *DistributedKeyGeneration) broadcastMsg(msgType types.DKGMessageType, serialisedMsg string, toAddress crypto.Address) {
-	msg := dkg.newDKGMessage(msgType, serialisedMsg, toAddress)
+	var msg *types.DKGMessage
+	if dkg.sendsPayloadOffChain(msgType) {
+		msg = dkg.newDKGCommitment(msgType, serialisedMsg, toAddress)
+		dkg.payloads.add(msg, serialisedMsg)
+		if dkg.sendPayloadCallback != nil {
+			dkg.sendPayloadCallback(msg, serialisedMsg)
+		}
+	} else {
+		msg = dkg.newDKGMessage(msgType, serialisedMsg, toAddress)
+	}
 
 	if dkg.sendMsgCallback != nil {
 		dkg.sendMsgCallback(msg)
 	}
 }
 
+// sendEncryptionKey announces the ephemeral encryption key of this iteration. The key is bound to
+// the chain, dkg and iteration by the validator signature of the message.
 func (dkg *DistributedKeyGeneration) sendEncryptionKey() {
 	dkg.Logger.Debug("sendEncryptionKey", "iteration", dkg.dkgIteration)
-	dkg.broadcastMsg(types.DKGEncryptionKey, string(dkg.encryptionKey.Public), nil)
+	dkg.broadcastMsg(types.DKGEncryptionKey, string(dkg.beaconService.EncryptionKey()), nil)
 }
 
 func (dkg *DistributedKeyGeneration) sendSharesAndCoefficients() {
@@ -461,7 +640,7 @@ func (dkg *DistributedKeyGeneration) sendSharesAndCoefficients() {
 		if _, haveKeys := dkg.encryptionPublicKeys[index]; !haveKeys {
 			continue
 		}
-		encryptedMsg, err := tmnoise.EncryptMsg(dkg.encryptionKey, dkg.encryptionPublicKeys[index], dkg.beaconService.GetShare(index))
+		encryptedMsg, err := dkg.beaconService.GetEncryptedShare(index, dkg.encryptionPublicKeys[index])
 		if err != nil {
 			dkg.Logger.Error("sendShares: error encrypting share", "error", err.Error())
 			continue
@@ -505,18 +684,37 @@ func (dkg *DistributedKeyGeneration) buildQual() bool {
 }
 
 func (dkg *DistributedKeyGeneration) computeKeys() {
-	aeonExecUnit := dkg.beaconService.ComputePublicKeys()
-	// Create new aeon details - start height either the start
-	// of the next aeon or immediately (with some delay)
+	start, end := dkg.nextAeonHeights()
+	aeonExecUnit, err := dkg.beaconService.ComputeKeys(&types.DKGOutput{
+		ValidatorHeight: dkg.validatorHeight,
+		Start:           start,
+		End:             end,
+	})
+	if err != nil {
+		dkg.Logger.Error("computeKeys", "err", err.Error())
+		return
+	}
+	dkg.sendDryRun(aeonExecUnit)
+}
+
+// nextAeonHeights returns the start and end of the aeon generated by the dkg, which starts either
+// at the start of the next aeon or immediately (with some delay)
+func (dkg *DistributedKeyGeneration) nextAeonHeights() (int64, int64) {
 	nextAeonStart := dkg.currentAeonEnd + 1
 	dkgEnd := (dkg.startHeight + dkg.duration())
 	if dkgEnd >= nextAeonStart {
 		// +2 because the keyless aeon runs until dkgEnd +1 so the new set of keys starts at the block height after that
 		nextAeonStart = dkgEnd + 2
 	}
+	return nextAeonStart, nextAeonStart + dkg.params.AeonLength - 1
+}
+
+// sendDryRun sets the keys computed by the dkg and signs them in a dry run message
+func (dkg *DistributedKeyGeneration) sendDryRun(aeonExecUnit BaseAeon) {
+	nextAeonStart, nextAeonEnd := dkg.nextAeonHeights()
 	var err error
 	dkg.aeonKeys, err = newAeonDetails(dkg.privValidator, dkg.validatorHeight, &dkg.validators, aeonExecUnit,
-		nextAeonStart, nextAeonStart+dkg.aeonLength-1)
+		nextAeonStart, nextAeonEnd)
 	if err != nil {
 		dkg.Logger.Error("computePublicKeys", "err", err.Error())
 		dkg.aeonKeys = nil
@@ -524,9 +722,19 @@ func (dkg *DistributedKeyGeneration) computeKeys() {
 	}
 	dkg.Logger.Debug("sendDryRun", "iteration", dkg.dkgIteration)
 	msgToSign := string(cdc.MustMarshalBinaryBare(dkg.aeonKeys.dkgOutput()))
-	signature := dkg.aeonKeys.aeonExecUnit.Sign(msgToSign, uint(dkg.index()))
+	var signature string
+	if aeonExecUnit.CanSign() {
+		signature = aeonExecUnit.Sign(msgToSign, uint(dkg.index()))
+	} else if dkg.aeonSigner != nil {
+		// The aeon signer keeps the private key it computed
+		signature, err = dkg.aeonSigner.SignAeonShare(dkg.dkgID, msgToSign)
+		if err != nil {
+			dkg.Logger.Error("sendDryRun: failed to sign with aeon signer", "err", err.Error())
+			return
+		}
+	}
 	// Broadcast message to notify everyone of completion
-	dryRun := DryRunSignature{
+	dryRun := types.DryRunSignature{
 		PublicInfo:     *dkg.aeonKeys.dkgOutput(),
 		SignatureShare: signature,
 	}
@@ -545,6 +753,15 @@ func (dkg *DistributedKeyGeneration) dispatchKeys() {
 	if dkg.dkgCompletionCallback != nil {
 		dkg.dkgCompletionCallback(dkg.aeonKeys)
 	}
+	if dkg.aeonKeys != nil {
+		dkg.eventBus.PublishEventDKGCompleted(types.EventDataDKGCompleted{
+			DKGID:     dkg.dkgID,
+			Iteration: dkg.dkgIteration,
+			Aeon:      *dkg.aeonKeys.dkgOutput(),
+		})
+	}
+
+	dkg.removeCheckpoint()
 
 	// Stop service so we do not process more blocks
 	dkg.Stop()
@@ -570,7 +787,7 @@ func (dkg *DistributedKeyGeneration) duration() int64 {
 }
 
 func (dkg *DistributedKeyGeneration) onDryRun(data string, validatorAddress string) {
-	dryRun := DryRunSignature{}
+	dryRun := types.DryRunSignature{}
 	err := cdc.UnmarshalBinaryBare([]byte(data), &dryRun)
 	if err != nil {
 		dkg.Logger.Error("onDryRun: error decoding msg", "error", err.Error())
@@ -599,10 +816,7 @@ func (dkg *DistributedKeyGeneration) receivedAllDryRuns() bool {
 
 func (dkg *DistributedKeyGeneration) checkDryRuns() bool {
 	encodedOutput := ""
-	requiredPassSize := uint(dkg.validators.Size() - dkg.validators.Size()/3)
-	if requiredPassSize < dkg.threshold {
-		requiredPassSize = dkg.threshold
-	}
+	requiredPassSize := uint(types.DryRunPassSize(dkg.validators.Size()))
 	for encodedKeys, signatures := range dkg.dryRunSignatures {
 		if uint(len(signatures)) >= requiredPassSize {
 			encodedOutput = encodedKeys
@@ -663,29 +877,7 @@ func (dkg *DistributedKeyGeneration) checkEncryptionKeys() bool {
 }
 
 func (dkg *DistributedKeyGeneration) onShares(msg string, index uint) {
-	decryptedShares, err := tmnoise.DecryptMsg(dkg.encryptionKey, dkg.encryptionPublicKeys[index], msg)
-	if err != nil {
+	if err := dkg.beaconService.OnEncryptedShares(msg, index, dkg.encryptionPublicKeys[index]); err != nil {
 		dkg.Logger.Error("onShares: error decrypting share", "error", err.Error())
 	}
-	dkg.beaconService.OnShares(decryptedShares, uint(index))
-}
-
-//-------------------------------------------------------------------------------------------
-
-// DryRunSignature is struct publishing public dkg output with group signature
-type DryRunSignature struct {
-	PublicInfo     DKGOutput `json:"public_info"`
-	SignatureShare string    `json:"group_signature"`
-}
-
-// ValidateBasic for basic validity checking of aeon file
-func (dryRun *DryRunSignature) ValidateBasic() error {
-	err := dryRun.PublicInfo.ValidateBasic()
-	if err != nil {
-		return fmt.Errorf(err.Error())
-	}
-	if len(dryRun.SignatureShare) == 0 || len(dryRun.SignatureShare) > types.MaxEntropyShareSize {
-		return fmt.Errorf("Invalid signature share size %v", len(dryRun.SignatureShare))
-	}
-	return nil
 }
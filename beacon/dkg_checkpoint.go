@@ -0,0 +1,162 @@
+package beacon
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/flynn/noise"
+	"github.com/tendermint/tendermint/crypto/keyfile"
+	tmos "github.com/tendermint/tendermint/libs/os"
+)
+
+// dkgCheckpoint is the secret state of a dkg in progress. It is saved on each state change so that
+// a node restarting during the dkg sends the same coefficients and shares it committed to, and
+// keeps the shares sent to it.
+type dkgCheckpoint struct {
+	DKGID     int64 `json:"dkg_id"`
+	Iteration int64 `json:"iteration"`
+
+	// Ephemeral encryption key of the iteration
+	EncryptionKey noise.DHKey `json:"encryption_key"`
+
+	// Serialised secret polynomials of the beacon setup service, when running the dkg in full
+	Polynomials string `json:"polynomials,omitempty"`
+	// Commitments and shares dealt by this node, when resharing
+	ReshareCommitments []string `json:"reshare_commitments,omitempty"`
+	ReshareShares      []string `json:"reshare_shares,omitempty"`
+
+	// Decrypted shares sent to this node
+	Shares []dkgCheckpointShare `json:"shares"`
+}
+
+type dkgCheckpointShare struct {
+	From  uint   `json:"from"` // validator index of sender
+	Share string `json:"share"`
+}
+
+// saveDKGCheckpoint writes checkpoint to filePath, encrypted with key if not empty
+func saveDKGCheckpoint(filePath string, key []byte, checkpoint *dkgCheckpoint) error {
+	jsonBytes, err := cdc.MarshalJSON(checkpoint)
+	if err != nil {
+		return err
+	}
+	return keyfile.WriteFile(filePath, jsonBytes, key)
+}
+
+// loadDKGCheckpoint reads the checkpoint saved in filePath, or returns nil if there is none
+func loadDKGCheckpoint(filePath string, key []byte) (*dkgCheckpoint, error) {
+	if !tmos.FileExists(filePath) {
+		return nil, nil
+	}
+	jsonBytes, err := keyfile.ReadFile(filePath, key)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &dkgCheckpoint{}
+	if err := cdc.UnmarshalJSON(jsonBytes, checkpoint); err != nil {
+		return nil, fmt.Errorf("error reading dkg checkpoint from %v: %v", filePath, err)
+	}
+	return checkpoint, nil
+}
+
+// SetCheckpointFile sets the file in which the secret state of the dkg is saved on each state
+// change, encrypted with keyEncryptionKey if not empty as the aeon key files are, and restores the
+// state saved by an earlier run of the same dkg. Must be called before the dkg is started. Dkgs run
+// by the aeon signer keep their secret state in the signer, and are not checkpointed.
+func (dkg *DistributedKeyGeneration) SetCheckpointFile(filePath string, keyEncryptionKey []byte) {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	if dkg.index() < 0 || dkg.aeonSigner != nil {
+		return
+	}
+	checkpoint, err := loadDKGCheckpoint(filePath, keyEncryptionKey)
+	if err != nil {
+		dkg.Logger.Error("SetCheckpointFile: error loading checkpoint", "file", filePath, "err", err)
+	} else if checkpoint != nil && checkpoint.DKGID > dkg.dkgID {
+		// Replaying a dkg which completed before the checkpointed one. Keep the later checkpoint
+		return
+	}
+	dkg.checkpointFile = filePath
+	dkg.checkpointKey = keyEncryptionKey
+	dkg.checkpoint = checkpoint
+	dkg.restoreCheckpoint()
+}
+
+// restoreCheckpoint restores the secret state saved by an earlier run of the current iteration
+func (dkg *DistributedKeyGeneration) restoreCheckpoint() {
+	checkpoint := dkg.checkpoint
+	service := dkg.localService()
+	if checkpoint == nil || service == nil || checkpoint.DKGID != dkg.dkgID || checkpoint.Iteration != dkg.dkgIteration {
+		return
+	}
+	if len(checkpoint.EncryptionKey.Private) != 0 {
+		service.encryptionKey = checkpoint.EncryptionKey
+	}
+	if dkg.resharing != nil {
+		if len(checkpoint.ReshareShares) == dkg.validators.Size() {
+			dkg.resharing.dealtShares = checkpoint.ReshareShares
+			dkg.resharing.commitments[uint(dkg.index())] = checkpoint.ReshareCommitments
+		}
+	} else if !service.RestorePolynomials(checkpoint.Polynomials) {
+		dkg.Logger.Error("restoreCheckpoint: failed to restore polynomials", "iteration", dkg.dkgIteration)
+		return
+	}
+	for _, share := range checkpoint.Shares {
+		if share.From >= uint(dkg.validators.Size()) {
+			continue
+		}
+		if dkg.resharing != nil {
+			dkg.resharing.shares[share.From] = share.Share
+		} else {
+			service.receivedShares[share.From] = share.Share
+			service.OnShares(share.Share, share.From)
+		}
+		dkg.sharesReceived.SetIndex(int(share.From), true)
+	}
+	dkg.Logger.Info("restoreCheckpoint: restored dkg state", "iteration", dkg.dkgIteration,
+		"shares", len(checkpoint.Shares))
+}
+
+// saveCheckpoint saves the secret state of the current iteration, if a checkpoint file is set
+func (dkg *DistributedKeyGeneration) saveCheckpoint() {
+	service := dkg.localService()
+	if len(dkg.checkpointFile) == 0 || service == nil {
+		return
+	}
+	checkpoint := &dkgCheckpoint{
+		DKGID:         dkg.dkgID,
+		Iteration:     dkg.dkgIteration,
+		EncryptionKey: service.encryptionKey,
+	}
+	shares := service.receivedShares
+	if dkg.resharing != nil {
+		checkpoint.ReshareCommitments = dkg.resharing.commitments[uint(dkg.index())]
+		checkpoint.ReshareShares = dkg.resharing.dealtShares
+		shares = dkg.resharing.shares
+	} else {
+		checkpoint.Polynomials = service.GetPolynomials()
+	}
+	for from, share := range shares {
+		checkpoint.Shares = append(checkpoint.Shares, dkgCheckpointShare{From: from, Share: share})
+	}
+	sort.Slice(checkpoint.Shares, func(i, j int) bool { return checkpoint.Shares[i].From < checkpoint.Shares[j].From })
+
+	if err := saveDKGCheckpoint(dkg.checkpointFile, dkg.checkpointKey, checkpoint); err != nil {
+		dkg.Logger.Error("saveCheckpoint: error saving checkpoint", "file", dkg.checkpointFile, "err", err)
+		return
+	}
+	dkg.checkpoint = checkpoint
+}
+
+// removeCheckpoint deletes the checkpoint once the dkg has finished
+func (dkg *DistributedKeyGeneration) removeCheckpoint() {
+	if len(dkg.checkpointFile) == 0 {
+		return
+	}
+	if err := os.Remove(dkg.checkpointFile); err != nil && !os.IsNotExist(err) {
+		dkg.Logger.Error("removeCheckpoint: error removing checkpoint", "file", dkg.checkpointFile, "err", err)
+	}
+	dkg.checkpoint = nil
+}
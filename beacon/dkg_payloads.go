@@ -0,0 +1,133 @@
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// dkgPayload is the payload of a dkg message gossiped off chain, with the signed message
+// committing to it
+type dkgPayload struct {
+	msg     *types.DKGMessage
+	payload string
+}
+
+// dkgPayloads holds the payloads of dkg messages gossiped off chain for a dkg iteration, and the
+// messages included in blocks which are waiting for their payload to arrive
+type dkgPayloads struct {
+	payloads    map[string]dkgPayload // keyed by payload hash
+	numReceived map[uint]int          // number of payloads from each validator
+	pending     []*types.DKGMessage
+}
+
+func newDKGPayloads() *dkgPayloads {
+	return &dkgPayloads{
+		payloads:    make(map[string]dkgPayload),
+		numReceived: make(map[uint]int),
+		pending:     make([]*types.DKGMessage, 0),
+	}
+}
+
+func (p *dkgPayloads) get(payloadHash []byte) (dkgPayload, bool) {
+	payload, ok := p.payloads[string(payloadHash)]
+	return payload, ok
+}
+
+func (p *dkgPayloads) add(msg *types.DKGMessage, payload string) {
+	p.payloads[string(msg.PayloadHash)] = dkgPayload{msg: msg, payload: payload}
+}
+
+// popPending returns the messages waiting for their payload and clears them
+func (p *dkgPayloads) popPending() []*types.DKGMessage {
+	pending := p.pending
+	p.pending = make([]*types.DKGMessage, 0)
+	return pending
+}
+
+//-----------------------------------------------------------------------------
+
+// SetPayloadCallbacks sets the functions with which the dkg gossips the payloads of its messages
+// off chain, and requests payloads committed to in blocks which it has not received
+func (dkg *DistributedKeyGeneration) SetPayloadCallbacks(send func(msg *types.DKGMessage, payload string),
+	request func(payloadHash []byte)) {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	dkg.sendPayloadCallback = send
+	dkg.requestPayloadCallback = request
+}
+
+// sendsPayloadOffChain returns true if messages of msgType only commit to their payload on chain.
+// Encryption keys are small, and dry runs are kept on chain so aeons can be verified from blocks
+// by light clients.
+func (dkg *DistributedKeyGeneration) sendsPayloadOffChain(msgType types.DKGMessageType) bool {
+	return dkg.config.OffChainDKGPayloads && msgType != types.DKGEncryptionKey && msgType != types.DKGDryRun
+}
+
+// maxPayloadsPerValidator bounds the payloads accepted from each validator, which sends a share to
+// each other validator and one message of each other type
+func (dkg *DistributedKeyGeneration) maxPayloadsPerValidator() int {
+	return dkg.validators.Size() + int(types.DKGDryRun)
+}
+
+// onPayload checks and stores the payload of a message gossiped off chain. Returns true if the
+// payload is new, and so should be relayed to peers. Payloads for other dkg runs or iterations
+// are ignored.
+func (dkg *DistributedKeyGeneration) onPayload(msg *types.DKGMessage, payload string) (bool, error) {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	if err := msg.ValidateBasic(); err != nil {
+		return false, err
+	}
+	if err := msg.VerifyPayload(payload); err != nil {
+		return false, err
+	}
+	if _, ok := dkg.payloads.get(msg.PayloadHash); ok {
+		return false, nil
+	}
+	if msg.DKGID != dkg.dkgID || msg.DKGIteration != dkg.dkgIteration {
+		return false, nil
+	}
+	index, val := dkg.validators.GetByAddress(msg.FromAddress)
+	if index < 0 {
+		return false, fmt.Errorf("onPayload: FromAddress not in validator set")
+	}
+	if !val.PubKey.VerifyBytes(msg.SignBytes(dkg.chainID), msg.Signature) {
+		return false, fmt.Errorf("onPayload: failed signature verification")
+	}
+	if dkg.payloads.numReceived[uint(index)] >= dkg.maxPayloadsPerValidator() {
+		dkg.Logger.Debug("onPayload: too many payloads", "from", msg.FromAddress)
+		return false, nil
+	}
+	dkg.payloads.numReceived[uint(index)]++
+	dkg.payloads.add(msg, payload)
+	return true, nil
+}
+
+// payload returns the message and payload with payloadHash held by the dkg
+func (dkg *DistributedKeyGeneration) payload(payloadHash []byte) (*types.DKGMessage, string, bool) {
+	dkg.mtx.RLock()
+	defer dkg.mtx.RUnlock()
+
+	payload, ok := dkg.payloads.get(payloadHash)
+	return payload.msg, payload.payload, ok
+}
+
+// withPayload returns msg with its off-chain payload as data. If the payload has not been
+// received the message is kept until the next block and the payload requested from peers.
+func (dkg *DistributedKeyGeneration) withPayload(msg *types.DKGMessage) (*types.DKGMessage, bool) {
+	if !msg.HasOffChainPayload() {
+		return msg, true
+	}
+	payload, ok := dkg.payloads.get(msg.PayloadHash)
+	if !ok {
+		dkg.payloads.pending = append(dkg.payloads.pending, msg)
+		if dkg.requestPayloadCallback != nil {
+			dkg.requestPayloadCallback(msg.PayloadHash)
+		}
+		return nil, false
+	}
+	return msg.WithPayload(payload.payload), true
+}
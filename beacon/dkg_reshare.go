@@ -0,0 +1,367 @@
+package beacon
+
+import (
+	"github.com/tendermint/tendermint/beacon/reshare"
+	"github.com/tendermint/tendermint/crypto"
+	bits "github.com/tendermint/tendermint/libs/bits"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Number of dkg states with non-zero duration when resharing, which skips the qual coefficient,
+// qual complaint and reconstruction states
+const reshareStatesWithDuration = dkgStatesWithDuration - 3
+
+// Payloads of reshare messages
+type reshareDealing struct {
+	Commitments []string
+}
+
+type reshareComplaint struct {
+	Dealers []uint // validator indices of dealers complained against
+}
+
+type reshareAnswer struct {
+	Members []uint // validator indices of complainers
+	Shares  []string
+}
+
+// dkgReshare holds the state of a dkg which reshares the keys of the current aeon. Dealers are
+// the qualified members of the aeon which remain validators. Each shares its key share with the
+// new validators, and the new keys are interpolated from the dealings of the dealers which answer
+// all complaints against them. Resharing uses the key shares in the node, so nodes whose dkgs are
+// run by the aeon signer only observe reshares.
+type dkgReshare struct {
+	aeon       *types.DKGOutput
+	privateKey string        // key share of this node in aeon, empty if it is not a dealer
+	dealers    map[uint]uint // aeon index of dealers keyed by validator index
+	threshold  uint          // threshold of aeon
+
+	dealtShares []string                 // shares dealt by this node
+	commitments map[uint][]string        // valid commitments keyed by validator index of dealer
+	shares      map[uint]string          // shares dealt to this node keyed by validator index of dealer
+	complaints  map[uint]map[uint]bool   // complainers against each dealer
+	answers     map[uint]map[uint]string // shares revealed by each dealer keyed by complainer
+	qual        map[uint]bool            // dealers whose shares are combined
+
+	coefficientsReceived *bits.BitArray
+	complaintsReceived   *bits.BitArray
+	answersReceived      *bits.BitArray
+}
+
+// setReshareAeon sets the dkg to reshare the keys of aeon if enough of its qualified members
+// remain validators. Returns false if the dkg must run in full.
+func (dkg *DistributedKeyGeneration) setReshareAeon(aeon *aeonDetails) bool {
+	dkg.mtx.Lock()
+	defer dkg.mtx.Unlock()
+
+	if aeon == nil || aeon.IsKeyless() {
+		return false
+	}
+	if aeon.aeonExecUnit.Name() != dkg.params.KeyType {
+		dkg.Logger.Info("setReshareAeon: aeon key type changed", "keyType", dkg.params.KeyType)
+		return false
+	}
+	r := &dkgReshare{
+		aeon:                 aeon.dkgOutput(),
+		dealers:              make(map[uint]uint),
+		threshold:            types.DKGThreshold(aeon.validators.Size()),
+		commitments:          make(map[uint][]string),
+		shares:               make(map[uint]string),
+		complaints:           make(map[uint]map[uint]bool),
+		answers:              make(map[uint]map[uint]string),
+		qual:                 make(map[uint]bool),
+		coefficientsReceived: bits.NewBitArray(dkg.validators.Size()),
+		complaintsReceived:   bits.NewBitArray(dkg.validators.Size()),
+		answersReceived:      bits.NewBitArray(dkg.validators.Size()),
+	}
+	for index, val := range dkg.validators.Validators {
+		aeonIndex, _ := aeon.validators.GetByAddress(val.Address)
+		if aeonIndex >= 0 && aeon.aeonExecUnit.InQual(uint(aeonIndex)) {
+			r.dealers[uint(index)] = uint(aeonIndex)
+			r.complaints[uint(index)] = make(map[uint]bool)
+		}
+	}
+	if uint(len(r.dealers)) < r.threshold {
+		dkg.Logger.Info("setReshareAeon: not enough dealers", "dealers", len(r.dealers), "threshold", r.threshold)
+		return false
+	}
+	if _, isDealer := r.dealers[uint(dkg.index())]; isDealer && aeon.aeonExecUnit.CanSign() {
+		r.privateKey = aeon.aeonExecUnit.PrivateKey()
+	}
+	dkg.resharing = r
+	dkg.setStates()
+	return true
+}
+
+func (dkg *DistributedKeyGeneration) setReshareStates() {
+	dkg.states[waitForEncryptionKeys] = newState(1,
+		dkg.sendEncryptionKey,
+		dkg.checkEncryptionKeys,
+		dkg.receivedAllEncryptionKeys)
+	dkg.states[waitForCoefficientsAndShares] = newState(1,
+		dkg.sendReshareDealing,
+		nil,
+		dkg.receivedAllReshareDealings)
+	dkg.states[waitForComplaints] = newState(1,
+		dkg.sendReshareComplaints,
+		nil,
+		dkg.receivedAllReshareComplaints)
+	dkg.states[waitForComplaintAnswers] = newState(1,
+		dkg.sendReshareComplaintAnswers,
+		dkg.buildReshareQual,
+		dkg.receivedAllReshareComplaintAnswers)
+	dkg.states[waitForDryRun] = newState(1,
+		dkg.computeReshareKeys,
+		dkg.checkDryRuns,
+		dkg.receivedAllDryRuns)
+}
+
+// onReshareMessage processes reshare messages, which are ignored by dkgs running in full
+func (dkg *DistributedKeyGeneration) onReshareMessage(msg *types.DKGMessage, index uint) {
+	r := dkg.resharing
+	if r == nil {
+		return
+	}
+	_, isDealer := r.dealers[index]
+	switch msg.Type {
+	case types.DKGReshareCoefficient:
+		if !isDealer || r.coefficientsReceived.GetIndex(int(index)) {
+			return
+		}
+		r.coefficientsReceived.SetIndex(int(index), true)
+		dealing := reshareDealing{}
+		if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(msg.Data), &dealing); err != nil {
+			dkg.Logger.Error("onReshareMessage: error decoding dealing", "error", err.Error())
+			return
+		}
+		if err := reshare.VerifyCommitments(r.aeon, r.dealers[index], dealing.Commitments, dkg.threshold); err != nil {
+			dkg.Logger.Error("onReshareMessage: invalid commitments", "from", index, "error", err.Error())
+			return
+		}
+		r.commitments[index] = dealing.Commitments
+	case types.DKGReshareShare:
+		if !isDealer || dkg.sharesReceived.GetIndex(int(index)) {
+			return
+		}
+		dkg.sharesReceived.SetIndex(int(index), true)
+		share, err := dkg.localService().decryptShare(msg.Data, index, dkg.encryptionPublicKeys[index])
+		if err != nil {
+			dkg.Logger.Error("onReshareMessage: error decrypting share", "error", err.Error())
+			return
+		}
+		r.shares[index] = share
+	case types.DKGReshareComplaint:
+		if r.complaintsReceived.GetIndex(int(index)) {
+			return
+		}
+		r.complaintsReceived.SetIndex(int(index), true)
+		complaint := reshareComplaint{}
+		if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(msg.Data), &complaint); err != nil {
+			dkg.Logger.Error("onReshareMessage: error decoding complaint", "error", err.Error())
+			return
+		}
+		for _, dealer := range complaint.Dealers {
+			if complainers, ok := r.complaints[dealer]; ok {
+				complainers[index] = true
+			}
+		}
+	case types.DKGReshareComplaintAnswer:
+		if !isDealer || r.answersReceived.GetIndex(int(index)) {
+			return
+		}
+		r.answersReceived.SetIndex(int(index), true)
+		answer := reshareAnswer{}
+		if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(msg.Data), &answer); err != nil ||
+			len(answer.Members) != len(answer.Shares) {
+			dkg.Logger.Error("onReshareMessage: invalid complaint answer", "from", index)
+			return
+		}
+		r.answers[index] = make(map[uint]string)
+		for i, member := range answer.Members {
+			r.answers[index][member] = answer.Shares[i]
+		}
+	}
+}
+
+func (dkg *DistributedKeyGeneration) sendReshareDealing() {
+	r := dkg.resharing
+	if len(r.privateKey) == 0 {
+		return
+	}
+	dkg.Logger.Debug("sendReshareDealing", "iteration", dkg.dkgIteration)
+	own := uint(dkg.index())
+	// Deal the shares restored from the checkpoint, if the node restarted after dealing
+	commitments, shares := r.commitments[own], r.dealtShares
+	if len(shares) == 0 {
+		var err error
+		commitments, shares, err = reshare.Deal(r.aeon, r.privateKey, uint(dkg.validators.Size()), dkg.threshold)
+		if err != nil {
+			dkg.Logger.Error("sendReshareDealing: error dealing shares", "error", err.Error())
+			return
+		}
+	}
+	r.dealtShares = shares
+	r.commitments[own] = commitments
+	r.shares[own] = shares[own]
+	r.coefficientsReceived.SetIndex(int(own), true)
+	dkg.saveCheckpoint()
+	dkg.broadcastMsg(types.DKGReshareCoefficient,
+		string(cdc.MustMarshalBinaryLengthPrefixed(&reshareDealing{Commitments: commitments})), nil)
+
+	for validator, index := range dkg.valToIndex {
+		if _, haveKeys := dkg.encryptionPublicKeys[index]; !haveKeys || index == own {
+			continue
+		}
+		encryptedMsg, err := dkg.localService().encryptShare(index, dkg.encryptionPublicKeys[index], shares[index])
+		if err != nil {
+			dkg.Logger.Error("sendReshareDealing: error encrypting share", "error", err.Error())
+			continue
+		}
+		dkg.broadcastMsg(types.DKGReshareShare, encryptedMsg, crypto.Address(validator))
+	}
+}
+
+// receivedAllReshareDealings returns true if all other dealers have sent their commitments and
+// a share to this node
+func (dkg *DistributedKeyGeneration) receivedAllReshareDealings() bool {
+	own := dkg.index()
+	for dealer := range dkg.resharing.dealers {
+		if int(dealer) == own {
+			continue
+		}
+		if !dkg.resharing.coefficientsReceived.GetIndex(int(dealer)) || !dkg.sharesReceived.GetIndex(int(dealer)) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendReshareComplaints complains against dealers with valid commitments whose share to this node
+// is missing or does not match them
+func (dkg *DistributedKeyGeneration) sendReshareComplaints() {
+	dkg.Logger.Debug("sendReshareComplaints", "iteration", dkg.dkgIteration)
+	r := dkg.resharing
+	own := uint(dkg.index())
+	complaint := reshareComplaint{Dealers: make([]uint, 0)}
+	for dealer := uint(0); dealer < uint(dkg.validators.Size()); dealer++ {
+		commitments, ok := r.commitments[dealer]
+		if !ok || dealer == own {
+			continue
+		}
+		if share, ok := r.shares[dealer]; !ok || !reshare.VerifyShare(r.aeon, commitments, own, share) {
+			delete(r.shares, dealer)
+			r.complaints[dealer][own] = true
+			complaint.Dealers = append(complaint.Dealers, dealer)
+		}
+	}
+	r.complaintsReceived.SetIndex(int(own), true)
+	dkg.broadcastMsg(types.DKGReshareComplaint, string(cdc.MustMarshalBinaryLengthPrefixed(&complaint)), nil)
+}
+
+func (dkg *DistributedKeyGeneration) receivedAllReshareComplaints() bool {
+	return dkg.resharing.complaintsReceived.IsFull()
+}
+
+// sendReshareComplaintAnswers reveals the shares dealt by this node to the validators which
+// complained against it
+func (dkg *DistributedKeyGeneration) sendReshareComplaintAnswers() {
+	r := dkg.resharing
+	if r.dealtShares == nil {
+		return
+	}
+	dkg.Logger.Debug("sendReshareComplaintAnswers", "iteration", dkg.dkgIteration)
+	own := uint(dkg.index())
+	answer := reshareAnswer{Members: make([]uint, 0), Shares: make([]string, 0)}
+	for member := uint(0); member < uint(dkg.validators.Size()); member++ {
+		if r.complaints[own][member] {
+			answer.Members = append(answer.Members, member)
+			answer.Shares = append(answer.Shares, r.dealtShares[member])
+		}
+	}
+	r.answersReceived.SetIndex(int(own), true)
+	dkg.broadcastMsg(types.DKGReshareComplaintAnswer, string(cdc.MustMarshalBinaryLengthPrefixed(&answer)), nil)
+}
+
+// receivedAllReshareComplaintAnswers returns true if all dealers with valid commitments have
+// answered the complaints against them
+func (dkg *DistributedKeyGeneration) receivedAllReshareComplaintAnswers() bool {
+	for dealer := range dkg.resharing.commitments {
+		if !dkg.resharing.answersReceived.GetIndex(int(dealer)) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildReshareQual selects the dealers with valid commitments which answered every complaint
+// against them with a valid share, and fewer complaints than the threshold so their polynomial
+// is not revealed. Fails if there are fewer qualified dealers than the threshold of the aeon.
+func (dkg *DistributedKeyGeneration) buildReshareQual() bool {
+	r := dkg.resharing
+	own := uint(dkg.index())
+	for dealer, commitments := range r.commitments {
+		complainers := r.complaints[dealer]
+		if uint(len(complainers)) >= dkg.threshold {
+			continue
+		}
+		answered := true
+		for member := range complainers {
+			share, ok := r.answers[dealer][member]
+			if !ok || !reshare.VerifyShare(r.aeon, commitments, member, share) {
+				answered = false
+				break
+			}
+			if member == own {
+				r.shares[dealer] = share
+			}
+		}
+		if answered {
+			r.qual[dealer] = true
+		}
+	}
+	if uint(len(r.qual)) < r.threshold {
+		dkg.Logger.Info("buildReshareQual: DKG failed", "iteration", dkg.dkgIteration, "qual", len(r.qual))
+		return false
+	}
+	return true
+}
+
+// computeReshareKeys combines the dealings of the qualified dealers into the keys of the new aeon,
+// which keeps the group public key of the aeon reshared
+func (dkg *DistributedKeyGeneration) computeReshareKeys() {
+	r := dkg.resharing
+	commitments := make(map[uint][]string)
+	shares := make(map[uint]string)
+	for dealer := range r.qual {
+		commitments[r.dealers[dealer]] = r.commitments[dealer]
+		if share, ok := r.shares[dealer]; ok {
+			shares[r.dealers[dealer]] = share
+		}
+	}
+	publicKeyShares, err := reshare.CombinePublicKeyShares(r.aeon, commitments, uint(dkg.validators.Size()))
+	if err != nil {
+		dkg.Logger.Error("computeReshareKeys", "err", err.Error())
+		return
+	}
+	if len(shares) != len(commitments) {
+		dkg.Logger.Error("computeReshareKeys: missing shares", "have", len(shares), "want", len(commitments))
+		return
+	}
+	privateKey, err := reshare.CombineShares(shares)
+	if err != nil {
+		dkg.Logger.Error("computeReshareKeys", "err", err.Error())
+		return
+	}
+	output := &types.DKGOutput{
+		KeyType:         r.aeon.KeyType,
+		GroupPublicKey:  r.aeon.GroupPublicKey,
+		Generator:       r.aeon.Generator,
+		PublicKeyShares: publicKeyShares,
+		ValidatorHeight: dkg.validatorHeight,
+		Qual:            make([]uint, dkg.validators.Size()),
+	}
+	for i := range output.Qual {
+		output.Qual[i] = uint(i)
+	}
+	dkg.sendDryRun(aeonExecUnitFromOutput(output, privateKey))
+}
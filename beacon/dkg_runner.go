@@ -5,7 +5,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/flynn/noise"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/service"
 	sm "github.com/tendermint/tendermint/state"
@@ -28,6 +27,7 @@ type DKGRunner struct {
 	height       int64
 	aeonStart    int64 // next entropy generation start
 	aeonEnd      int64 // next entropy generation end
+	currentAeon  *aeonDetails
 	validators   types.ValidatorSet
 	activeDKG    *DistributedKeyGeneration
 	completedDKG bool
@@ -37,28 +37,34 @@ type DKGRunner struct {
 	dkgCompletionCallback func(aeon *aeonDetails)
 	fastSync              bool
 
-	encryptionKey noise.DHKey
+	sendPayloadCallback    func(msg *types.DKGMessage, payload string)
+	requestPayloadCallback func(payloadHash []byte)
 
-	mtx     sync.Mutex
-	metrics *Metrics
+	checkpointFile string          // secret state of the active dkg is saved to this file, if set
+	checkpointKey  []byte          // key-encryption key of the checkpoint
+	aeonSigner     types.DKGSigner // runs the steps of dkgs with secret state, if set
+
+	mtx      sync.Mutex
+	metrics  *Metrics
+	eventBus types.BeaconEventPublisher
 }
 
 // NewDKGRunner creates struct for starting new DKGs
 func NewDKGRunner(config *cfg.BeaconConfig, chain string, db dbm.DB, val types.PrivValidator,
-	encryptionKey noise.DHKey, blockHeight int64) *DKGRunner {
+	blockHeight int64) *DKGRunner {
 	dkgRunner := &DKGRunner{
-		beaconConfig:  config,
-		chainID:       chain,
-		stateDB:       db,
-		privVal:       val,
-		height:        blockHeight,
-		aeonStart:     -1,
-		aeonEnd:       -1,
-		completedDKG:  false,
-		dkgCounter:    0,
-		metrics:       NopMetrics(),
-		fastSync:      false,
-		encryptionKey: encryptionKey,
+		beaconConfig: config,
+		chainID:      chain,
+		stateDB:      db,
+		privVal:      val,
+		height:       blockHeight,
+		aeonStart:    -1,
+		aeonEnd:      -1,
+		completedDKG: false,
+		dkgCounter:   0,
+		metrics:      NopMetrics(),
+		eventBus:     types.NopEventBus{},
+		fastSync:     false,
 	}
 	dkgRunner.BaseService = *service.NewBaseService(nil, "DKGRunner", dkgRunner)
 
@@ -72,6 +78,35 @@ func (dkgRunner *DKGRunner) AttachMetrics(metrics *Metrics) {
 	}
 }
 
+// SetDKGCheckpointFile sets the file in which the secret state of the active dkg is saved, encrypted
+// with keyEncryptionKey if not empty, so that the node can finish a dkg it had started after
+// restarting. Must be called before FastSync.
+func (dkgRunner *DKGRunner) SetDKGCheckpointFile(filePath string, keyEncryptionKey []byte) {
+	dkgRunner.mtx.Lock()
+	defer dkgRunner.mtx.Unlock()
+
+	dkgRunner.checkpointFile = filePath
+	dkgRunner.checkpointKey = keyEncryptionKey
+}
+
+// SetAeonSigner sets the signer which runs the steps of dkgs using secret state, so that the shares
+// sent to the node and the private keys computed from them stay with the signer. Must be called
+// before FastSync.
+func (dkgRunner *DKGRunner) SetAeonSigner(aeonSigner types.DKGSigner) {
+	dkgRunner.mtx.Lock()
+	defer dkgRunner.mtx.Unlock()
+
+	dkgRunner.aeonSigner = aeonSigner
+}
+
+// SetEventBus sets the event bus on which the events of all dkgs run are published
+func (dkgRunner *DKGRunner) SetEventBus(eventBus types.BeaconEventPublisher) {
+	dkgRunner.mtx.Lock()
+	defer dkgRunner.mtx.Unlock()
+
+	dkgRunner.eventBus = eventBus
+}
+
 // SetDKGCompletionCallback for dispatching dkg output
 func (dkgRunner *DKGRunner) SetDKGCompletionCallback(callback func(aeon *aeonDetails)) {
 	dkgRunner.mtx.Lock()
@@ -89,6 +124,42 @@ func (dkgRunner *DKGRunner) AttachMessageHandler(handler tx_extensions.MessageHa
 	dkgRunner.messageHandler.WhenChainTxSeen(dkgRunner.OnBlock)
 }
 
+// SetDKGPayloadCallbacks sets the functions with which dkgs gossip the payloads of messages
+// off chain, and request payloads committed to in blocks which they have not received
+func (dkgRunner *DKGRunner) SetDKGPayloadCallbacks(send func(msg *types.DKGMessage, payload string),
+	request func(payloadHash []byte)) {
+	dkgRunner.mtx.Lock()
+	defer dkgRunner.mtx.Unlock()
+
+	dkgRunner.sendPayloadCallback = send
+	dkgRunner.requestPayloadCallback = request
+}
+
+// OnDKGPayload passes the payload of a dkg message gossiped off chain to the active dkg. Returns
+// true if the payload is new, and so should be relayed to peers
+func (dkgRunner *DKGRunner) OnDKGPayload(msg *types.DKGMessage, payload string) (bool, error) {
+	dkgRunner.mtx.Lock()
+	activeDKG := dkgRunner.activeDKG
+	dkgRunner.mtx.Unlock()
+
+	if activeDKG == nil {
+		return false, nil
+	}
+	return activeDKG.onPayload(msg, payload)
+}
+
+// DKGPayload returns the message and off-chain payload with payloadHash held by the active dkg
+func (dkgRunner *DKGRunner) DKGPayload(payloadHash []byte) (*types.DKGMessage, string, bool) {
+	dkgRunner.mtx.Lock()
+	activeDKG := dkgRunner.activeDKG
+	dkgRunner.mtx.Unlock()
+
+	if activeDKG == nil {
+		return nil, "", false
+	}
+	return activeDKG.payload(payloadHash)
+}
+
 // SetCurrentAeon sets the entropy generation aeon currently active
 func (dkgRunner *DKGRunner) SetCurrentAeon(aeon *aeonDetails) {
 	dkgRunner.mtx.Lock()
@@ -99,6 +170,19 @@ func (dkgRunner *DKGRunner) SetCurrentAeon(aeon *aeonDetails) {
 	}
 	dkgRunner.aeonStart = aeon.Start
 	dkgRunner.aeonEnd = aeon.End
+	dkgRunner.currentAeon = aeon
+}
+
+// DKGStatus returns the status of the active dkg, or nil if there is none
+func (dkgRunner *DKGRunner) DKGStatus() *types.DKGStatus {
+	dkgRunner.mtx.Lock()
+	activeDKG := dkgRunner.activeDKG
+	dkgRunner.mtx.Unlock()
+
+	if activeDKG == nil {
+		return nil
+	}
+	return activeDKG.status()
 }
 
 // FastSync runs a dkg from block messages up to current block height
@@ -170,12 +254,12 @@ func (dkgRunner *DKGRunner) OnBlock(blockHeight int64, entropy types.ThresholdSi
 	dkgRunner.mtx.Unlock()
 }
 
-// Returns validators for height from state DB
-func (dkgRunner *DKGRunner) findValidatorsAndParams(height int64) (*types.ValidatorSet, int64) {
+// Returns validators and entropy params for height from state DB
+func (dkgRunner *DKGRunner) findValidatorsAndParams(height int64) (*types.ValidatorSet, types.EntropyParams) {
 	for {
 		if !dkgRunner.fastSync && !dkgRunner.IsRunning() {
 			dkgRunner.Logger.Debug("findValidators: exiting", "height", dkgRunner.height)
-			return nil, 0
+			return nil, types.EntropyParams{}
 		}
 
 		newVals, err := sm.LoadValidators(dkgRunner.stateDB, height)
@@ -184,7 +268,7 @@ func (dkgRunner *DKGRunner) findValidatorsAndParams(height int64) (*types.Valida
 			time.Sleep(100 * time.Millisecond)
 		} else {
 			dkgRunner.Logger.Debug("findValidators: vals updated", "height", height)
-			return newVals, newParams.Entropy.AeonLength
+			return newVals, newParams.Entropy
 		}
 	}
 }
@@ -205,31 +289,45 @@ func (dkgRunner *DKGRunner) checkNextDKG() {
 			// Only time when there is no previous aeon is first dkg from genesis
 			validatorHeight = 1
 		}
-		vals, aeonLength := dkgRunner.findValidatorsAndParams(validatorHeight)
+		vals, params := dkgRunner.findValidatorsAndParams(validatorHeight)
 		if vals == nil {
 			// Should only return nil if dkg runner is stopped and not in fast sync
 			dkgRunner.Logger.Debug("findValidatorsAndParams return nil vals", "fastSync",
 				dkgRunner.fastSync, "dkgRunner running", dkgRunner.IsRunning())
 			return
 		}
-		dkgRunner.startNewDKG(validatorHeight, vals, aeonLength)
+		dkgRunner.startNewDKG(validatorHeight, vals, params)
 	}
 }
 
 // Starts new DKG if old one has completed for those in the current validator set
-func (dkgRunner *DKGRunner) startNewDKG(validatorHeight int64, validators *types.ValidatorSet, aeonLength int64) {
+func (dkgRunner *DKGRunner) startNewDKG(validatorHeight int64, validators *types.ValidatorSet,
+	params types.EntropyParams) {
 	dkgRunner.Logger.Debug("startNewDKG: successful", "height", validatorHeight)
 	// Create new dkg that starts DKGResetDelay after most recent block height
 	dkgRunner.activeDKG = NewDistributedKeyGeneration(dkgRunner.beaconConfig, dkgRunner.chainID,
-		dkgRunner.privVal, dkgRunner.encryptionKey, validatorHeight, *validators, dkgRunner.aeonEnd, aeonLength)
+		dkgRunner.privVal, validatorHeight, *validators, dkgRunner.aeonEnd, params)
 	// Set logger with dkgID and node index for debugging
 	dkgLogger := dkgRunner.Logger.With("dkgID", dkgRunner.activeDKG.dkgID)
 	dkgLogger.With("index", dkgRunner.activeDKG.index())
 	dkgRunner.activeDKG.SetLogger(dkgLogger)
+	if dkgRunner.aeonSigner != nil {
+		dkgRunner.activeDKG.SetAeonSigner(dkgRunner.aeonSigner)
+	}
+	// Reshare the keys of the current aeon if enabled, so the group public key is kept
+	if params.DKGReshare && dkgRunner.activeDKG.setReshareAeon(dkgRunner.currentAeon) {
+		dkgLogger.Info("startNewDKG: resharing keys of current aeon", "aeonEnd", dkgRunner.aeonEnd)
+	}
+	// Restore the secret state of the dkg if the node restarted during it
+	if len(dkgRunner.checkpointFile) != 0 {
+		dkgRunner.activeDKG.SetCheckpointFile(dkgRunner.checkpointFile, dkgRunner.checkpointKey)
+	}
 	// Set message handler for sending DKG transactions
 	dkgRunner.activeDKG.SetSendMsgCallback(func(msg *types.DKGMessage) {
 		dkgRunner.messageHandler.SubmitSpecialTx(msg)
 	})
+	// Gossip payloads of messages which only commit to them on chain
+	dkgRunner.activeDKG.SetPayloadCallbacks(dkgRunner.sendPayloadCallback, dkgRunner.requestPayloadCallback)
 	// Mark dkg completion so so that activeDKG can be reset and set start and end
 	// of next entropy aeon
 	dkgRunner.activeDKG.SetDkgCompletionCallback(func(keys *aeonDetails) {
@@ -254,4 +352,5 @@ func (dkgRunner *DKGRunner) startNewDKG(validatorHeight int64, validators *types
 			dkgRunner.activeDKG.duration()+1))
 	}
 	dkgRunner.activeDKG.attachMetrics(dkgRunner.metrics)
+	dkgRunner.activeDKG.SetEventBus(dkgRunner.eventBus)
 }
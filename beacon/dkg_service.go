@@ -0,0 +1,426 @@
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/flynn/noise"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnoise "github.com/tendermint/tendermint/noise"
+	"github.com/tendermint/tendermint/types"
+)
+
+// dkgService runs the steps of a dkg iteration which use the secret state of this node: the
+// ephemeral key with which shares are encrypted to it, the polynomials it deals and the shares
+// dealt to it
+type dkgService interface {
+	EncryptionKey() []byte
+
+	GetCoefficients() string
+	GetEncryptedShare(to uint, encryptionKey []byte) (string, error)
+	GetComplaints() string
+	GetComplaintAnswers() string
+	GetQualCoefficients() string
+	GetQualComplaints() string
+	GetReconstructionShares() string
+
+	OnCoefficients(coefficients string, from uint)
+	OnEncryptedShares(msg string, from uint, encryptionKey []byte) error
+	OnComplaints(complaints string, from uint)
+	OnComplaintAnswers(answers string, from uint)
+	OnQualCoefficients(coefficients string, from uint)
+	OnQualComplaints(complaints string, from uint)
+	OnReconstructionShares(shares string, from uint)
+
+	ReceivedAllCoefficientsAndShares() bool
+	ReceivedAllComplaints() bool
+	ReceivedAllComplaintAnswers() bool
+	ReceivedAllQualCoefficients() bool
+	ReceivedAllQualComplaints() bool
+	ReceivedAllReconstructionShares() bool
+
+	BuildQual() uint
+	CheckQualComplaints() bool
+	RunReconstruction() bool
+	// ComputeKeys computes the keys of the aeon with the heights of output
+	ComputeKeys(output *types.DKGOutput) (BaseAeon, error)
+
+	Delete()
+}
+
+// dkgShareContext is the context in which a share is sent
+type dkgShareContext struct {
+	ChainID   string
+	DKGID     int64
+	Iteration int64
+	From      uint // validator index of sender
+	To        uint // validator index of recipient
+}
+
+//-----------------------------------------------------------------------------
+
+// localDKGService runs the dkg with the beacon setup service, holding the secret state in this
+// process
+type localDKGService struct {
+	BeaconSetupService
+
+	chainID     string
+	dkgID       int64
+	iteration   int64
+	cabinetSize uint
+	index       uint
+
+	encryptionKey  noise.DHKey     // ephemeral key of the iteration, with which shares are encrypted
+	receivedShares map[uint]string // decrypted shares keyed by validator index of sender
+}
+
+var _ dkgService = (*localDKGService)(nil)
+
+func newLocalDKGService(chainID string, dkgID int64, iteration int64, cabinetSize uint, threshold uint,
+	index uint, keyType string) *localDKGService {
+	return &localDKGService{
+		BeaconSetupService: NewBeaconSetupService(cabinetSize, threshold, index, keyType),
+		chainID:            chainID,
+		dkgID:              dkgID,
+		iteration:          iteration,
+		cabinetSize:        cabinetSize,
+		index:              index,
+		encryptionKey:      tmnoise.NewEncryptionKey(),
+		receivedShares:     make(map[uint]string),
+	}
+}
+
+func (service *localDKGService) EncryptionKey() []byte {
+	return service.encryptionKey.Public
+}
+
+func (service *localDKGService) GetEncryptedShare(to uint, encryptionKey []byte) (string, error) {
+	return service.encryptShare(to, encryptionKey, service.GetShare(to))
+}
+
+func (service *localDKGService) OnEncryptedShares(msg string, from uint, encryptionKey []byte) error {
+	share, err := service.decryptShare(msg, from, encryptionKey)
+	if err == nil {
+		if _, haveShares := service.receivedShares[from]; !haveShares {
+			service.receivedShares[from] = share
+		}
+	}
+	service.OnShares(share, from)
+	return err
+}
+
+func (service *localDKGService) ComputeKeys(output *types.DKGOutput) (BaseAeon, error) {
+	return service.ComputePublicKeys(), nil
+}
+
+func (service *localDKGService) Delete() {
+	DeleteBeaconSetupService(service.BeaconSetupService)
+}
+
+// encryptShare encrypts share to validator index to with its ephemeral encryption key
+func (service *localDKGService) encryptShare(to uint, encryptionKey []byte, share string) (string, error) {
+	return tmnoise.EncryptMsg(service.encryptionKey, encryptionKey, service.sharePrologue(service.index, to), share)
+}
+
+// decryptShare decrypts a share sent to this node by validator index from
+func (service *localDKGService) decryptShare(msg string, from uint, encryptionKey []byte) (string, error) {
+	return tmnoise.DecryptMsg(service.encryptionKey, encryptionKey, service.sharePrologue(from, service.index), msg)
+}
+
+// sharePrologue returns the prologue of the encryption of a share sent from validator index from
+// to validator index to. Binding the share to its context prevents it being replayed in another
+// dkg, iteration or to another recipient.
+func (service *localDKGService) sharePrologue(from uint, to uint) []byte {
+	return cdc.MustMarshalBinaryBare(&dkgShareContext{
+		ChainID:   service.chainID,
+		DKGID:     service.dkgID,
+		Iteration: service.iteration,
+		From:      from,
+		To:        to,
+	})
+}
+
+// runStep runs a step of the dkg requested by a remote node. Shares are only ever returned
+// encrypted, and the keys are computed by the caller
+func (service *localDKGService) runStep(step *types.DKGStep) (*types.DKGStepResult, error) {
+	if step.From >= service.cabinetSize || step.To >= service.cabinetSize {
+		return nil, fmt.Errorf("validator index out of range")
+	}
+	result := &types.DKGStepResult{}
+	switch step.Type {
+	case types.DKGStepGetMessage:
+		switch step.MessageType {
+		case types.DKGCoefficient:
+			result.Data = service.GetCoefficients()
+		case types.DKGComplaint:
+			result.Data = service.GetComplaints()
+		case types.DKGComplaintAnswer:
+			result.Data = service.GetComplaintAnswers()
+		case types.DKGQualCoefficient:
+			result.Data = service.GetQualCoefficients()
+		case types.DKGQualComplaint:
+			result.Data = service.GetQualComplaints()
+		case types.DKGReconstructionShare:
+			result.Data = service.GetReconstructionShares()
+		default:
+			return nil, fmt.Errorf("unexpected message type %v", step.MessageType)
+		}
+	case types.DKGStepGetShare:
+		share, err := service.GetEncryptedShare(step.To, step.EncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		result.Data = share
+	case types.DKGStepOnMessage:
+		switch step.MessageType {
+		case types.DKGShare:
+			if err := service.OnEncryptedShares(step.Data, step.From, step.EncryptionKey); err != nil {
+				return nil, err
+			}
+		case types.DKGCoefficient:
+			service.OnCoefficients(step.Data, step.From)
+		case types.DKGComplaint:
+			service.OnComplaints(step.Data, step.From)
+		case types.DKGComplaintAnswer:
+			service.OnComplaintAnswers(step.Data, step.From)
+		case types.DKGQualCoefficient:
+			service.OnQualCoefficients(step.Data, step.From)
+		case types.DKGQualComplaint:
+			service.OnQualComplaints(step.Data, step.From)
+		case types.DKGReconstructionShare:
+			service.OnReconstructionShares(step.Data, step.From)
+		default:
+			return nil, fmt.Errorf("unexpected message type %v", step.MessageType)
+		}
+	case types.DKGStepReceivedAll:
+		switch step.MessageType {
+		case types.DKGCoefficient, types.DKGShare:
+			result.Success = service.ReceivedAllCoefficientsAndShares()
+		case types.DKGComplaint:
+			result.Success = service.ReceivedAllComplaints()
+		case types.DKGComplaintAnswer:
+			result.Success = service.ReceivedAllComplaintAnswers()
+		case types.DKGQualCoefficient:
+			result.Success = service.ReceivedAllQualCoefficients()
+		case types.DKGQualComplaint:
+			result.Success = service.ReceivedAllQualComplaints()
+		case types.DKGReconstructionShare:
+			result.Success = service.ReceivedAllReconstructionShares()
+		default:
+			return nil, fmt.Errorf("unexpected message type %v", step.MessageType)
+		}
+	case types.DKGStepBuildQual:
+		result.QualSize = service.BuildQual()
+	case types.DKGStepCheckQualComplaints:
+		result.Success = service.CheckQualComplaints()
+	case types.DKGStepRunReconstruction:
+		result.Success = service.RunReconstruction()
+	default:
+		return nil, fmt.Errorf("unexpected dkg step %v", step.Type)
+	}
+	return result, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// remoteDKGService runs the dkg with a DKGSigner, which holds the secret state. The private key
+// computed by the dkg stays with the signer.
+type remoteDKGService struct {
+	signer  types.DKGSigner
+	chainID string
+	start   types.DKGStep
+	logger  log.Logger
+
+	encryptionKey []byte // set once the iteration is started by the signer
+}
+
+var _ dkgService = (*remoteDKGService)(nil)
+
+func newRemoteDKGService(signer types.DKGSigner, chainID string, dkgID int64, iteration int64,
+	cabinetSize uint, threshold uint, index uint, keyType string, logger log.Logger) *remoteDKGService {
+	return &remoteDKGService{
+		signer:  signer,
+		chainID: chainID,
+		start: types.DKGStep{
+			Type:        types.DKGStepStart,
+			DKGID:       dkgID,
+			Iteration:   iteration,
+			CabinetSize: cabinetSize,
+			Threshold:   threshold,
+			Index:       index,
+			KeyType:     keyType,
+		},
+		logger: logger,
+	}
+}
+
+// startIteration starts the iteration with the signer, if it has not been started already
+func (service *remoteDKGService) startIteration() error {
+	if service.encryptionKey != nil {
+		return nil
+	}
+	result, err := service.signer.DKGStep(service.chainID, &service.start)
+	if err != nil {
+		service.logger.Error("remoteDKGService: failed to start dkg", "err", err)
+		return err
+	}
+	if len(result.EncryptionKey) != noise.DH25519.DHLen() {
+		service.logger.Error("remoteDKGService: invalid encryption key from signer")
+		return fmt.Errorf("invalid encryption key")
+	}
+	service.encryptionKey = result.EncryptionKey
+	return nil
+}
+
+// run runs step of the iteration with the signer. Returns an empty result if the step fails
+func (service *remoteDKGService) run(step types.DKGStep) (*types.DKGStepResult, error) {
+	if err := service.startIteration(); err != nil {
+		return &types.DKGStepResult{}, err
+	}
+	step.DKGID = service.start.DKGID
+	step.Iteration = service.start.Iteration
+	result, err := service.signer.DKGStep(service.chainID, &step)
+	if err != nil {
+		service.logger.Error("remoteDKGService: failed dkg step", "step", step.Type, "msgType", step.MessageType,
+			"err", err)
+		return &types.DKGStepResult{}, err
+	}
+	return result, nil
+}
+
+func (service *remoteDKGService) getMessage(msgType types.DKGMessageType) string {
+	result, _ := service.run(types.DKGStep{Type: types.DKGStepGetMessage, MessageType: msgType})
+	return result.Data
+}
+
+func (service *remoteDKGService) onMessage(msgType types.DKGMessageType, data string, from uint) {
+	service.run(types.DKGStep{Type: types.DKGStepOnMessage, MessageType: msgType, Data: data, From: from})
+}
+
+func (service *remoteDKGService) receivedAll(msgType types.DKGMessageType) bool {
+	result, _ := service.run(types.DKGStep{Type: types.DKGStepReceivedAll, MessageType: msgType})
+	return result.Success
+}
+
+func (service *remoteDKGService) EncryptionKey() []byte {
+	service.startIteration()
+	return service.encryptionKey
+}
+
+func (service *remoteDKGService) GetCoefficients() string {
+	return service.getMessage(types.DKGCoefficient)
+}
+
+func (service *remoteDKGService) GetEncryptedShare(to uint, encryptionKey []byte) (string, error) {
+	result, err := service.run(types.DKGStep{Type: types.DKGStepGetShare, To: to, EncryptionKey: encryptionKey})
+	return result.Data, err
+}
+
+func (service *remoteDKGService) GetComplaints() string {
+	return service.getMessage(types.DKGComplaint)
+}
+
+func (service *remoteDKGService) GetComplaintAnswers() string {
+	return service.getMessage(types.DKGComplaintAnswer)
+}
+
+func (service *remoteDKGService) GetQualCoefficients() string {
+	return service.getMessage(types.DKGQualCoefficient)
+}
+
+func (service *remoteDKGService) GetQualComplaints() string {
+	return service.getMessage(types.DKGQualComplaint)
+}
+
+func (service *remoteDKGService) GetReconstructionShares() string {
+	return service.getMessage(types.DKGReconstructionShare)
+}
+
+func (service *remoteDKGService) OnCoefficients(coefficients string, from uint) {
+	service.onMessage(types.DKGCoefficient, coefficients, from)
+}
+
+func (service *remoteDKGService) OnEncryptedShares(msg string, from uint, encryptionKey []byte) error {
+	_, err := service.run(types.DKGStep{Type: types.DKGStepOnMessage, MessageType: types.DKGShare, Data: msg,
+		From: from, EncryptionKey: encryptionKey})
+	return err
+}
+
+func (service *remoteDKGService) OnComplaints(complaints string, from uint) {
+	service.onMessage(types.DKGComplaint, complaints, from)
+}
+
+func (service *remoteDKGService) OnComplaintAnswers(answers string, from uint) {
+	service.onMessage(types.DKGComplaintAnswer, answers, from)
+}
+
+func (service *remoteDKGService) OnQualCoefficients(coefficients string, from uint) {
+	service.onMessage(types.DKGQualCoefficient, coefficients, from)
+}
+
+func (service *remoteDKGService) OnQualComplaints(complaints string, from uint) {
+	service.onMessage(types.DKGQualComplaint, complaints, from)
+}
+
+func (service *remoteDKGService) OnReconstructionShares(shares string, from uint) {
+	service.onMessage(types.DKGReconstructionShare, shares, from)
+}
+
+func (service *remoteDKGService) ReceivedAllCoefficientsAndShares() bool {
+	return service.receivedAll(types.DKGCoefficient)
+}
+
+func (service *remoteDKGService) ReceivedAllComplaints() bool {
+	return service.receivedAll(types.DKGComplaint)
+}
+
+func (service *remoteDKGService) ReceivedAllComplaintAnswers() bool {
+	return service.receivedAll(types.DKGComplaintAnswer)
+}
+
+func (service *remoteDKGService) ReceivedAllQualCoefficients() bool {
+	return service.receivedAll(types.DKGQualCoefficient)
+}
+
+func (service *remoteDKGService) ReceivedAllQualComplaints() bool {
+	return service.receivedAll(types.DKGQualComplaint)
+}
+
+func (service *remoteDKGService) ReceivedAllReconstructionShares() bool {
+	return service.receivedAll(types.DKGReconstructionShare)
+}
+
+func (service *remoteDKGService) BuildQual() uint {
+	result, _ := service.run(types.DKGStep{Type: types.DKGStepBuildQual})
+	return result.QualSize
+}
+
+func (service *remoteDKGService) CheckQualComplaints() bool {
+	result, _ := service.run(types.DKGStep{Type: types.DKGStepCheckQualComplaints})
+	return result.Success
+}
+
+func (service *remoteDKGService) RunReconstruction() bool {
+	result, _ := service.run(types.DKGStep{Type: types.DKGStepRunReconstruction})
+	return result.Success
+}
+
+// ComputeKeys returns an aeon execution unit with the public keys computed by the signer, which
+// keeps the private key
+func (service *remoteDKGService) ComputeKeys(output *types.DKGOutput) (BaseAeon, error) {
+	result, err := service.run(types.DKGStep{Type: types.DKGStepComputeKeys, Output: output})
+	if err != nil {
+		return nil, err
+	}
+	if result.Output == nil {
+		return nil, fmt.Errorf("no keys computed by signer")
+	}
+	if err := result.Output.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if len(result.Output.GroupPublicKey) == 0 || uint(len(result.Output.PublicKeyShares)) != service.start.CabinetSize {
+		return nil, fmt.Errorf("invalid keys computed by signer")
+	}
+	return aeonExecUnitFromOutput(result.Output, ""), nil
+}
+
+func (service *remoteDKGService) Delete() {}
@@ -0,0 +1,541 @@
+package beacon
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/tx_extensions"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// dkgSimulator runs the dkg runner and entropy generator of each node of a network over a
+// simulated chain. Blocks are only produced when requested, and the dkg messages sent by nodes
+// can be withheld, corrupted or delayed, nodes taken offline and the validator set changed at
+// given heights, so that dkg scenarios can be run deterministically without full nodes.
+type dkgSimulator struct {
+	t       *testing.T
+	chainID string
+	stateDB dbm.DB
+	state   sm.State
+	nodes   []*simNode
+
+	height           int64
+	blocks           map[int64][]*types.DKGMessage // dkg messages included in each block
+	pending          map[int64][]*types.DKGMessage // dkg messages to be included in future blocks
+	validatorChanges map[int64][]int               // indices of nodes which become validators
+}
+
+// simNode is a node of the simulated network
+type simNode struct {
+	index            int
+	privVal          types.PrivValidator
+	config           *cfg.Config
+	dkgRunner        *DKGRunner
+	entropyGenerator *EntropyGenerator
+	handler          *simMessageHandler
+
+	faults      []msgFault
+	offlineFrom int64 // node neither receives blocks nor sends messages in [offlineFrom, offlineTo)
+	offlineTo   int64
+	lastBlock   int64 // last block delivered to the node
+
+	aeons   []*aeonDetails               // aeons with keys output by the dkgs run by the node
+	entropy map[int64]types.BlockEntropy // entropy generated by the node for each block
+}
+
+// msgFault alters a dkg message sent by a faulty node. It returns the message to include in the
+// chain, or nil to withhold it, and the number of blocks by which its inclusion is delayed.
+type msgFault func(node *simNode, msg *types.DKGMessage) (*types.DKGMessage, int64)
+
+// newDKGSimulator creates a simulated network of nVals genesis validators and nSpare nodes
+// which are not validators at genesis, with the given aeon length
+func newDKGSimulator(t *testing.T, nVals int, nSpare int, aeonLength int64) *dkgSimulator {
+	genDoc, privVals := randGenesisDoc(nVals, false, 30)
+	genDoc.ConsensusParams = types.DefaultConsensusParams()
+	genDoc.ConsensusParams.Entropy.AeonLength = aeonLength
+	stateDB := dbm.NewMemDB()
+	state, err := sm.LoadStateFromDBOrGenesisDoc(stateDB, genDoc)
+	require.NoError(t, err)
+
+	for i := 0; i < nSpare; i++ {
+		_, privVal := types.RandValidator(false, 30)
+		privVals = append(privVals, privVal)
+	}
+
+	sim := &dkgSimulator{
+		t:                t,
+		chainID:          genDoc.ChainID,
+		stateDB:          stateDB,
+		state:            state,
+		nodes:            make([]*simNode, len(privVals)),
+		blocks:           make(map[int64][]*types.DKGMessage),
+		pending:          make(map[int64][]*types.DKGMessage),
+		validatorChanges: make(map[int64][]int),
+	}
+	for i, privVal := range privVals {
+		sim.nodes[i] = sim.newNode(i, privVal, state.LastComputedEntropy)
+	}
+	return sim
+}
+
+func (sim *dkgSimulator) newNode(index int, privVal types.PrivValidator,
+	genesisEntropy types.ThresholdSignature) *simNode {
+	config := cfg.ResetTestRoot(fmt.Sprintf("dkg_simulator_%d", index))
+	logger := log.TestingLogger().With("node", index)
+	node := &simNode{
+		index:   index,
+		privVal: privVal,
+		config:  config,
+		entropy: make(map[int64]types.BlockEntropy),
+	}
+	node.handler = &simMessageHandler{sim: sim, node: node}
+
+	node.entropyGenerator = NewEntropyGenerator(&config.BaseConfig, config.Beacon, 0)
+	node.entropyGenerator.SetLogger(logger)
+	node.entropyGenerator.SetLastComputedEntropy(0, genesisEntropy)
+
+	node.dkgRunner = NewDKGRunner(config.Beacon, sim.chainID, sim.stateDB, privVal, 0)
+	node.dkgRunner.SetLogger(logger)
+	node.dkgRunner.AttachMessageHandler(node.handler)
+	node.dkgRunner.SetDKGCompletionCallback(func(aeon *aeonDetails) {
+		if !aeon.IsKeyless() {
+			node.aeons = append(node.aeons, aeon)
+		}
+		node.entropyGenerator.SetNextAeonDetails(aeon)
+	})
+	return node
+}
+
+// start starts the dkg runners of all nodes, which start the first dkg
+func (sim *dkgSimulator) start() {
+	for _, node := range sim.nodes {
+		require.NoError(sim.t, node.dkgRunner.Start())
+	}
+}
+
+// stop stops the dkg runners of all nodes and removes their config directories
+func (sim *dkgSimulator) stop() {
+	for _, node := range sim.nodes {
+		node.dkgRunner.Stop()
+		os.RemoveAll(node.config.RootDir)
+	}
+}
+
+// addFaults makes the node apply faults, in order, to the dkg messages it sends
+func (sim *dkgSimulator) addFaults(index int, faults ...msgFault) {
+	sim.nodes[index].faults = append(sim.nodes[index].faults, faults...)
+}
+
+// setOffline takes the node offline from block height from until block height to. Once back
+// online, the node catches up on the blocks it has missed.
+func (sim *dkgSimulator) setOffline(index int, from int64, to int64) {
+	sim.nodes[index].offlineFrom = from
+	sim.nodes[index].offlineTo = to
+}
+
+// changeValidators makes the nodes with the given indices the validators from block height + 2,
+// as validator updates in block height take effect two blocks later
+func (sim *dkgSimulator) changeValidators(height int64, indices ...int) {
+	sim.validatorChanges[height] = indices
+}
+
+// runUntil produces blocks until done returns true, failing the test if this does not happen
+// by block height maxHeight
+func (sim *dkgSimulator) runUntil(maxHeight int64, done func() bool) {
+	for !done() {
+		if sim.height >= maxHeight {
+			sim.t.Fatalf("simulation did not complete by height %v", maxHeight)
+		}
+		sim.nextBlock()
+	}
+}
+
+// firstDKGEnd returns the height by which the first iteration of the first dkg completes or fails.
+// Must be called after start.
+func (sim *dkgSimulator) firstDKGEnd() int64 {
+	dkg := sim.nodes[0].dkgRunner.activeDKG
+	return dkg.startHeight + dkg.duration()
+}
+
+// runUntilDKGsCompleted produces blocks until the nodes with the given indices have each output
+// nDKGs aeons with keys
+func (sim *dkgSimulator) runUntilDKGsCompleted(maxHeight int64, nDKGs int, indices ...int) {
+	sim.runUntil(maxHeight, func() bool {
+		for _, index := range indices {
+			if len(sim.nodes[index].aeons) < nDKGs {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// nextBlock generates entropy for the next block height, and produces and delivers the block
+func (sim *dkgSimulator) nextBlock() {
+	sim.height++
+	height := sim.height
+
+	sim.generateEntropy(height)
+	sim.commitState(height)
+
+	sim.blocks[height] = sim.pending[height]
+	delete(sim.pending, height)
+	for _, node := range sim.nodes {
+		if node.isOffline(height) {
+			continue
+		}
+		for node.lastBlock < height {
+			node.lastBlock++
+			node.handler.deliverBlock(node.lastBlock, sim.blocks[node.lastBlock])
+		}
+	}
+}
+
+// commitState saves the state after block height, so that dkg runners can load the validators
+// and consensus params of later heights
+func (sim *dkgSimulator) commitState(height int64) {
+	sim.state.LastBlockHeight = height
+	sim.state.LastValidators = sim.state.Validators
+	sim.state.Validators = sim.state.NextValidators.Copy()
+	if indices, ok := sim.validatorChanges[height]; ok {
+		validators := make([]*types.Validator, len(indices))
+		for i, index := range indices {
+			validators[i] = types.NewValidator(sim.nodes[index].privVal.GetPubKey(), 30)
+		}
+		sim.state.NextValidators = types.NewValidatorSet(validators)
+		sim.state.LastHeightValidatorsChanged = height + 2
+	}
+	sm.SaveState(sim.stateDB, sim.state)
+}
+
+// generateEntropy runs the entropy generators of online nodes up to block height, gossiping
+// entropy shares and computed entropy between them, until no more progress can be made
+func (sim *dkgSimulator) generateEntropy(height int64) {
+	for progress := true; progress; {
+		progress = false
+
+		generating := make([]*simNode, 0, len(sim.nodes))
+		for _, node := range sim.nodes {
+			gen := node.entropyGenerator
+			if node.isOffline(height) || gen.getLastBlockHeight() >= height {
+				continue
+			}
+			// Generator is blocked until it has an aeon for its next height
+			if gen.resetKeys() && !gen.changeKeys() {
+				continue
+			}
+			gen.sign()
+			generating = append(generating, node)
+		}
+
+		for _, node := range generating {
+			gen := node.entropyGenerator
+			if !gen.isSigningEntropy() {
+				continue
+			}
+			nextHeight := gen.getLastBlockHeight() + 1
+			for _, peer := range generating {
+				if peer == node {
+					continue
+				}
+				if entropy := peer.entropyGenerator.getComputedEntropy(nextHeight); entropy != nil {
+					gen.applyComputedEntropy(nextHeight, entropy)
+				}
+				for _, share := range peer.entropyGenerator.getEntropyShares(nextHeight) {
+					share := share
+					gen.applyEntropyShare(&share)
+				}
+			}
+		}
+
+		for _, node := range generating {
+			if haveNewEntropy, entropy := node.entropyGenerator.checkForNewEntropy(); haveNewEntropy {
+				node.entropy[entropy.Height] = entropy.Entropy
+				progress = true
+			}
+		}
+	}
+}
+
+// submit schedules a dkg message sent by node for inclusion in the chain, after applying the
+// node's faults
+func (sim *dkgSimulator) submit(node *simNode, msg *types.DKGMessage) {
+	if node.isOffline(sim.height) {
+		return
+	}
+	delay := int64(0)
+	for _, fault := range node.faults {
+		var faultDelay int64
+		if msg, faultDelay = fault(node, msg); msg == nil {
+			return
+		}
+		delay += faultDelay
+	}
+	// Messages sent while processing a block are included in the next one
+	includeHeight := sim.height + 1 + delay
+	sim.pending[includeHeight] = append(sim.pending[includeHeight], msg)
+}
+
+// checkAeons checks that the nodes with the given indices output agreeing aeons for their dkgNum-th
+// completed dkg, with the expected qual, and that signature shares of qual members combine into a
+// group signature which verifies for all of them
+func (sim *dkgSimulator) checkAeons(dkgNum int, qual []uint, indices ...int) {
+	t := sim.t
+	require.NotEmpty(t, indices)
+	aeons := make([]*aeonDetails, len(indices))
+	for i, index := range indices {
+		require.True(t, len(sim.nodes[index].aeons) > dkgNum, "node %v did not complete dkg %v", index, dkgNum)
+		aeons[i] = sim.nodes[index].aeons[dkgNum]
+	}
+
+	expected := aeons[0].dkgOutput()
+	assert.Equal(t, qual, aeonQual(aeons[0]), "unexpected qual")
+	for i, aeon := range aeons {
+		output := aeon.dkgOutput()
+		assert.Equal(t, expected.GroupPublicKey, output.GroupPublicKey, "node %v group public key differs", indices[i])
+		assert.Equal(t, expected.PublicKeyShares, output.PublicKeyShares, "node %v public key shares differ", indices[i])
+		assert.Equal(t, qual, aeonQual(aeon), "node %v qual differs", indices[i])
+		assert.Equal(t, expected.Start, output.Start)
+		assert.Equal(t, expected.End, output.End)
+	}
+
+	message := "TestMessage"
+	sigShares := NewIntStringMap()
+	defer DeleteIntStringMap(sigShares)
+	for _, aeon := range aeons {
+		if !aeon.aeonExecUnit.CanSign() {
+			continue
+		}
+		index, _ := aeon.validators.GetByAddress(aeon.privValidator.GetPubKey().Address())
+		sigShares.Set(uint(index), aeon.aeonExecUnit.Sign(message, uint(index)))
+	}
+	require.True(t, int(sigShares.Size()) >= aeons[0].threshold, "not enough signature shares")
+	groupSig := aeons[0].aeonExecUnit.ComputeGroupSignature(sigShares)
+	for i, aeon := range aeons {
+		assert.True(t, aeon.aeonExecUnit.VerifyGroupSignature(message, groupSig),
+			"node %v failed to verify group signature", indices[i])
+	}
+}
+
+// checkEntropy checks that the nodes with the given indices generated the same, non-trivial,
+// entropy at block height
+func (sim *dkgSimulator) checkEntropy(height int64, indices ...int) {
+	t := sim.t
+	require.NotEmpty(t, indices)
+	expected, ok := sim.nodes[indices[0]].entropy[height]
+	require.True(t, ok, "node %v did not generate entropy at height %v", indices[0], height)
+	assert.False(t, types.IsEmptyBlockEntropy(&expected))
+	for _, index := range indices[1:] {
+		entropy, ok := sim.nodes[index].entropy[height]
+		require.True(t, ok, "node %v did not generate entropy at height %v", index, height)
+		assert.Equal(t, expected, entropy, "node %v entropy differs", index)
+	}
+}
+
+func (node *simNode) isOffline(height int64) bool {
+	return height >= node.offlineFrom && height < node.offlineTo
+}
+
+// aeonQual returns the indices of the validators in the qual set of aeon
+func aeonQual(aeon *aeonDetails) []uint {
+	qual := make([]uint, 0, aeon.validators.Size())
+	for i := 0; i < aeon.validators.Size(); i++ {
+		if aeon.aeonExecUnit.InQual(uint(i)) {
+			qual = append(qual, uint(i))
+		}
+	}
+	return qual
+}
+
+// withholdMsgs withholds dkg messages of the given types
+func withholdMsgs(msgTypes ...types.DKGMessageType) msgFault {
+	return func(node *simNode, msg *types.DKGMessage) (*types.DKGMessage, int64) {
+		if hasMsgType(msg, msgTypes) {
+			return nil, 0
+		}
+		return msg, 0
+	}
+}
+
+// delayMsgs delays the inclusion of dkg messages of the given types by a number of blocks
+func delayMsgs(blocks int64, msgTypes ...types.DKGMessageType) msgFault {
+	return func(node *simNode, msg *types.DKGMessage) (*types.DKGMessage, int64) {
+		if hasMsgType(msg, msgTypes) {
+			return msg, blocks
+		}
+		return msg, 0
+	}
+}
+
+// corruptMsgs corrupts the data of dkg messages of the given types with failure, and re-signs them
+// so that they pass message checks
+func corruptMsgs(failure dkgFailure, msgTypes ...types.DKGMessageType) msgFault {
+	return func(node *simNode, msg *types.DKGMessage) (*types.DKGMessage, int64) {
+		if !hasMsgType(msg, msgTypes) {
+			return msg, 0
+		}
+		corrupted := *msg
+		if failure == mutateData {
+			corrupted.Data = "garbage"
+		} else {
+			corrupted.Data = MutateMsg(msg.Data, FetchBeaconDKGMessageType(msg.Type), FetchBeaconFailure(failure))
+		}
+		if err := node.privVal.SignDKGMessage(node.dkgRunner.chainID, &corrupted); err != nil {
+			panic(err)
+		}
+		return &corrupted, 0
+	}
+}
+
+func hasMsgType(msg *types.DKGMessage, msgTypes []types.DKGMessageType) bool {
+	for _, msgType := range msgTypes {
+		if msg.Type == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// simMessageHandler is the message handler of a simulated node. It sends dkg messages to the
+// simulator and delivers blocks produced by it to the dkg runner.
+type simMessageHandler struct {
+	sim     *dkgSimulator
+	node    *simNode
+	onBlock []func(int64, types.ThresholdSignature, []*types.DKGMessage)
+	block   []*types.DKGMessage
+}
+
+var _ tx_extensions.MessageHandler = (*simMessageHandler)(nil)
+
+func (handler *simMessageHandler) SubmitSpecialTx(message interface{}) {
+	msg, ok := message.(*types.DKGMessage)
+	if !ok {
+		panic(fmt.Sprintf("unexpected special tx %T", message))
+	}
+	handler.sim.submit(handler.node, msg)
+}
+
+// ToSubmitTx is a no-op, as messages are submitted to the simulator directly
+func (handler *simMessageHandler) ToSubmitTx(cb func([]byte)) {}
+
+func (handler *simMessageHandler) WhenChainTxSeen(cb func(int64, types.ThresholdSignature, []*types.DKGMessage)) {
+	handler.onBlock = append(handler.onBlock, cb)
+}
+
+func (handler *simMessageHandler) BeginBlock(entropy types.ThresholdSignature) {
+	handler.block = make([]*types.DKGMessage, 0)
+}
+
+func (handler *simMessageHandler) SpecialTxSeen(tx []byte) {
+	msg, err := tx_extensions.FromBytes(tx)
+	if err != nil {
+		panic(err)
+	}
+	handler.block = append(handler.block, msg)
+}
+
+func (handler *simMessageHandler) EndBlock(blockHeight int64) {
+	for _, cb := range handler.onBlock {
+		cb(blockHeight, []byte{}, handler.block)
+	}
+}
+
+// deliverBlock delivers the dkg messages of a block as encoded in transactions
+func (handler *simMessageHandler) deliverBlock(height int64, msgs []*types.DKGMessage) {
+	handler.BeginBlock(nil)
+	for _, msg := range msgs {
+		handler.SpecialTxSeen(tx_extensions.AsBytes(msg))
+	}
+	handler.EndBlock(height)
+}
+
+//-------------------------------------------------------------------------------
+// scenarios
+
+func TestDKGSimulatorScenarios(t *testing.T) {
+	testCases := []struct {
+		testName string
+		faults   func(*dkgSimulator)
+		nVals    int
+		qual     []uint
+		honest   []int
+	}{
+		{"All honest", func(*dkgSimulator) {}, 4, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"Withheld shares", func(sim *dkgSimulator) {
+			sim.addFaults(4, withholdMsgs(types.DKGShare))
+		}, 5, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"Wrong shares", func(sim *dkgSimulator) {
+			sim.addFaults(4, corruptMsgs(badShare, types.DKGShare))
+		}, 5, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"Wrong coefficients", func(sim *dkgSimulator) {
+			sim.addFaults(4, corruptMsgs(badCoefficient, types.DKGCoefficient))
+		}, 5, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"Late encryption key", func(sim *dkgSimulator) {
+			sim.addFaults(4, delayMsgs(10, types.DKGEncryptionKey))
+		}, 5, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"Offline validator", func(sim *dkgSimulator) {
+			sim.setOffline(4, 0, 1000)
+		}, 5, []uint{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			cppLogger := NewNativeLoggingCollector(log.TestingLogger())
+			cppLogger.Start()
+			defer cppLogger.Stop()
+
+			sim := newDKGSimulator(t, tc.nVals, 0, 20)
+			defer sim.stop()
+			tc.faults(sim)
+			sim.start()
+
+			// Faults must not make the first dkg iteration fail
+			sim.runUntilDKGsCompleted(sim.firstDKGEnd(), 1, tc.honest...)
+			sim.checkAeons(0, tc.qual, tc.honest...)
+
+			// Honest nodes generate entropy with the new aeon
+			aeonStart := sim.nodes[tc.honest[0]].aeons[0].Start
+			sim.runUntil(aeonStart+5, func() bool { return sim.height > aeonStart })
+			sim.checkEntropy(aeonStart, tc.honest...)
+		})
+	}
+}
+
+func TestDKGSimulatorValidatorChange(t *testing.T) {
+	cppLogger := NewNativeLoggingCollector(log.TestingLogger())
+	cppLogger.Start()
+	defer cppLogger.Stop()
+
+	sim := newDKGSimulator(t, 4, 1, 20)
+	defer sim.stop()
+	// Replace validator 3 by node 4 while the first dkg is running
+	sim.changeValidators(3, 0, 1, 2, 4)
+	sim.start()
+
+	// First dkg is run by the genesis validators, and node 4 obtains the aeon as an observer
+	sim.runUntilDKGsCompleted(sim.firstDKGEnd(), 1, 0, 1, 2, 3, 4)
+	sim.checkAeons(0, []uint{0, 1, 2, 3}, 0, 1, 2, 3, 4)
+	genesisVals, err := sm.LoadValidators(sim.stateDB, 1)
+	require.NoError(t, err)
+	assert.Equal(t, genesisVals.Hash(), sim.nodes[0].aeons[0].validators.Hash())
+
+	// Second dkg is run by the new validators
+	sim.runUntilDKGsCompleted(200, 2, 0, 1, 2, 4)
+	sim.checkAeons(1, []uint{0, 1, 2, 3}, 0, 1, 2, 4)
+	newVals, err := sm.LoadValidators(sim.stateDB, sim.nodes[0].aeons[1].validatorHeight)
+	require.NoError(t, err)
+	assert.Equal(t, newVals.Hash(), sim.nodes[0].aeons[1].validators.Hash())
+	index, _ := sim.nodes[0].aeons[1].validators.GetByAddress(sim.nodes[3].privVal.GetPubKey().Address())
+	assert.Equal(t, -1, index)
+
+	aeonStart := sim.nodes[0].aeons[1].Start
+	sim.runUntil(aeonStart+5, func() bool { return sim.height > aeonStart })
+	sim.checkEntropy(aeonStart, 0, 1, 2, 4)
+}
@@ -1,10 +1,17 @@
 package beacon
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	"github.com/tendermint/tendermint/libs/log"
 	tmnoise "github.com/tendermint/tendermint/noise"
 	sm "github.com/tendermint/tendermint/state"
@@ -86,11 +93,79 @@ func TestDKGReset(t *testing.T) {
 	dkg.checkTransition(oldStartHeight + dkg.duration())
 
 	assert.True(t, !dkg.IsRunning())
-	assert.True(t, dkg.startHeight == oldStartHeight+oldDuration+dkgResetDelay)
-	assert.True(t, dkg.stateDuration == oldStateDuration+int64(float64(oldStateDuration)*dkgIterationDurationMultiplier))
+	assert.True(t, dkg.startHeight == oldStartHeight+oldDuration+dkg.params.DKGResetDelay)
+	assert.True(t, dkg.stateDuration == oldStateDuration+oldStateDuration*dkg.params.DKGIterationDurationIncrease/100)
 	assert.True(t, dkg.dkgIteration == 1)
 }
 
+func TestDKGEntropyParams(t *testing.T) {
+	genDoc, privVals := randGenesisDoc(4, false, 30)
+	state, _ := sm.LoadStateFromDBOrGenesisDoc(dbm.NewMemDB(), genDoc)
+	params := types.EntropyParams{
+		AeonLength:                   50,
+		DKGStateDuration:             8,
+		DKGIterationDurationIncrease: 100,
+		DKGMaxStateDuration:          20,
+		DKGResetDelay:                5,
+	}
+	dkg := NewDistributedKeyGeneration(cfg.TestBeaconConfig(), genDoc.ChainID, privVals[0],
+		8, *state.Validators, 20, params)
+	dkg.SetLogger(log.TestingLogger())
+	assert.Equal(t, int64(8), dkg.stateDuration)
+	assert.Equal(t, dkgStatesWithDuration*8, dkg.duration())
+
+	// State durations double on each failed iteration until they would exceed the max
+	oldStartHeight := dkg.startHeight
+	oldDuration := dkg.duration()
+	require.NoError(t, dkg.OnReset())
+	assert.Equal(t, oldStartHeight+oldDuration+5, dkg.startHeight)
+	assert.Equal(t, int64(16), dkg.stateDuration)
+	require.NoError(t, dkg.OnReset())
+	assert.Equal(t, int64(16), dkg.stateDuration)
+}
+
+func TestDKGEvents(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	stateChanges, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryDKGStateChange, 10)
+	require.NoError(t, err)
+	failures, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryDKGFailed)
+	require.NoError(t, err)
+
+	dkg := exampleDKG(4)
+	dkg.SetEventBus(eventBus)
+	dkg.states[waitForDryRun].onExit = func() bool {
+		return false
+	}
+	// Starts the dkg
+	dkg.checkTransition(dkg.startHeight)
+
+	msg := <-stateChanges.Out()
+	stateChange := msg.Data().(types.EventDataDKGStateChange)
+	assert.Equal(t, dkg.dkgID, stateChange.DKGID)
+	assert.Equal(t, dkg.startHeight, stateChange.BlockHeight)
+	assert.Equal(t, waitForEncryptionKeys.String(), stateChange.State)
+
+	// Trigger a failed transition
+	dkg.currentState = waitForDryRun
+	failedHeight := dkg.startHeight + dkg.duration()
+	dkg.checkTransition(failedHeight)
+	select {
+	case msg := <-failures.Out():
+		failure := msg.Data().(types.EventDataDKGFailed)
+		assert.Equal(t, types.EventDataDKGFailed{
+			DKGID:       dkg.dkgID,
+			Iteration:   0,
+			BlockHeight: failedHeight,
+			State:       waitForDryRun.String(),
+		}, failure)
+	case <-time.After(time.Second):
+		t.Fatal("did not receive dkg failure")
+	}
+}
+
 func TestDKGCheckMessage(t *testing.T) {
 	nodes := exampleDKGNetwork(4, 0, false)
 	dkgToGenerateMsg := nodes[0].dkg
@@ -288,6 +363,146 @@ func TestDKGScenarios(t *testing.T) {
 	}
 }
 
+func TestDKGOffChainPayloads(t *testing.T) {
+	nodes := exampleDKGNetwork(4, 0, false)
+	cppLogger := NewNativeLoggingCollector(log.TestingLogger())
+	cppLogger.Start()
+	defer cppLogger.Stop()
+
+	// The last node does not receive gossiped payloads, and has to request those committed to in
+	// blocks
+	lateNode := nodes[len(nodes)-1]
+	payloads := make([]dkgPayload, 0)
+	requested := make([][]byte, 0)
+	outputs := make([]*aeonDetails, len(nodes))
+	for index, node := range nodes {
+		output := &outputs[index]
+		node.dkg.config.OffChainDKGPayloads = true
+		node.dkg.SetDkgCompletionCallback(func(aeon *aeonDetails) {
+			*output = aeon
+		})
+		node.dkg.SetPayloadCallbacks(func(msg *types.DKGMessage, payload string) {
+			payloads = append(payloads, dkgPayload{msg, payload})
+		}, nil)
+	}
+	lateNode.dkg.SetPayloadCallbacks(func(msg *types.DKGMessage, payload string) {
+		payloads = append(payloads, dkgPayload{msg, payload})
+	}, func(payloadHash []byte) {
+		requested = append(requested, payloadHash)
+	})
+
+	blockHeight := int64(10)
+	for _, node := range nodes {
+		node.dkg.OnBlock(blockHeight, []*types.DKGMessage{})
+		node.clearMsgs()
+	}
+	for nodesFinished := 0; nodesFinished < len(nodes); {
+		blockHeight++
+		currentMsgs := make([]*types.DKGMessage, 0)
+		for _, node := range nodes {
+			currentMsgs = append(currentMsgs, node.currentMsgs...)
+		}
+		for _, msg := range currentMsgs {
+			if msg.Type == types.DKGEncryptionKey || msg.Type == types.DKGDryRun {
+				assert.NotEmpty(t, msg.Data)
+			} else {
+				assert.Empty(t, msg.Data)
+				assert.True(t, msg.HasOffChainPayload())
+			}
+		}
+
+		// Payloads are gossiped before the messages committing to them are in a block
+		for _, payload := range payloads {
+			for _, node := range nodes[:len(nodes)-1] {
+				_, err := node.dkg.onPayload(payload.msg, payload.payload)
+				assert.NoError(t, err)
+			}
+		}
+		payloads = payloads[:0]
+		for _, node := range nodes {
+			node.dkg.OnBlock(blockHeight, currentMsgs)
+			node.clearMsgs()
+		}
+
+		// Requested payloads are fetched from a peer which holds them
+		for _, payloadHash := range requested {
+			msg, payload, ok := nodes[0].dkg.payload(payloadHash)
+			require.True(t, ok)
+			isNew, err := lateNode.dkg.onPayload(msg, payload)
+			assert.NoError(t, err)
+			assert.True(t, isNew)
+		}
+		requested = requested[:0]
+
+		nodesFinished = 0
+		for _, node := range nodes {
+			require.Zero(t, node.dkg.dkgIteration)
+			if node.dkg.currentState == dkgFinish {
+				nodesFinished++
+			}
+		}
+	}
+
+	for _, aeon := range outputs {
+		require.NotNil(t, aeon)
+		require.NotNil(t, aeon.aeonExecUnit)
+		assert.True(t, aeon.dkgOutput().Equal(outputs[0].dkgOutput()))
+	}
+}
+
+func TestDKGOnPayload(t *testing.T) {
+	nodes := exampleDKGNetwork(4, 0, false)
+	sender := nodes[0].dkg
+	receiver := nodes[1].dkg
+	payload := "payload"
+
+	msg := sender.newDKGCommitment(types.DKGCoefficient, payload, nil)
+	isNew, err := receiver.onPayload(msg, "other payload")
+	assert.Error(t, err)
+	assert.False(t, isNew)
+
+	invalidSignature := *msg
+	invalidSignature.Type = types.DKGComplaint
+	_, err = receiver.onPayload(&invalidSignature, payload)
+	assert.Error(t, err)
+
+	privVal := types.NewMockPV()
+	notValidator := *msg
+	notValidator.FromAddress = privVal.GetPubKey().Address()
+	privVal.SignDKGMessage(sender.chainID, &notValidator)
+	_, err = receiver.onPayload(&notValidator, payload)
+	assert.Error(t, err)
+
+	// Payloads of other iterations are ignored
+	otherIteration := *msg
+	otherIteration.DKGIteration++
+	sender.privValidator.SignDKGMessage(sender.chainID, &otherIteration)
+	isNew, err = receiver.onPayload(&otherIteration, payload)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+
+	isNew, err = receiver.onPayload(msg, payload)
+	assert.NoError(t, err)
+	assert.True(t, isNew)
+	isNew, err = receiver.onPayload(msg, payload)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	storedMsg, storedPayload, ok := receiver.payload(msg.PayloadHash)
+	require.True(t, ok)
+	assert.Equal(t, msg, storedMsg)
+	assert.Equal(t, payload, storedPayload)
+
+	// Payloads from each validator are bounded
+	for i := 1; i < receiver.maxPayloadsPerValidator(); i++ {
+		isNew, err = receiver.onPayload(sender.newDKGCommitment(types.DKGShare, fmt.Sprint(i), nil), fmt.Sprint(i))
+		assert.NoError(t, err)
+		assert.True(t, isNew)
+	}
+	isNew, err = receiver.onPayload(sender.newDKGCommitment(types.DKGShare, "extra", nil), "extra")
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+}
+
 // Test MaxDKGDataSize is large enough for the dry run messages for committee of size 200
 func TestDKGMessageMaxDataSize(t *testing.T) {
 	_, privVal := types.RandValidator(false, 10)
@@ -303,7 +518,7 @@ func TestDKGMessageMaxDataSize(t *testing.T) {
 	msgToSign := string(cdc.MustMarshalBinaryBare(aeonKeys.dkgOutput()))
 	signature := aeonKeys.aeonExecUnit.Sign(msgToSign, 200)
 
-	dryRun := DryRunSignature{
+	dryRun := types.DryRunSignature{
 		PublicInfo:     *aeonKeys.dkgOutput(),
 		SignatureShare: signature,
 	}
@@ -321,13 +536,248 @@ func TestDKGMessageMaxDataSize(t *testing.T) {
 	assert.True(t, dkgMessage.ValidateBasic() == nil)
 }
 
+func TestDKGReshare(t *testing.T) {
+	genDoc, privVals := randGenesisDoc(4, false, 30)
+	stateDB := dbm.NewMemDB()
+	state, _ := sm.LoadStateFromDBOrGenesisDoc(stateDB, genDoc)
+	config := cfg.TestBeaconConfig()
+	params := types.DefaultEntropyParams()
+
+	nodes := make([]*testNode, len(privVals))
+	for i := range privVals {
+		nodes[i] = newTestNode(config, genDoc.ChainID, privVals[i], state.Validators, false)
+	}
+	aeons := runDKGNetwork(t, nodes, 10)
+
+	// Replace one validator with a new one
+	newVal, newPrivVal := types.RandValidator(false, 30)
+	newVals := types.NewValidatorSet(append(state.Validators.Copy().Validators[1:], newVal))
+	reshareNodes := make([]*testNode, 0)
+	for _, privVal := range append(privVals, newPrivVal) {
+		if index, _ := newVals.GetByAddress(privVal.GetPubKey().Address()); index < 0 {
+			continue
+		}
+		node := &testNode{
+			dkg: NewDistributedKeyGeneration(config, genDoc.ChainID, privVal, 30,
+				*newVals, 40, params),
+			currentMsgs: make([]*types.DKGMessage, 0),
+			nextMsgs:    make([]*types.DKGMessage, 0),
+		}
+		node.dkg.SetLogger(log.TestingLogger())
+		node.dkg.SetSendMsgCallback(func(msg *types.DKGMessage) {
+			node.nextMsgs = append(node.nextMsgs, msg)
+		})
+		// New validators only hold the public keys of the current aeon
+		aeon := LoadAeonDetails(&AeonDetailsFile{PublicInfo: *aeons[0].dkgOutput()}, state.Validators, privVal)
+		for i, oldNode := range nodes {
+			if oldNode.dkg.privValidator == privVal {
+				aeon = aeons[i]
+			}
+		}
+		require.True(t, node.dkg.setReshareAeon(aeon))
+		assert.Equal(t, reshareStatesWithDuration*node.dkg.stateDuration, node.dkg.duration())
+		reshareNodes = append(reshareNodes, node)
+	}
+	reshared := runDKGNetwork(t, reshareNodes, 30)
+
+	// Group public key is kept and the new validators can sign with it
+	message := "TestMessage"
+	sigShares := NewIntStringMap()
+	defer DeleteIntStringMap(sigShares)
+	for i, node := range reshareNodes {
+		assert.Equal(t, aeons[0].aeonExecUnit.GroupPublicKey(), reshared[i].aeonExecUnit.GroupPublicKey())
+		assert.Equal(t, int64(30), reshared[i].validatorHeight)
+		sigShares.Set(uint(node.dkg.index()), reshared[i].aeonExecUnit.Sign(message, uint(node.dkg.index())))
+	}
+	groupSig := reshared[0].aeonExecUnit.ComputeGroupSignature(sigShares)
+	assert.True(t, aeons[0].aeonExecUnit.VerifyGroupSignature(message, groupSig))
+}
+
+func TestDKGReshareFallback(t *testing.T) {
+	dkg := exampleDKG(4)
+	assert.False(t, dkg.setReshareAeon(nil))
+	assert.False(t, dkg.setReshareAeon(keylessAeonDetails(1, 10)))
+	assert.Nil(t, dkg.resharing)
+	assert.Equal(t, dkgStatesWithDuration*dkg.stateDuration, dkg.duration())
+
+	// Reset falls back to a full dkg
+	dkg.resharing = &dkgReshare{}
+	dkg.setStates()
+	_, haveState := dkg.states[waitForQualCoefficients]
+	assert.False(t, haveState)
+	dkg.currentState = waitForComplaintAnswers
+	assert.Equal(t, waitForDryRun, dkg.nextState())
+	dkg.OnReset()
+	assert.Nil(t, dkg.resharing)
+	_, haveState = dkg.states[waitForQualCoefficients]
+	assert.True(t, haveState)
+}
+
+func TestDKGCheckpoint(t *testing.T) {
+	checkpointFile := cfg.ResetTestRoot("dkg_checkpoint_test").DKGCheckpointFile()
+	genDoc, privVals := randGenesisDoc(4, false, 30)
+	state, _ := sm.LoadStateFromDBOrGenesisDoc(dbm.NewMemDB(), genDoc)
+	keyEncryptionKey := []byte("key-encryption-key")
+	newDKG := func(key []byte) *DistributedKeyGeneration {
+		dkg := NewDistributedKeyGeneration(cfg.TestBeaconConfig(), genDoc.ChainID, privVals[0], 8,
+			*state.Validators, 20, types.DefaultEntropyParams())
+		dkg.SetLogger(log.TestingLogger())
+		dkg.SetCheckpointFile(checkpointFile, key)
+		return dkg
+	}
+
+	dkg := newDKG(keyEncryptionKey)
+	dkg.localService().receivedShares[1] = "share"
+	dkg.proceedToNextState(waitForEncryptionKeys, false, 10)
+
+	// Checkpoint is encrypted
+	fileBytes, err := ioutil.ReadFile(checkpointFile)
+	require.NoError(t, err)
+	assert.True(t, keyfile.IsEncrypted(fileBytes))
+	checkpoint, err := loadDKGCheckpoint(checkpointFile, keyEncryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, dkg.dkgID, checkpoint.DKGID)
+	assert.Equal(t, dkg.localService().GetPolynomials(), checkpoint.Polynomials)
+
+	// Restarted dkg sends the same coefficients and shares, and keeps the shares received
+	restarted := newDKG(keyEncryptionKey)
+	assert.Equal(t, dkg.localService().encryptionKey, restarted.localService().encryptionKey)
+	assert.Equal(t, dkg.beaconService.GetCoefficients(), restarted.beaconService.GetCoefficients())
+	assert.Equal(t, dkg.localService().GetShare(2), restarted.localService().GetShare(2))
+	assert.Equal(t, "share", restarted.localService().receivedShares[1])
+	assert.True(t, restarted.sharesReceived.GetIndex(1))
+
+	// Checkpoint of another iteration is not restored
+	restarted.OnReset()
+	assert.NotEqual(t, dkg.beaconService.GetCoefficients(), restarted.beaconService.GetCoefficients())
+	assert.Empty(t, restarted.localService().receivedShares)
+	assert.NotEqual(t, dkg.localService().encryptionKey, restarted.localService().encryptionKey)
+
+	// Checkpoint can not be read without the key-encryption key
+	other := newDKG([]byte("other-key-encryption-key"))
+	assert.NotEqual(t, dkg.beaconService.GetCoefficients(), other.beaconService.GetCoefficients())
+	_, err = loadDKGCheckpoint(checkpointFile, nil)
+	assert.Equal(t, keyfile.ErrNoKeyEncryptionKey, err)
+
+	// Checkpoint is removed when the dkg finishes
+	dkg.dispatchKeys()
+	_, err = os.Stat(checkpointFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDKGShareContext(t *testing.T) {
+	dkg := exampleDKG(4)
+	service := dkg.localService()
+	own := uint(dkg.index())
+	sender := (own + 1) % 4
+	senderKey := tmnoise.NewEncryptionKey()
+
+	ciphertext, err := tmnoise.EncryptMsg(senderKey, service.EncryptionKey(), service.sharePrologue(sender, own), "share")
+	require.NoError(t, err)
+	share, err := service.decryptShare(ciphertext, sender, senderKey.Public)
+	require.NoError(t, err)
+	assert.Equal(t, "share", share)
+
+	// Share sent to another recipient
+	ciphertext, err = tmnoise.EncryptMsg(senderKey, service.EncryptionKey(),
+		service.sharePrologue(sender, (own+2)%4), "share")
+	require.NoError(t, err)
+	_, err = service.decryptShare(ciphertext, sender, senderKey.Public)
+	assert.Error(t, err)
+
+	// Share replayed in another iteration
+	ciphertext, err = tmnoise.EncryptMsg(senderKey, service.EncryptionKey(), service.sharePrologue(sender, own), "share")
+	require.NoError(t, err)
+	service.iteration++
+	_, err = service.decryptShare(ciphertext, sender, senderKey.Public)
+	assert.Error(t, err)
+}
+
+func TestDKGAeonSigner(t *testing.T) {
+	keyFile := cfg.ResetTestRoot("dkg_aeon_signer_test").EntropyKeyFile()
+	nodes := exampleDKGNetwork(4, 0, false)
+	signer := NewAeonKeySigner()
+	signer.SetKeyFile(keyFile, nil)
+	nodes[0].dkg.SetAeonSigner(signer)
+	assert.Nil(t, nodes[0].dkg.localService())
+	outputs := runDKGNetwork(t, nodes, 8)
+
+	// Node has the public keys computed by the signer, which keeps the private key
+	assert.False(t, outputs[0].aeonExecUnit.CanSign())
+	assert.Equal(t, outputs[1].dkgOutput(), outputs[0].dkgOutput())
+	share, err := signer.SignAeonShare(nodes[0].dkg.dkgID, "message")
+	require.NoError(t, err)
+	assert.True(t, outputs[1].aeonExecUnit.Verify("message", share, uint(nodes[0].dkg.index())))
+	aeonFiles, err := LoadAeonDetailsFiles(keyFile, nil)
+	require.NoError(t, err)
+	require.Len(t, aeonFiles, 1)
+	assert.Equal(t, *outputs[0].dkgOutput(), aeonFiles[0].PublicInfo)
+	assert.NotEmpty(t, aeonFiles[0].PrivateKey)
+
+	// Signer only runs steps of the latest dkg started
+	chainID := nodes[0].dkg.chainID
+	_, err = signer.DKGStep(chainID, &types.DKGStep{Type: types.DKGStepGetMessage, DKGID: 1,
+		MessageType: types.DKGComplaint})
+	assert.Error(t, err)
+	_, err = signer.DKGStep(chainID, &types.DKGStep{Type: types.DKGStepStart, DKGID: 1, CabinetSize: 4,
+		Threshold: 3, KeyType: GetAeonType()})
+	assert.Error(t, err)
+
+	// Dkgs run by the signer observe reshares
+	dkg := exampleDKG(4)
+	dkg.SetAeonSigner(signer)
+	dkg.resharing = &dkgReshare{}
+	dkg.setStates()
+	_, haveState := dkg.states[waitForEncryptionKeys]
+	assert.False(t, haveState)
+	assert.Equal(t, reshareStatesWithDuration*dkg.stateDuration, dkg.duration())
+}
+
+// runDKGNetwork runs the dkgs of nodes, starting at blockHeight, until all have finished and
+// returns their outputs
+func runDKGNetwork(t *testing.T, nodes []*testNode, blockHeight int64) []*aeonDetails {
+	outputs := make([]*aeonDetails, len(nodes))
+	for index := range nodes {
+		output := &outputs[index]
+		nodes[index].dkg.SetDkgCompletionCallback(func(aeon *aeonDetails) {
+			*output = aeon
+		})
+	}
+	for _, node := range nodes {
+		node.dkg.OnBlock(blockHeight, []*types.DKGMessage{})
+		node.clearMsgs()
+	}
+	for nodesFinished := 0; nodesFinished < len(nodes); {
+		blockHeight++
+		currentMsgs := make([]*types.DKGMessage, 0)
+		for _, node := range nodes {
+			currentMsgs = append(currentMsgs, node.currentMsgs...)
+		}
+		nodesFinished = 0
+		for _, node := range nodes {
+			node.dkg.OnBlock(blockHeight, currentMsgs)
+			node.clearMsgs()
+			require.Zero(t, node.dkg.dkgIteration)
+			if node.dkg.currentState == dkgFinish {
+				nodesFinished++
+			}
+		}
+	}
+	for _, aeon := range outputs {
+		require.NotNil(t, aeon)
+		require.NotNil(t, aeon.aeonExecUnit)
+	}
+	return outputs
+}
+
 func exampleDKG(nVals int) *DistributedKeyGeneration {
 	genDoc, privVals := randGenesisDoc(nVals, false, 30)
 	stateDB := dbm.NewMemDB() // each state needs its own db
 	state, _ := sm.LoadStateFromDBOrGenesisDoc(stateDB, genDoc)
 	config := cfg.TestBeaconConfig()
 
-	dkg := NewDistributedKeyGeneration(config, genDoc.ChainID, privVals[0], tmnoise.NewEncryptionKey(), 8, *state.Validators, 20, 100)
+	dkg := NewDistributedKeyGeneration(config, genDoc.ChainID, privVals[0], 8, *state.Validators, 20,
+		types.DefaultEntropyParams())
 	dkg.SetLogger(log.TestingLogger())
 	return dkg
 }
@@ -343,7 +793,8 @@ type testNode struct {
 func newTestNode(config *cfg.BeaconConfig, chainID string, privVal types.PrivValidator,
 	vals *types.ValidatorSet, sendDuplicates bool) *testNode {
 	node := &testNode{
-		dkg:          NewDistributedKeyGeneration(config, chainID, privVal, tmnoise.NewEncryptionKey(), 8, *vals, 20, 100),
+		dkg: NewDistributedKeyGeneration(config, chainID, privVal, 8, *vals, 20,
+			types.DefaultEntropyParams()),
 		currentMsgs:  make([]*types.DKGMessage, 0),
 		nextMsgs:     make([]*types.DKGMessage, 0),
 		failures:     make([]dkgFailure, 0),
@@ -1,17 +1,23 @@
 package beacon
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"runtime/debug"
+	"sort"
 	"sync"
 	"time"
 
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/service"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 const (
@@ -19,6 +25,27 @@ const (
 	entropyHistoryLength = 10
 )
 
+var (
+	errInvalidShareSignature  = errors.New("invalid validator signature on entropy share")
+	errInvalidEntropyShare    = errors.New("invalid entropy share")
+	errInvalidComputedEntropy = errors.New("invalid computed entropy")
+)
+
+// interface to the evidence pool
+type evidencePool interface {
+	AddEvidence(types.Evidence) error
+}
+
+// shareEvidence is evidence against an entropy share of the current aeon, which is held until the
+// block at the share height is committed. The share is then checked against the entropy on chain,
+// and the evidence is timestamped with the block time.
+type shareEvidence struct {
+	aeon   *aeonDetails
+	pubKey crypto.PubKey
+	index  uint
+	share  types.EntropyShare
+}
+
 // EntropyGenerator holds DKG keys for computing entropy and computes entropy shares
 // and entropy for dispatching along channel. Entropy generation is blocked by arrival of keys for the
 // keys for the current block height from the dkg - including for trivial entropy periods, for which the
@@ -38,9 +65,31 @@ type EntropyGenerator struct {
 	nextAeons              []*aeonDetails
 	aeon                   *aeonDetails
 
+	// Evidence of invalid entropy shares is reported here, if set
+	evpool evidencePool
+
+	// Committed entropy and block times, against which entropy share evidence is checked
+	stateDB         dbm.DB
+	pendingEvidence []shareEvidence
+
+	// Computed entropy is saved here, if set, so it is kept beyond entropyHistoryLength
+	entropyStore *EntropyStore
+
+	// Signs entropy for aeons whose key share is not held by the node, if set
+	aeonSigner types.AeonSigner
+
+	// Verifies entropy shares and computed entropy, if set. Otherwise the aeon execution unit is used
+	signatureVerifier types.AeonSignatureVerifier
+
 	baseConfig   *cfg.BaseConfig
 	beaconConfig *cfg.BeaconConfig
 
+	// Aeon key files are encrypted with this key, if set
+	keyEncryptionKey []byte
+
+	// New entropy and aeon changes are published here
+	eventBus types.BeaconEventPublisher
+
 	// synchronous pubsub between entropy generator and reactor.
 	// entropy generator only emits new computed entropy height
 	evsw tmevents.EventSwitch
@@ -77,6 +126,7 @@ func NewEntropyGenerator(bConfig *cfg.BaseConfig, beaconConfig *cfg.BeaconConfig
 		beaconConfig:              beaconConfig,
 		evsw:                      tmevents.NewEventSwitch(),
 		quit:                      make(chan struct{}),
+		eventBus:                  types.NopEventBus{},
 		metrics:                   NopMetrics(),
 	}
 
@@ -91,6 +141,8 @@ func (entropyGenerator *EntropyGenerator) OnStart() error {
 		return err
 	}
 
+	entropyGenerator.loadStoredEntropy()
+
 	entropyGenerator.Logger.Debug("OnStart", "height", entropyGenerator.lastBlockHeight,
 		"aeon", entropyGenerator.isSigningEntropy(), "lastEntropyHeight", entropyGenerator.lastComputedEntropyHeight)
 
@@ -115,6 +167,73 @@ func (entropyGenerator *EntropyGenerator) SetEntropyChannel(entropyChannel chan<
 	}
 }
 
+// SetEvidencePool sets the pool to which evidence of misbehaving aeon members is reported
+func (entropyGenerator *EntropyGenerator) SetEvidencePool(evpool evidencePool) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.evpool = evpool
+}
+
+// SetStateDB sets the state db against which entropy share evidence is checked before it is reported
+func (entropyGenerator *EntropyGenerator) SetStateDB(stateDB dbm.DB) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.stateDB = stateDB
+}
+
+// SetEntropyStore sets the store in which computed entropy is saved. Must be called before starting.
+func (entropyGenerator *EntropyGenerator) SetEntropyStore(store *EntropyStore) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.entropyStore = store
+}
+
+// SetKeyEncryptionKey sets the key with which aeon key files are encrypted. Must be called before starting.
+func (entropyGenerator *EntropyGenerator) SetKeyEncryptionKey(keyEncryptionKey []byte) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.keyEncryptionKey = keyEncryptionKey
+}
+
+// SetAeonSigner sets the signer holding the key shares of aeons loaded without private key
+func (entropyGenerator *EntropyGenerator) SetAeonSigner(aeonSigner types.AeonSigner) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.aeonSigner = aeonSigner
+}
+
+// saveAeons saves aeons to filePath. Private keys are not saved if the aeon signer is set, as it
+// holds the key shares of aeons
+func (entropyGenerator *EntropyGenerator) saveAeons(filePath string, aeons ...*aeonDetails) {
+	if entropyGenerator.aeonSigner != nil {
+		savePublicAeons(filePath, entropyGenerator.keyEncryptionKey, aeons...)
+	} else {
+		saveAeons(filePath, entropyGenerator.keyEncryptionKey, aeons...)
+	}
+}
+
+// SetSignatureVerifier sets the verifier of entropy shares and computed entropy, in place of the aeon
+// execution unit
+func (entropyGenerator *EntropyGenerator) SetSignatureVerifier(verifier types.AeonSignatureVerifier) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.signatureVerifier = verifier
+}
+
+// SetEventBus sets the event bus on which new entropy and aeon changes are published
+func (entropyGenerator *EntropyGenerator) SetEventBus(eventBus types.BeaconEventPublisher) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	entropyGenerator.eventBus = eventBus
+}
+
 // SetLogger implements Service.
 func (entropyGenerator *EntropyGenerator) SetLogger(l log.Logger) {
 	entropyGenerator.BaseService.Logger = l
@@ -146,6 +265,29 @@ func (entropyGenerator *EntropyGenerator) setLastBlockHeight(height int64) {
 	}
 }
 
+// SkipEntropy moves the generator past height, which consensus committed with empty entropy
+// after timing out waiting for it, so that entropy for the next height is signed on the last
+// entropy on chain. A generator which already computed entropy at height can not rejoin the
+// chain of entropy until it changes aeon.
+func (entropyGenerator *EntropyGenerator) SkipEntropy(height int64) {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	if entropyGenerator.entropyComputed[height] != nil {
+		entropyGenerator.Logger.Error("SkipEntropy: entropy already computed", "height", height)
+		return
+	}
+	if height != entropyGenerator.lastBlockHeight+1 {
+		entropyGenerator.Logger.Debug("SkipEntropy: not waiting on height", "height", height,
+			"lastBlockHeight", entropyGenerator.lastBlockHeight)
+		return
+	}
+
+	entropyGenerator.Logger.Info("SkipEntropy: skipping height committed without entropy", "height", height)
+	delete(entropyGenerator.entropyShares, height)
+	entropyGenerator.lastBlockHeight = height
+}
+
 func (entropyGenerator *EntropyGenerator) InjectNextAeonDetails(aeon *aeonDetails) {
 	entropyGenerator.mtx.Lock()
 	defer entropyGenerator.mtx.Unlock()
@@ -168,13 +310,35 @@ func (entropyGenerator *EntropyGenerator) SetNextAeonDetails(aeon *aeonDetails)
 
 	entropyGenerator.nextAeons = append(entropyGenerator.nextAeons, aeon)
 
-	saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
+	entropyGenerator.saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
 
 	if entropyGenerator.metrics != nil {
 		entropyGenerator.metrics.AeonKeyBuffer.Set(float64(aeon.Start - entropyGenerator.lastBlockHeight))
 	}
 }
 
+// GetAeon returns the public info of the current or queued aeon which covers height, preferring
+// aeons with keys. Returns nil if no such aeon is known
+func (entropyGenerator *EntropyGenerator) GetAeon(height int64) *types.DKGOutput {
+	entropyGenerator.mtx.RLock()
+	defer entropyGenerator.mtx.RUnlock()
+
+	var found *aeonDetails
+	aeons := append([]*aeonDetails{entropyGenerator.aeon}, entropyGenerator.nextAeons...)
+	for _, aeon := range aeons {
+		if aeon == nil || height < aeon.Start || height > aeon.End {
+			continue
+		}
+		if found == nil || (found.IsKeyless() && !aeon.IsKeyless()) {
+			found = aeon
+		}
+	}
+	if found == nil {
+		return nil
+	}
+	return found.dkgOutput()
+}
+
 // Trim old aeons from the queue (assumes they are ordered)
 func (entropyGenerator *EntropyGenerator) trimNextAeons() {
 	for {
@@ -191,7 +355,7 @@ func (entropyGenerator *EntropyGenerator) trimNextAeons() {
 			}
 		} else {
 			// Save aeons to file
-			saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
+			entropyGenerator.saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
 			break
 		}
 	}
@@ -210,7 +374,7 @@ func (entropyGenerator *EntropyGenerator) resetKeys() bool {
 	if entropyGenerator.aeon != nil && entropyGenerator.lastBlockHeight >= entropyGenerator.aeon.End {
 		// When updating the aeon, we save the current aeon so that in the event of a crash we
 		// can load it since the block height may still be within this old aeon (entropy leads block height)
-		saveAeons(entropyGenerator.baseConfig.OldEntropyKeyFile(), entropyGenerator.aeon)
+		entropyGenerator.saveAeons(entropyGenerator.baseConfig.OldEntropyKeyFile(), entropyGenerator.aeon)
 
 		entropyGenerator.Logger.Info("changeKeys: Existing keys expired.", "blockHeight", entropyGenerator.lastBlockHeight,
 			"end", entropyGenerator.aeon.End)
@@ -235,14 +399,18 @@ func (entropyGenerator *EntropyGenerator) changeKeys() (didChangeKeys bool) {
 		entropyGenerator.nextAeons = remove(entropyGenerator.nextAeons, 0)
 
 		// Set new aeon - save keys for crash recovery
-		saveAeons(entropyGenerator.baseConfig.EntropyKeyFile(), entropyGenerator.aeon)
+		entropyGenerator.saveAeons(entropyGenerator.baseConfig.EntropyKeyFile(), entropyGenerator.aeon)
 
 		if len(entropyGenerator.nextAeons) > 0 {
-			saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
+			entropyGenerator.saveAeons(entropyGenerator.baseConfig.NextEntropyKeyFile(), entropyGenerator.nextAeons...)
 		}
 
 		entropyGenerator.Logger.Info("changeKeys: Loaded new keys", "blockHeight", entropyGenerator.lastBlockHeight,
 			"start", entropyGenerator.aeon.Start)
+		entropyGenerator.eventBus.PublishEventAeonChanged(types.EventDataAeonChanged{
+			Height: entropyGenerator.lastBlockHeight + 1,
+			Aeon:   *entropyGenerator.aeon.dkgOutput(),
+		})
 		didChangeKeys = true
 	}
 
@@ -256,8 +424,9 @@ func (entropyGenerator *EntropyGenerator) changeKeys() (didChangeKeys bool) {
 	return
 }
 
-// ApplyComputedEntropy processes completed entropy from peer
-func (entropyGenerator *EntropyGenerator) applyComputedEntropy(height int64, entropy types.ThresholdSignature) {
+// ApplyComputedEntropy processes completed entropy from peer. Returns an error if the entropy is not a valid
+// group signature
+func (entropyGenerator *EntropyGenerator) applyComputedEntropy(height int64, entropy types.ThresholdSignature) error {
 	// Should not be called in entropy generator is not running
 	if !entropyGenerator.isSigningEntropy() {
 		panic(fmt.Errorf("applyComputedEntropy while entropy generator stopped"))
@@ -270,19 +439,21 @@ func (entropyGenerator *EntropyGenerator) applyComputedEntropy(height int64, ent
 	// Only process if corresponds to next entropy
 	if entropyGenerator.entropyComputed[height] == nil && height == entropyGenerator.lastBlockHeight+1 {
 		message := string(tmhash.Sum(entropyGenerator.entropyComputed[entropyGenerator.lastComputedEntropyHeight]))
-		if entropyGenerator.aeon.aeonExecUnit.VerifyGroupSignature(message, string(entropy)) {
+		if entropyGenerator.verifyGroupSignature(message, string(entropy)) {
 			entropyGenerator.entropyComputed[height] = entropy
 		} else {
 			entropyGenerator.Logger.Error("received invalid computed entropy", "height", height)
 			entropyGenerator.Logger.Error("Note: aeon is: ", entropyGenerator.aeon)
+			return errInvalidComputedEntropy
 		}
 	}
-
-	//TODO: Should down rate peers which send irrelevant computed entropy or invalid entropy
+	return nil
 }
 
-// ApplyEntropyShare processes entropy share from reactor
-func (entropyGenerator *EntropyGenerator) applyEntropyShare(share *types.EntropyShare) {
+// ApplyEntropyShare processes entropy share from reactor. Returns an error if the share is invalid, which
+// peers only send if misbehaving. Irrelevant shares and further shares from a validator for a height are
+// ignored.
+func (entropyGenerator *EntropyGenerator) applyEntropyShare(share *types.EntropyShare) error {
 	// Should not be called in entropy generator is not running
 	if !entropyGenerator.isSigningEntropy() {
 		panic(fmt.Errorf("applyEntropyShare while entropy generator stopped"))
@@ -292,26 +463,33 @@ func (entropyGenerator *EntropyGenerator) applyEntropyShare(share *types.Entropy
 	defer entropyGenerator.mtx.Unlock()
 
 	index, validator := entropyGenerator.aeon.validators.GetByAddress(share.SignerAddress)
+	if _, ok := entropyGenerator.entropyShares[share.Height][uint(index)]; index >= 0 && ok {
+		// Glow shares carry a randomised proof, so a share signed again for the height, after a
+		// restart or by a retrying remote signer, differs from the share held without being a
+		// different signature
+		return nil
+	}
 	err := entropyGenerator.validInputs(share.Height, index)
 	if err != nil {
 		entropyGenerator.Logger.Debug("applyEntropyShare: rejected share", "error", err.Error())
-		return
+		return nil
 	}
 
 	// Verify signature on message
 	verifySig := validator.PubKey.VerifyBytes(share.SignBytes(entropyGenerator.baseConfig.ChainID()), share.Signature)
 	if !verifySig {
 		entropyGenerator.Logger.Error("applyEntropyShare: invalid validator signature", "validator", share.SignerAddress, "index", index)
-		return
+		return errInvalidShareSignature
 	}
 
 	// Verify share
 	message := string(tmhash.Sum(entropyGenerator.entropyComputed[entropyGenerator.lastComputedEntropyHeight]))
-	if !entropyGenerator.aeon.aeonExecUnit.Verify(message, share.SignatureShare, uint(index)) {
+	if !entropyGenerator.verifySignatureShare(message, share.SignatureShare, uint(index)) {
 		entropyGenerator.Logger.Error("applyEntropyShare: invalid entropy share", "height", share.Height,
 			"lastComputedEntropyHeight", entropyGenerator.lastComputedEntropyHeight, "lastBlockHeight",
 			entropyGenerator.lastBlockHeight, "validator", share.SignerAddress, "index", index)
-		return
+		entropyGenerator.addPendingEvidence(validator.PubKey, uint(index), share)
+		return errInvalidEntropyShare
 	}
 
 	entropyGenerator.Logger.Debug("applyEntropyShare: valid share received", "height", share.Height, "validator index", index)
@@ -320,7 +498,74 @@ func (entropyGenerator *EntropyGenerator) applyEntropyShare(share *types.Entropy
 	}
 
 	entropyGenerator.entropyShares[share.Height][uint(index)] = share.Copy()
-	return
+	return nil
+}
+
+// addPendingEvidence holds evidence against share until the block at its height is committed. Must be
+// called with mtx held.
+func (entropyGenerator *EntropyGenerator) addPendingEvidence(pubKey crypto.PubKey, index uint,
+	share *types.EntropyShare) {
+	if entropyGenerator.evpool == nil || entropyGenerator.stateDB == nil {
+		return
+	}
+	entropyGenerator.pendingEvidence = append(entropyGenerator.pendingEvidence, shareEvidence{
+		aeon:   entropyGenerator.aeon,
+		pubKey: pubKey,
+		index:  index,
+		share:  share.Copy(),
+	})
+}
+
+// reportPendingEvidence reports the pending evidence whose share height has been committed. Shares
+// found invalid against the local entropy are checked against the entropy on chain, as the node may
+// have been signing on entropy which did not make it into a block. Evidence for old heights, or
+// from a previous aeon, is dropped.
+func (entropyGenerator *EntropyGenerator) reportPendingEvidence() {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	remaining := entropyGenerator.pendingEvidence[:0]
+	for _, ev := range entropyGenerator.pendingEvidence {
+		if ev.aeon != entropyGenerator.aeon ||
+			ev.share.Height <= entropyGenerator.lastBlockHeight-entropyHistoryLength {
+			continue
+		}
+		blockTime, err := sm.LoadBlockTime(entropyGenerator.stateDB, ev.share.Height)
+		if err != nil {
+			// Block at the share height is not committed yet
+			remaining = append(remaining, ev)
+			continue
+		}
+		id := dkgID(ev.aeon.validatorHeight)
+		previousEntropy, err := sm.LoadPreviousEntropy(entropyGenerator.stateDB, ev.share.Height)
+		if err != nil {
+			entropyGenerator.Logger.Error("reportPendingEvidence: failed to load previous entropy", "height",
+				ev.share.Height, "err", err)
+			continue
+		}
+		if len(previousEntropy) == 0 {
+			previousEntropy = types.InitialEntropy(ev.aeon.dkgOutput().GroupPublicKey)
+		}
+		if entropyGenerator.verifySignatureShare(types.EntropyMessage(previousEntropy), ev.share.SignatureShare,
+			ev.index) {
+			entropyGenerator.Logger.Info("reportPendingEvidence: entropy share valid on chain", "height",
+				ev.share.Height, "validator", ev.share.SignerAddress)
+			continue
+		}
+		entropyGenerator.reportEvidence(types.NewInvalidEntropyShareEvidence(ev.pubKey, id, &ev.share, blockTime))
+	}
+	entropyGenerator.pendingEvidence = remaining
+}
+
+// reportEvidence adds evidence of a misbehaving aeon member to the evidence pool. Must be called
+// with mtx held.
+func (entropyGenerator *EntropyGenerator) reportEvidence(evidence types.Evidence) {
+	if entropyGenerator.evpool == nil {
+		return
+	}
+	if err := entropyGenerator.evpool.AddEvidence(evidence); err != nil {
+		entropyGenerator.Logger.Error("Failed to add beacon evidence", "evidence", evidence, "err", err)
+	}
 }
 
 func (entropyGenerator *EntropyGenerator) getLastComputedEntropyHeight() int64 {
@@ -337,11 +582,19 @@ func (entropyGenerator *EntropyGenerator) getLastBlockHeight() int64 {
 	return entropyGenerator.lastBlockHeight
 }
 
+// getComputedEntropy returns the entropy computed at height, loading entropy which has been flushed
+// from memory from the entropy store
 func (entropyGenerator *EntropyGenerator) getComputedEntropy(height int64) types.ThresholdSignature {
 	entropyGenerator.mtx.RLock()
 	defer entropyGenerator.mtx.RUnlock()
 
-	return entropyGenerator.entropyComputed[height]
+	if entropy := entropyGenerator.entropyComputed[height]; entropy != nil || entropyGenerator.entropyStore == nil {
+		return entropy
+	}
+	if entropy := entropyGenerator.entropyStore.LoadEntropy(height); entropy != nil {
+		return entropy.GroupSignature
+	}
+	return nil
 }
 
 // GetEntropyShares gets entropy shares at a particular height
@@ -355,6 +608,25 @@ func (entropyGenerator *EntropyGenerator) getEntropyShares(height int64) map[uin
 	return sharesCopy
 }
 
+// verifySignatureShare checks the share of the member with index of the current aeon. Must be called with
+// mtx held
+func (entropyGenerator *EntropyGenerator) verifySignatureShare(message string, share string, index uint) bool {
+	if entropyGenerator.signatureVerifier != nil {
+		return entropyGenerator.signatureVerifier.VerifySignatureShare(entropyGenerator.aeon.dkgOutput(), message,
+			share, index)
+	}
+	return entropyGenerator.aeon.aeonExecUnit.Verify(message, share, index)
+}
+
+// verifyGroupSignature checks the group signature of the current aeon. Must be called with mtx held
+func (entropyGenerator *EntropyGenerator) verifyGroupSignature(message string, signature string) bool {
+	if entropyGenerator.signatureVerifier != nil {
+		return entropyGenerator.signatureVerifier.VerifyGroupSignature(entropyGenerator.aeon.dkgOutput(), message,
+			types.ThresholdSignature(signature))
+	}
+	return entropyGenerator.aeon.aeonExecUnit.VerifyGroupSignature(message, signature)
+}
+
 func (entropyGenerator *EntropyGenerator) validInputs(height int64, index int) error {
 	if index < 0 || !entropyGenerator.aeon.aeonExecUnit.InQual(uint(index)) {
 		return fmt.Errorf("invalid validator index %v", index)
@@ -372,7 +644,9 @@ func (entropyGenerator *EntropyGenerator) sign() {
 	entropyGenerator.mtx.Lock()
 	defer entropyGenerator.mtx.Unlock()
 
-	if entropyGenerator.aeon.aeonExecUnit == nil || !entropyGenerator.aeon.aeonExecUnit.CanSign() {
+	aeonExecUnit := entropyGenerator.aeon.aeonExecUnit
+	if aeonExecUnit == nil || entropyGenerator.aeon.privValidator == nil ||
+		(!aeonExecUnit.CanSign() && entropyGenerator.aeonSigner == nil) {
 		entropyGenerator.Logger.Debug("sign: no dkg private key", "height", entropyGenerator.lastBlockHeight+1)
 		return
 	}
@@ -393,7 +667,16 @@ func (entropyGenerator *EntropyGenerator) sign() {
 		"nodeAddress", pubKey.Address())
 
 	message := string(tmhash.Sum(entropyGenerator.entropyComputed[entropyGenerator.lastComputedEntropyHeight]))
-	signature := entropyGenerator.aeon.aeonExecUnit.Sign(message, uint(index))
+	var signature string
+	if aeonExecUnit.CanSign() {
+		signature = aeonExecUnit.Sign(message, uint(index))
+	} else {
+		signature, err = entropyGenerator.aeonSigner.SignAeonShare(dkgID(entropyGenerator.aeon.validatorHeight), message)
+		if err != nil {
+			entropyGenerator.Logger.Error("sign: failed to sign with aeon signer", "height", blockHeight, "err", err)
+			return
+		}
+	}
 
 	// Insert own signature into entropy shares
 	if entropyGenerator.entropyShares[blockHeight] == nil {
@@ -456,6 +739,7 @@ OUTER_LOOP:
 			}
 		}
 		entropyGenerator.UpdateMetrics()
+		entropyGenerator.reportPendingEvidence()
 		// Continue onto the next random value
 		entropyGenerator.sign()
 		// Notify peers of of new entropy height
@@ -483,6 +767,11 @@ OUTER_LOOP:
 				}
 
 			}
+			entropyGenerator.eventBus.PublishEventNewEntropy(types.EventDataNewEntropy{
+				Height:  entropyToSend.Height,
+				Entropy: entropyToSend.Entropy,
+				Enabled: entropyToSend.Enabled,
+			})
 			// Update metrics
 			if entropyToSend.Enabled {
 				entropyGenerator.metrics.EntropyGenerating.Set(1.0)
@@ -514,6 +803,7 @@ func (entropyGenerator *EntropyGenerator) checkForNewEntropy() (bool, *types.Cha
 		entropyGenerator.Logger.Info("New entropy computed", "height", height)
 		entropyGenerator.lastBlockHeight++
 		entropyGenerator.lastComputedEntropyHeight = entropyGenerator.lastBlockHeight
+		entropyGenerator.saveEntropyShares(height)
 
 		return true, types.NewChannelEntropy(height, entropyGenerator.blockEntropy(height), true, entropyGenerator.aeon.validators.Hash())
 	}
@@ -526,7 +816,7 @@ func (entropyGenerator *EntropyGenerator) checkForNewEntropy() (bool, *types.Cha
 			signatureShares.Set(key, share.SignatureShare)
 		}
 		groupSignature := entropyGenerator.aeon.aeonExecUnit.ComputeGroupSignature(signatureShares)
-		if !entropyGenerator.aeon.aeonExecUnit.VerifyGroupSignature(message, groupSignature) {
+		if !entropyGenerator.verifyGroupSignature(message, groupSignature) {
 			entropyGenerator.Logger.Error("entropy_generator.VerifyGroupSignature == false")
 			return false, nil
 		}
@@ -535,6 +825,7 @@ func (entropyGenerator *EntropyGenerator) checkForNewEntropy() (bool, *types.Cha
 		entropyGenerator.entropyComputed[height] = []byte(groupSignature)
 		entropyGenerator.lastBlockHeight++
 		entropyGenerator.lastComputedEntropyHeight = entropyGenerator.lastBlockHeight
+		entropyGenerator.saveEntropyShares(height)
 		entropyGenerator.metrics.LastGenEntropyHeight.Set(float64(height))
 
 		// Update metrics
@@ -559,6 +850,54 @@ func (entropyGenerator *EntropyGenerator) blockEntropy(height int64) types.Block
 		dkgID(entropyGenerator.aeon.validatorHeight))
 }
 
+// saveEntropyShares saves the shares the entropy computed at height was computed from in the
+// entropy store, if configured. The entropy itself is saved in the store when its block is
+// committed. Must be called with mtx held.
+func (entropyGenerator *EntropyGenerator) saveEntropyShares(height int64) {
+	if entropyGenerator.entropyStore == nil || !entropyGenerator.beaconConfig.SaveEntropyShares {
+		return
+	}
+
+	var shares []types.EntropyShare
+	for _, share := range entropyGenerator.entropyShares[height] {
+		shares = append(shares, share.Copy())
+	}
+	if len(shares) == 0 {
+		return
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return bytes.Compare(shares[i].SignerAddress, shares[j].SignerAddress) < 0
+	})
+	entropyGenerator.entropyStore.SaveEntropyShares(height, shares)
+}
+
+// loadStoredEntropy loads the recent entropy history up to the last block from the entropy
+// store, and the last entropy on chain if it has not been set
+func (entropyGenerator *EntropyGenerator) loadStoredEntropy() {
+	entropyGenerator.mtx.Lock()
+	defer entropyGenerator.mtx.Unlock()
+
+	if entropyGenerator.entropyStore == nil {
+		return
+	}
+	lastBlockHeight := entropyGenerator.lastBlockHeight
+	for height := lastBlockHeight - entropyHistoryLength; height <= lastBlockHeight; height++ {
+		if height <= 0 || entropyGenerator.entropyComputed[height] != nil {
+			continue
+		}
+		if entropy := entropyGenerator.entropyStore.LoadEntropy(height); entropy != nil {
+			entropyGenerator.entropyComputed[height] = entropy.GroupSignature
+		}
+	}
+	if entropyGenerator.lastComputedEntropyHeight == -1 {
+		height, entropy := entropyGenerator.entropyStore.LoadLastEntropy(lastBlockHeight)
+		if entropy != nil {
+			entropyGenerator.entropyComputed[height] = entropy.GroupSignature
+			entropyGenerator.lastComputedEntropyHeight = height
+		}
+	}
+}
+
 func (entropyGenerator *EntropyGenerator) flushOldEntropy() {
 	entropyGenerator.mtx.Lock()
 	defer entropyGenerator.mtx.Unlock()
@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/beacon/verifier"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -120,6 +123,33 @@ func TestEntropyGeneratorSign(t *testing.T) {
 	})
 }
 
+func TestEntropyGeneratorSkipEntropy(t *testing.T) {
+	nValidators := 4
+	state, privVals := groupTestSetup(nValidators)
+
+	pubKey := privVals[0].GetPubKey()
+	index, _ := state.Validators.GetByAddress(pubKey.Address())
+	newGen := testEntropyGen(state.Validators, privVals[0], index)
+	newGen.SetLastComputedEntropy(2, []byte("Test Entropy"))
+	newGen.setLastBlockHeight(2)
+	newGen.sign()
+	assert.True(t, len(newGen.entropyShares[3]) == 1)
+
+	t.Run("skip wrong height", func(t *testing.T) {
+		newGen.SkipEntropy(4)
+		assert.Equal(t, int64(2), newGen.getLastBlockHeight())
+	})
+	t.Run("skip valid", func(t *testing.T) {
+		newGen.SkipEntropy(3)
+		assert.Equal(t, int64(3), newGen.getLastBlockHeight())
+		assert.Equal(t, int64(2), newGen.getLastComputedEntropyHeight())
+		assert.True(t, len(newGen.entropyShares[3]) == 0)
+
+		newGen.sign()
+		assert.True(t, len(newGen.entropyShares[4]) == 1)
+	})
+}
+
 func TestEntropyGeneratorApplyShare(t *testing.T) {
 	nValidators := 4
 	state, privVals := groupTestSetup(nValidators)
@@ -128,6 +158,15 @@ func TestEntropyGeneratorApplyShare(t *testing.T) {
 	newGen := testEntropyGen(state.Validators, nil, -1)
 	newGen.SetLastComputedEntropy(1, []byte("Test Entropy"))
 	newGen.setLastBlockHeight(1)
+	evpool := &mockEvidencePool{}
+	newGen.SetEvidencePool(evpool)
+	stateDB := dbm.NewMemDB()
+	newGen.SetStateDB(stateDB)
+	commitState := state.Copy()
+	commitState.LastBlockHeight = 1
+	commitState.LastComputedEntropyHeight = 1
+	commitState.LastComputedEntropy = []byte("Test Entropy")
+	sm.SaveState(stateDB, commitState)
 
 	t.Run("applyShare non-validator", func(t *testing.T) {
 		_, privVal := types.RandValidator(false, 30)
@@ -188,8 +227,20 @@ func TestEntropyGeneratorApplyShare(t *testing.T) {
 		// Sign message
 		privVal.SignEntropy(newGen.baseConfig.ChainID(), &share)
 
-		newGen.applyEntropyShare(&share)
+		assert.Equal(t, errInvalidEntropyShare, newGen.applyEntropyShare(&share))
 		assert.True(t, len(newGen.entropyShares[2]) == 0)
+
+		// Evidence is held until the block at the share height is committed
+		newGen.reportPendingEvidence()
+		assert.Len(t, evpool.evidence, 0)
+
+		commitState.LastBlockHeight = 2
+		commitState.LastBlockTime = tmtime.Now()
+		sm.SaveState(stateDB, commitState)
+		newGen.reportPendingEvidence()
+		require.Len(t, evpool.evidence, 1)
+		assert.IsType(t, &types.InvalidEntropyShareEvidence{}, evpool.evidence[0])
+		assert.True(t, commitState.LastBlockTime.Equal(evpool.evidence[0].Time()))
 	})
 	t.Run("applyShare invalid validator signature", func(t *testing.T) {
 		pubKey := privVals[0].GetPubKey()
@@ -203,7 +254,7 @@ func TestEntropyGeneratorApplyShare(t *testing.T) {
 		// Alter signature message
 		privVals[0].SignEntropy("wrong chain ID", &share)
 
-		newGen.applyEntropyShare(&share)
+		assert.Equal(t, errInvalidShareSignature, newGen.applyEntropyShare(&share))
 		assert.True(t, len(newGen.entropyShares[2]) == 0)
 	})
 	t.Run("applyShare correct", func(t *testing.T) {
@@ -216,9 +267,36 @@ func TestEntropyGeneratorApplyShare(t *testing.T) {
 		otherGen.sign()
 		share := otherGen.entropyShares[2][uint(index)]
 
-		newGen.applyEntropyShare(&share)
+		assert.NoError(t, newGen.applyEntropyShare(&share))
 		assert.True(t, len(newGen.entropyShares[2]) == 1)
 	})
+	t.Run("applyShare duplicate", func(t *testing.T) {
+		privVal := privVals[0]
+		pubKey := privVal.GetPubKey()
+		index, _ := state.Validators.GetByAddress(pubKey.Address())
+		share := types.EntropyShare{
+			Height:         2,
+			SignerAddress:  pubKey.Address(),
+			SignatureShare: "duplicate share",
+		}
+		privVal.SignEntropy(newGen.baseConfig.ChainID(), &share)
+
+		// Shares signed again for a height are ignored, as glow shares differ in their proofs
+		assert.NoError(t, newGen.applyEntropyShare(&share))
+		assert.True(t, len(newGen.entropyShares[2]) == 1)
+		assert.NotEqual(t, share.SignatureShare, newGen.entropyShares[2][uint(index)].SignatureShare)
+		newGen.reportPendingEvidence()
+		require.Len(t, evpool.evidence, 1)
+	})
+}
+
+type mockEvidencePool struct {
+	evidence []types.Evidence
+}
+
+func (evpool *mockEvidencePool) AddEvidence(evidence types.Evidence) error {
+	evpool.evidence = append(evpool.evidence, evidence)
+	return nil
 }
 
 func TestEntropyGeneratorFlush(t *testing.T) {
@@ -241,6 +319,58 @@ func TestEntropyGeneratorFlush(t *testing.T) {
 	assert.True(t, len(newGen.entropyComputed) <= entropyHistoryLength+1)
 }
 
+func TestEntropyGeneratorEntropyStore(t *testing.T) {
+	state, privVal := groupTestSetup(1)
+
+	newGen := testEntropyGenerator()
+	newGen.SetLogger(log.TestingLogger())
+	newGen.beaconConfig.SaveEntropyShares = true
+	store := NewEntropyStore(dbm.NewMemDB(), 5)
+	newGen.SetEntropyStore(store)
+
+	aeonExecUnit := testAeonFromFile("test_keys/single_validator.txt")
+	aeonDetails, _ := newAeonDetails(privVal[0], 1, state.Validators, aeonExecUnit, 1, 50)
+	newGen.SetNextAeonDetails(aeonDetails)
+	newGen.SetLastComputedEntropy(0, []byte("Test Entropy"))
+	newGen.Start()
+
+	assert.Eventually(t, func() bool { return newGen.getLastBlockHeight() >= 21 }, 3*time.Second, 500*time.Millisecond)
+	newGen.Stop()
+	// Wait for compute entropy routine to exit
+	time.Sleep(time.Second)
+
+	// Only shares are saved as entropy is computed
+	lastHeight := newGen.getLastBlockHeight()
+	assert.EqualValues(t, 0, store.Height())
+	for h := int64(1); h <= lastHeight; h++ {
+		assert.Len(t, store.LoadEntropyShares(h), 1)
+	}
+
+	// Entropy is saved once committed, and old entropy and shares are pruned
+	for h := int64(1); h <= lastHeight; h++ {
+		if entropy := newGen.getComputedEntropy(h); entropy != nil {
+			store.SaveEntropy(h, types.NewBlockEntropy(entropy, h-aeonDetails.Start,
+				aeonDetails.End-aeonDetails.Start, dkgID(aeonDetails.validatorHeight)))
+		}
+	}
+	base, height := store.Base(), store.Height()
+	assert.EqualValues(t, lastHeight, height)
+	assert.EqualValues(t, height-4, base)
+	assert.Nil(t, store.LoadEntropy(base-1))
+	assert.Nil(t, store.LoadEntropyShares(base-1))
+
+	// The entropy history and last entropy on chain are recovered from the store on restart
+	restartedGen := testEntropyGenerator()
+	restartedGen.SetEntropyStore(store)
+	restartedGen.setLastBlockHeight(height)
+	restartedGen.loadStoredEntropy()
+	for h := base; h <= height; h++ {
+		assert.Equal(t, store.LoadEntropy(h).GroupSignature, restartedGen.getComputedEntropy(h))
+	}
+	assert.Len(t, restartedGen.entropyComputed, 5)
+	assert.EqualValues(t, height, restartedGen.getLastComputedEntropyHeight())
+}
+
 func TestEntropyGeneratorApplyComputedEntropy(t *testing.T) {
 	nValidators := 4
 	state, privVals := groupTestSetup(nValidators)
@@ -268,7 +398,7 @@ func TestEntropyGeneratorApplyComputedEntropy(t *testing.T) {
 
 		otherGen.sign()
 		share := otherGen.getEntropyShares(2)[uint(index)]
-		newGen.applyComputedEntropy(2, []byte(share.SignatureShare))
+		assert.Equal(t, errInvalidComputedEntropy, newGen.applyComputedEntropy(2, []byte(share.SignatureShare)))
 		assert.True(t, newGen.getComputedEntropy(2) == nil)
 	})
 	t.Run("applyEntropy correct", func(t *testing.T) {
@@ -292,11 +422,51 @@ func TestEntropyGeneratorApplyComputedEntropy(t *testing.T) {
 		}
 
 		assert.Eventually(t, func() bool { return otherGen.getLastComputedEntropyHeight() >= 2 }, time.Second, 10*time.Millisecond)
-		newGen.applyComputedEntropy(2, otherGen.getComputedEntropy(2))
+		assert.NoError(t, newGen.applyComputedEntropy(2, otherGen.getComputedEntropy(2)))
 		assert.True(t, bytes.Equal(newGen.getComputedEntropy(2), otherGen.getComputedEntropy(2)))
 	})
 }
 
+func TestEntropyGeneratorSignatureVerifier(t *testing.T) {
+	nValidators := 4
+	state, privVals := groupTestSetup(nValidators)
+
+	// Set up non-validator verifying with the pure Go verifier
+	newGen := testEntropyGen(state.Validators, nil, -1)
+	newGen.SetSignatureVerifier(verifier.NewAeonVerifier())
+	newGen.SetLastComputedEntropy(1, []byte("Test Entropy"))
+	newGen.setLastBlockHeight(1)
+	newGen.SetEvidencePool(&mockEvidencePool{})
+
+	shares := make([]types.EntropyShare, 0, nValidators)
+	for _, val := range privVals {
+		index, _ := state.Validators.GetByAddress(val.GetPubKey().Address())
+		otherGen := testEntropyGen(state.Validators, val, index)
+		otherGen.SetLastComputedEntropy(1, []byte("Test Entropy"))
+		otherGen.setLastBlockHeight(1)
+		otherGen.sign()
+		shares = append(shares, otherGen.getEntropyShares(2)[uint(index)])
+	}
+
+	// Share signed with the key of another member
+	invalidShare := shares[0]
+	invalidShare.SignatureShare = shares[1].SignatureShare
+	privVals[0].SignEntropy(newGen.baseConfig.ChainID(), &invalidShare)
+	assert.Equal(t, errInvalidEntropyShare, newGen.applyEntropyShare(&invalidShare))
+
+	for i := range shares {
+		assert.NoError(t, newGen.applyEntropyShare(&shares[i]))
+	}
+	assert.Len(t, newGen.getEntropyShares(2), nValidators)
+
+	// Entropy computed from the shares is verified
+	newGen.Start()
+	defer newGen.Stop()
+	assert.Eventually(t, func() bool { return newGen.getLastComputedEntropyHeight() >= 2 }, time.Second,
+		10*time.Millisecond)
+	assert.Equal(t, errInvalidComputedEntropy, newGen.applyComputedEntropy(3, []byte(shares[0].SignatureShare)))
+}
+
 func TestEntropyGeneratorChangeKeys(t *testing.T) {
 	newGen := testEntropyGenerator()
 	newGen.SetLogger(log.TestingLogger())
@@ -0,0 +1,325 @@
+package beacon
+
+import (
+	"fmt"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+var entropyStoreKey = []byte("entropyStore")
+
+/*
+EntropyStore is a low level store for the entropy of the chain. For each height with non-trivial
+entropy it holds:
+ - BlockEntropy: The entropy of the block committed at the height, with the aeon round and dkg id
+   generating it
+ - Entropy shares: Optionally, the signature shares the beacon computed the entropy from
+
+Entropy is saved once its block is committed, so the store agrees with the chain, and is also
+indexed by the dkg id of the aeon so the entropy generated by an aeon can be listed. Heights in
+keys are zero padded, so the last entropy and the entropy to prune are found by iterating over
+the stored heights only. The store is used for recovering entropy after a restart, rpc queries
+and catching up peers.
+
+NOTE: EntropyStore methods will panic if they encounter errors deserializing loaded data,
+indicating probable corruption on disk.
+*/
+type EntropyStore struct {
+	db           dbm.DB
+	retainBlocks int64
+
+	mtx    sync.RWMutex
+	base   int64
+	height int64
+}
+
+// NewEntropyStore returns a new EntropyStore with the given DB, initialized to the heights
+// saved in the DB. Entropy older than retainBlocks is pruned as entropy is saved, or never
+// if 0.
+func NewEntropyStore(db dbm.DB, retainBlocks int64) *EntropyStore {
+	esjson := loadEntropyStoreStateJSON(db)
+	return &EntropyStore{
+		db:           db,
+		retainBlocks: retainBlocks,
+		base:         esjson.Base,
+		height:       esjson.Height,
+	}
+}
+
+// Base returns the lowest height for which entropy may be stored, or 0 if the store is empty
+func (es *EntropyStore) Base() int64 {
+	es.mtx.RLock()
+	defer es.mtx.RUnlock()
+	return es.base
+}
+
+// Height returns the highest height with stored entropy, or 0 if the store is empty
+func (es *EntropyStore) Height() int64 {
+	es.mtx.RLock()
+	defer es.mtx.RUnlock()
+	return es.height
+}
+
+// LoadEntropy returns the entropy at height, or nil if there is none
+func (es *EntropyStore) LoadEntropy(height int64) *types.BlockEntropy {
+	bz, err := es.db.Get(calcEntropyKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	return decodeEntropy(height, bz)
+}
+
+// LoadEntropyShares returns the signature shares the entropy at height was computed from, or nil
+// if they were not saved
+func (es *EntropyStore) LoadEntropyShares(height int64) []types.EntropyShare {
+	bz, err := es.db.Get(calcEntropySharesKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	var shares []types.EntropyShare
+	if err := cdc.UnmarshalBinaryBare(bz, &shares); err != nil {
+		panic(fmt.Sprintf("Error reading entropy shares at height %v: %v", height, err))
+	}
+	return shares
+}
+
+// LoadLastEntropy returns the entropy at the highest height not above maxHeight, and its height.
+// Returns nil if there is no such entropy in the store.
+func (es *EntropyStore) LoadLastEntropy(maxHeight int64) (int64, *types.BlockEntropy) {
+	if maxHeight <= 0 {
+		return 0, nil
+	}
+	itr, err := es.db.ReverseIterator(calcEntropyKey(0), calcEntropyKey(maxHeight+1))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, nil
+	}
+	height := parseHeightKey(itr.Key(), entropyKeyPrefix)
+	return height, decodeEntropy(height, itr.Value())
+}
+
+// LoadAeonEntropyHeights returns the heights, in ascending order, of the stored entropy
+// generated by the aeon with dkgID
+func (es *EntropyStore) LoadAeonEntropyHeights(dkgID int64) []int64 {
+	prefix := calcAeonEntropyPrefix(dkgID)
+	itr, err := es.db.Iterator(prefix, append(prefix, 0xff))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	var heights []int64
+	for ; itr.Valid(); itr.Next() {
+		heights = append(heights, parseHeightKey(itr.Key(), prefix))
+	}
+	return heights
+}
+
+// SaveEntropy persists the entropy of the block committed at height, and prunes entropy older
+// than the retained blocks. Entropy which is already stored, or below the base of the store, is
+// not saved. Implements state.EntropyStore.
+func (es *EntropyStore) SaveEntropy(height int64, entropy *types.BlockEntropy) {
+	if entropy == nil || height <= 0 {
+		panic(fmt.Sprintf("EntropyStore can only save non-nil entropy at positive heights, got %v", height))
+	}
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+
+	if height < es.base || es.LoadEntropy(height) != nil {
+		return
+	}
+
+	batch := es.db.NewBatch()
+	defer batch.Close()
+
+	batch.Set(calcEntropyKey(height), cdc.MustMarshalBinaryBare(entropy))
+	batch.Set(calcAeonEntropyKey(entropy.DKGID, height), calcEntropyKey(height))
+
+	if es.base == 0 {
+		es.base = height
+	}
+	if height > es.height {
+		es.height = height
+	}
+	if es.retainBlocks > 0 && es.height-es.retainBlocks+1 > es.base {
+		es.prune(batch, es.height-es.retainBlocks+1)
+	}
+	batch.Set(entropyStoreKey, entropyStoreStateJSON{Base: es.base, Height: es.height}.bytes())
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+}
+
+// SaveEntropyShares persists the signature shares the entropy at height was computed from. The
+// shares are saved when the entropy is computed, and are kept even if the block at height is
+// committed without the entropy until they are pruned.
+func (es *EntropyStore) SaveEntropyShares(height int64, shares []types.EntropyShare) {
+	if len(shares) == 0 || height <= 0 {
+		panic(fmt.Sprintf("EntropyStore can only save shares at positive heights, got %v shares at height %v",
+			len(shares), height))
+	}
+	es.mtx.RLock()
+	defer es.mtx.RUnlock()
+
+	if height < es.base {
+		return
+	}
+	if err := es.db.SetSync(calcEntropySharesKey(height), cdc.MustMarshalBinaryBare(shares)); err != nil {
+		panic(err)
+	}
+}
+
+// PruneEntropy removes the entropy, and entropy shares, below retainHeight. Returns the number
+// of heights with entropy pruned.
+func (es *EntropyStore) PruneEntropy(retainHeight int64) (uint64, error) {
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+
+	if retainHeight <= es.base {
+		return 0, nil
+	}
+	if retainHeight > es.height+1 {
+		return 0, fmt.Errorf("cannot prune beyond the latest entropy height %v", es.height)
+	}
+
+	batch := es.db.NewBatch()
+	defer batch.Close()
+
+	pruned := es.prune(batch, retainHeight)
+	batch.Set(entropyStoreKey, entropyStoreStateJSON{Base: es.base, Height: es.height}.bytes())
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+// prune adds the deletion of the entropy and shares from the base up to retainHeight to batch,
+// and moves the base to retainHeight. Returns the number of heights with entropy pruned. Must
+// be called with mtx held.
+func (es *EntropyStore) prune(batch dbm.Batch, retainHeight int64) uint64 {
+	entropies := es.loadEntropyRange(es.base, retainHeight)
+	for height, entropy := range entropies {
+		batch.Delete(calcEntropyKey(height))
+		batch.Delete(calcAeonEntropyKey(entropy.DKGID, height))
+	}
+	for _, height := range es.loadSharesHeights(es.base, retainHeight) {
+		batch.Delete(calcEntropySharesKey(height))
+	}
+	es.base = retainHeight
+	return uint64(len(entropies))
+}
+
+// loadEntropyRange returns the stored entropy in the range [start, end) by height
+func (es *EntropyStore) loadEntropyRange(start, end int64) map[int64]*types.BlockEntropy {
+	itr, err := es.db.Iterator(calcEntropyKey(start), calcEntropyKey(end))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	entropies := make(map[int64]*types.BlockEntropy)
+	for ; itr.Valid(); itr.Next() {
+		height := parseHeightKey(itr.Key(), entropyKeyPrefix)
+		entropies[height] = decodeEntropy(height, itr.Value())
+	}
+	return entropies
+}
+
+// loadSharesHeights returns the heights in the range [start, end) with stored entropy shares
+func (es *EntropyStore) loadSharesHeights(start, end int64) []int64 {
+	itr, err := es.db.Iterator(calcEntropySharesKey(start), calcEntropySharesKey(end))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	var heights []int64
+	for ; itr.Valid(); itr.Next() {
+		heights = append(heights, parseHeightKey(itr.Key(), entropySharesKeyPrefix))
+	}
+	return heights
+}
+
+func decodeEntropy(height int64, bz []byte) *types.BlockEntropy {
+	entropy := new(types.BlockEntropy)
+	if err := cdc.UnmarshalBinaryBare(bz, entropy); err != nil {
+		panic(fmt.Sprintf("Error reading entropy at height %v: %v", height, err))
+	}
+	return entropy
+}
+
+// Heights are zero padded in keys so entropy is iterated in height order
+var (
+	entropyKeyPrefix       = []byte("E:")
+	entropySharesKeyPrefix = []byte("ES:")
+)
+
+func calcEntropyKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", entropyKeyPrefix, height))
+}
+
+func calcEntropySharesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", entropySharesKeyPrefix, height))
+}
+
+func calcAeonEntropyKey(dkgID int64, height int64) []byte {
+	return append(calcAeonEntropyPrefix(dkgID), []byte(fmt.Sprintf("%020d", height))...)
+}
+
+func calcAeonEntropyPrefix(dkgID int64) []byte {
+	return []byte(fmt.Sprintf("AE:%v:", dkgID))
+}
+
+func parseHeightKey(key []byte, prefix []byte) int64 {
+	var height int64
+	if _, err := fmt.Sscanf(string(key[len(prefix):]), "%d", &height); err != nil {
+		panic(fmt.Sprintf("Invalid entropy store key %X: %v", key, err))
+	}
+	return height
+}
+
+//-----------------------------------------------------------------------------
+
+// entropyStoreStateJSON is the entropy store state JSON structure.
+type entropyStoreStateJSON struct {
+	Base   int64 `json:"base"`
+	Height int64 `json:"height"`
+}
+
+func (esjson entropyStoreStateJSON) bytes() []byte {
+	bz, err := cdc.MarshalJSON(esjson)
+	if err != nil {
+		panic(fmt.Sprintf("Could not marshal state bytes: %v", err))
+	}
+	return bz
+}
+
+// loadEntropyStoreStateJSON returns the entropyStoreStateJSON as loaded from disk, or the zero
+// value if none was previously persisted
+func loadEntropyStoreStateJSON(db dbm.DB) entropyStoreStateJSON {
+	bz, err := db.Get(entropyStoreKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return entropyStoreStateJSON{}
+	}
+	esjson := entropyStoreStateJSON{}
+	if err := cdc.UnmarshalJSON(bz, &esjson); err != nil {
+		panic(fmt.Sprintf("Could not unmarshal bytes: %X", bz))
+	}
+	return esjson
+}
@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestEntropyStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewEntropyStore(db, 0)
+	assert.EqualValues(t, 0, store.Base())
+	assert.EqualValues(t, 0, store.Height())
+	assert.Nil(t, store.LoadEntropy(1))
+	_, last := store.LoadLastEntropy(10)
+	assert.Nil(t, last)
+
+	shares := []types.EntropyShare{{Height: 3, SignerAddress: []byte("address"), SignatureShare: "share"}}
+	for height := int64(2); height <= 10; height++ {
+		if height == 6 {
+			continue
+		}
+		dkgID := int64(1)
+		if height > 7 {
+			dkgID = 7
+		}
+		store.SaveEntropy(height, types.NewBlockEntropy([]byte{byte(height)}, height, 10, dkgID))
+	}
+	store.SaveEntropyShares(3, shares)
+	// Shares of entropy which was not committed
+	store.SaveEntropyShares(6, shares)
+	assert.EqualValues(t, 2, store.Base())
+	assert.EqualValues(t, 10, store.Height())
+
+	// Existing entropy is not overwritten
+	store.SaveEntropy(4, types.NewBlockEntropy([]byte("other"), 4, 10, 1))
+	assert.Equal(t, types.ThresholdSignature{4}, store.LoadEntropy(4).GroupSignature)
+
+	assert.Equal(t, shares, store.LoadEntropyShares(3))
+	assert.Nil(t, store.LoadEntropyShares(4))
+	assert.Equal(t, []int64{2, 3, 4, 5, 7}, store.LoadAeonEntropyHeights(1))
+	assert.Equal(t, []int64{8, 9, 10}, store.LoadAeonEntropyHeights(7))
+
+	height, entropy := store.LoadLastEntropy(6)
+	assert.EqualValues(t, 5, height)
+	assert.Equal(t, types.ThresholdSignature{5}, entropy.GroupSignature)
+	height, _ = store.LoadLastEntropy(100)
+	assert.EqualValues(t, 10, height)
+	_, entropy = store.LoadLastEntropy(1)
+	assert.Nil(t, entropy)
+
+	// Prune
+	_, err := store.PruneEntropy(12)
+	assert.Error(t, err)
+	pruned, err := store.PruneEntropy(4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+	assert.EqualValues(t, 4, store.Base())
+	assert.Nil(t, store.LoadEntropy(3))
+	assert.Nil(t, store.LoadEntropyShares(3))
+	assert.Equal(t, []int64{4, 5, 7}, store.LoadAeonEntropyHeights(1))
+	pruned, err = store.PruneEntropy(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+	pruned, err = store.PruneEntropy(7)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+	assert.Nil(t, store.LoadEntropyShares(6))
+
+	// Entropy below the base is not saved
+	store.SaveEntropy(5, types.NewBlockEntropy([]byte{5}, 5, 10, 1))
+	assert.Nil(t, store.LoadEntropy(5))
+
+	// Heights are persisted
+	store = NewEntropyStore(db, 0)
+	assert.EqualValues(t, 7, store.Base())
+	assert.EqualValues(t, 10, store.Height())
+}
+
+func TestEntropyStoreRetainBlocks(t *testing.T) {
+	store := NewEntropyStore(dbm.NewMemDB(), 3)
+	shares := []types.EntropyShare{{Height: 1, SignerAddress: []byte("address"), SignatureShare: "share"}}
+	for height := int64(1); height <= 6; height++ {
+		store.SaveEntropyShares(height, shares)
+		store.SaveEntropy(height, types.NewBlockEntropy([]byte{byte(height)}, height, 10, 1))
+	}
+
+	assert.EqualValues(t, 4, store.Base())
+	assert.EqualValues(t, 6, store.Height())
+	assert.Equal(t, []int64{4, 5, 6}, store.LoadAeonEntropyHeights(1))
+	assert.Nil(t, store.LoadEntropyShares(3))
+	assert.Equal(t, shares, store.LoadEntropyShares(4))
+}
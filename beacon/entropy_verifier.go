@@ -0,0 +1,77 @@
+package beacon
+
+import (
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// EntropyVerifier verifies block entropy using verify-only aeon execution units created from the public
+// dkg output of each aeon. Implements types.AeonSignatureVerifier and types.BeaconEvidenceVerifier
+type EntropyVerifier struct {
+	mtx       sync.Mutex
+	execUnits map[string]BaseAeon // keyed by dkg output hash
+}
+
+var _ types.AeonSignatureVerifier = (*EntropyVerifier)(nil)
+var _ types.BeaconEvidenceVerifier = (*EntropyVerifier)(nil)
+
+// NewEntropyVerifier returns a new EntropyVerifier
+func NewEntropyVerifier() *EntropyVerifier {
+	return &EntropyVerifier{
+		execUnits: make(map[string]BaseAeon),
+	}
+}
+
+// VerifyGroupSignature checks the signature is a valid group signature of message by aeon
+func (verifier *EntropyVerifier) VerifyGroupSignature(aeon *types.DKGOutput, message string,
+	signature types.ThresholdSignature) bool {
+	verifier.mtx.Lock()
+	defer verifier.mtx.Unlock()
+
+	execUnit := verifier.execUnit(aeon)
+	if execUnit == nil {
+		return false
+	}
+	return execUnit.VerifyGroupSignature(message, string(signature))
+}
+
+// VerifySignatureShare checks the share is a valid signature share of message by the member of aeon
+// with index
+func (verifier *EntropyVerifier) VerifySignatureShare(aeon *types.DKGOutput, message string, share string,
+	index uint) bool {
+	verifier.mtx.Lock()
+	defer verifier.mtx.Unlock()
+
+	execUnit := verifier.execUnit(aeon)
+	if execUnit == nil || !execUnit.InQual(index) {
+		return false
+	}
+	return execUnit.Verify(message, share, index)
+}
+
+// VerifyComplaintAnswer returns false if the shares exposed for complainer in the complaint answers
+// are inconsistent with the coefficients of their sender, in a dkg generating keys of keyType
+func (verifier *EntropyVerifier) VerifyComplaintAnswer(coefficients string, answers string, complainer uint,
+	threshold uint, keyType string) bool {
+	if len(keyType) == 0 {
+		keyType = GetAeonType()
+	}
+	return VerifyComplaintAnswer(coefficients, answers, complainer, threshold, keyType)
+}
+
+// execUnit returns the cached execution unit for aeon, or nil for an invalid aeon. Must be
+// called with mtx held.
+func (verifier *EntropyVerifier) execUnit(aeon *types.DKGOutput) BaseAeon {
+	if aeon == nil || aeon.IsKeyless() || aeon.ValidateBasic() != nil {
+		return nil
+	}
+
+	hash := string(aeon.Hash())
+	execUnit, ok := verifier.execUnits[hash]
+	if !ok {
+		execUnit = aeonExecUnitFromOutput(aeon, "")
+		verifier.execUnits[hash] = execUnit
+	}
+	return execUnit
+}
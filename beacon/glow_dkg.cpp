@@ -109,6 +109,7 @@ void GlowDkg::NewCabinet(CabinetIndex cabinet_size, CabinetIndex threshold, Cabi
   mcl::Init(this->A_ik_, cabinet_size_, polynomial_degree_ + 1);
   mcl::Init(this->secret_commitments_, cabinet_size_, cabinet_size_);
   mcl::Init(a_i_, this->polynomial_degree_ + 1);
+  mcl::Init(b_i_, this->polynomial_degree_ + 1);
   mcl::Init(B_i_, this->cabinet_size_);
 
   this->qual_.clear();
@@ -117,22 +118,25 @@ void GlowDkg::NewCabinet(CabinetIndex cabinet_size, CabinetIndex threshold, Cabi
 
 void GlowDkg::GenerateCoefficients()
 {
-  std::vector<PrivateKey> b_i(polynomial_degree_ + 1, GetZeroFr());
   for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
   {
     a_i_[k].Random();
-    b_i[k].Random();
+    b_i_[k].Random();
   }
+  ComputeCoefficientsAndShares();
+}
 
+void GlowDkg::ComputeCoefficientsAndShares()
+{
   for (CabinetIndex k = 0; k <= polynomial_degree_; k++)
   {
     this->C_ik_[cabinet_index_][k] =
-        mcl::ComputeLHS(GetGroupG(), GetGroupH(), a_i_[k], b_i[k]);
+        mcl::ComputeLHS(GetGroupG(), GetGroupH(), a_i_[k], b_i_[k]);
   }
 
   for (CabinetIndex l = 0; l < cabinet_size_; l++)
   {
-    mcl::ComputeShares(this->s_ij_[cabinet_index_][l], this->sprime_ij_[cabinet_index_][l], a_i_, b_i, l);
+    mcl::ComputeShares(this->s_ij_[cabinet_index_][l], this->sprime_ij_[cabinet_index_][l], a_i_, b_i_, l);
   }
 }
 
@@ -44,8 +44,10 @@ public:
   void ComputePublicKeys() override;
   std::shared_ptr<BaseAeon> GetDkgOutput() const override;
 
+protected:
+  void ComputeCoefficientsAndShares() override;
+
 private:
-  std::vector<PrivateKey> a_i_;
   std::vector<GroupPublicKey> B_i_;
 
   GroupPublicKey GetGeneratorG2() const;
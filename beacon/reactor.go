@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/behaviour"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	bits "github.com/tendermint/tendermint/libs/bits"
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,8 +24,18 @@ const (
 	StateChannel = byte(0x80)
 	// EntropyChannel along which signature shares on previous entropy are communicated
 	EntropyChannel = byte(0x81)
+	// DKGPayloadChannel along which the payloads of dkg messages are gossiped off chain
+	DKGPayloadChannel = byte(0x82)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
+	// Payloads are sent together with the dkg message committing to them
+	maxDKGPayloadMsgSize = types.MaxDKGPayloadSize + maxMsgSize
+
+	// maxEntropySharesPerMsg keeps batches of entropy shares within maxMsgSize
+	maxEntropySharesPerMsg = 1000
+	// entropySharesLookAhead is how far past the last entropy height of a peer it may announce
+	// entropy shares, as the announcement can arrive before its new entropy height
+	entropySharesLookAhead = 2
 )
 
 //-----------------------------------------------------------------------------
@@ -32,23 +45,23 @@ type Reactor struct {
 	p2p.BaseReactor // BaseService + p2p.Switch
 
 	entropyGen *EntropyGenerator
+	dkgRunner  *DKGRunner // gossips off-chain dkg payloads, if set
 
 	mtx      sync.RWMutex
 	fastSync bool
 
-	// Access blockchain through RPC for catching up peers
-	blockStore sm.BlockStore
+	// Peer behaviour is reported here for scoring peers
+	reporter behaviour.Reporter
 }
 
 // NewReactor returns a new Reactor with the given entropyGenerator.
-func NewReactor(entropyGenerator *EntropyGenerator, fastSync bool, blockStore sm.BlockStore) *Reactor {
+func NewReactor(entropyGenerator *EntropyGenerator, fastSync bool) *Reactor {
 	if entropyGenerator == nil {
 		panic(fmt.Sprintf("NewReactor with nil entropy generator"))
 	}
 	BeaconR := &Reactor{
 		entropyGen: entropyGenerator,
 		fastSync:   fastSync,
-		blockStore: blockStore,
 	}
 
 	BeaconR.BaseReactor = *p2p.NewBaseReactor("Reactor", BeaconR)
@@ -56,18 +69,31 @@ func NewReactor(entropyGenerator *EntropyGenerator, fastSync bool, blockStore sm
 	return BeaconR
 }
 
+// SetReporter sets the reporter of peer behaviour. Must be called before the reactor is started,
+// otherwise peers are reported directly to the switch.
+func (beaconR *Reactor) SetReporter(reporter behaviour.Reporter) {
+	beaconR.reporter = reporter
+}
+
+// SetDKGRunner sets the dkg runner whose off-chain dkg payloads are gossiped by the reactor. Must
+// be called before the reactor is started.
+func (beaconR *Reactor) SetDKGRunner(dkgRunner *DKGRunner) {
+	beaconR.dkgRunner = dkgRunner
+	dkgRunner.SetDKGPayloadCallbacks(beaconR.broadcastDKGPayload, beaconR.requestDKGPayload)
+}
+
 // OnStart implements BaseService by subscribing to events, which later will be
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (beaconR *Reactor) OnStart() error {
 	beaconR.Logger.Info("Reactor ", "fastSync", beaconR.fastSync)
 
+	if beaconR.reporter == nil {
+		beaconR.reporter = behaviour.NewSwitchReporter(beaconR.Switch)
+	}
 	beaconR.subscribeToBroadcastEvents()
 	if !beaconR.fastSync {
-		// If no previous entropy has been set then look back through chain to find
-		// either genesis or last computed entropy
-		if beaconR.entropyGen.lastComputedEntropyHeight == -1 {
-			beaconR.findAndSetLastEntropy(beaconR.entropyGen.lastBlockHeight)
-		}
+		// If no previous entropy has been set the entropy generator loads the last entropy
+		// on chain from the entropy store
 		return beaconR.entropyGen.Start()
 	}
 
@@ -90,10 +116,8 @@ func (beaconR *Reactor) SwitchToConsensus(state sm.State) {
 
 	lastBlockHeight := state.LastBlockHeight
 
-	if len(state.LastComputedEntropy) == 0 {
-		beaconR.findAndSetLastEntropy(lastBlockHeight)
-	} else {
-		beaconR.entropyGen.SetLastComputedEntropy(lastBlockHeight, state.LastComputedEntropy)
+	if len(state.LastComputedEntropy) != 0 {
+		beaconR.entropyGen.SetLastComputedEntropy(state.LastComputedEntropyHeight, state.LastComputedEntropy)
 	}
 
 	beaconR.entropyGen.setLastBlockHeight(lastBlockHeight)
@@ -129,6 +153,12 @@ func (beaconR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			SendQueueCapacity:   100,
 			RecvMessageCapacity: maxMsgSize,
 		},
+		{
+			ID:                  DKGPayloadChannel,
+			Priority:            1,
+			SendQueueCapacity:   100,
+			RecvMessageCapacity: maxDKGPayloadMsgSize,
+		},
 	}
 }
 
@@ -139,24 +169,6 @@ func (beaconR *Reactor) getFastSync() bool {
 	return beaconR.fastSync
 }
 
-// Given that we are on block height, set the entropy generator with
-// the last block that had entropy
-func (beaconR *Reactor) findAndSetLastEntropy(height int64) {
-
-	for height > 0 {
-		block := beaconR.blockStore.LoadBlockMeta(height)
-		if block == nil {
-			panic(fmt.Errorf("findAndSetLasEntropy: can not find block at height %v", height))
-		}
-		blockEntropy := block.Header.Entropy.GroupSignature
-		if len(blockEntropy) != 0 {
-			beaconR.entropyGen.SetLastComputedEntropy(height, blockEntropy)
-			break
-		}
-		height--
-	}
-}
-
 // InitPeer implements Reactor by creating a state for the peer.
 func (beaconR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	beaconR.Logger.Debug("InitPeer", "peer", peer)
@@ -179,6 +191,7 @@ func (beaconR *Reactor) AddPeer(peer p2p.Peer) {
 
 	// Begin routines for this peer.
 	go beaconR.gossipEntropySharesRoutine(peer, peerState)
+	go beaconR.queryEntropySharesBitsRoutine(peer, peerState)
 }
 
 // RemovePeer is a noop.
@@ -195,16 +208,16 @@ func (beaconR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		return
 	}
 
-	msg, err := decodeMsg(msgBytes)
+	msg, err := decodeMsg(chID, msgBytes)
 	if err != nil {
 		beaconR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		beaconR.Switch.StopPeerForError(src, err)
+		_ = beaconR.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		beaconR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		beaconR.Switch.StopPeerForError(src, err)
+		_ = beaconR.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
@@ -220,7 +233,32 @@ func (beaconR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	case StateChannel:
 		switch msg := msg.(type) {
 		case *NewEntropyHeightMessage:
-			ps.setLastComputedEntropyHeight(msg.Height)
+			if previousHeight := ps.setLastComputedEntropyHeight(msg.Height); msg.Height < previousHeight {
+				_ = beaconR.reporter.Report(behaviour.StaleEntropyHeight(src.ID(),
+					fmt.Sprintf("entropy height %v below previous height %v", msg.Height, previousHeight)))
+			}
+		case *HasEntropySharesMessage:
+			if beaconR.getFastSync() || !beaconR.entropyGen.isSigningEntropy() {
+				return
+			}
+			ps.applyHasEntropyShares(msg.Height, msg.Shares, beaconR.entropyGen.validators().Size())
+		case *EntropySharesBitsRequestMessage:
+			if beaconR.getFastSync() || !beaconR.entropyGen.isSigningEntropy() {
+				return
+			}
+			shares := entropySharesBitArray(beaconR.entropyGen.getEntropyShares(msg.Height),
+				beaconR.entropyGen.validators().Size())
+			src.TrySend(StateChannel, cdc.MustMarshalBinaryBare(&EntropySharesBitsMessage{
+				Height: msg.Height,
+				Shares: shares,
+			}))
+		case *EntropySharesBitsMessage:
+			if beaconR.getFastSync() || !beaconR.entropyGen.isSigningEntropy() {
+				return
+			}
+			ourShares := entropySharesBitArray(beaconR.entropyGen.getEntropyShares(msg.Height),
+				beaconR.entropyGen.validators().Size())
+			ps.applyEntropySharesBits(msg.Height, msg.Shares, ourShares)
 		default:
 			beaconR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -232,11 +270,36 @@ func (beaconR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		}
 		switch msg := msg.(type) {
 		case *EntropyShareMessage:
-			index, _ := beaconR.entropyGen.validators().GetByAddress(msg.SignerAddress)
-			ps.hasEntropyShare(msg.EntropyShare.Height, index, beaconR.entropyGen.validators().Size())
-			beaconR.entropyGen.applyEntropyShare(msg.EntropyShare)
+			beaconR.receiveEntropyShare(src, ps, msg.EntropyShare)
+		case *EntropySharesMessage:
+			for _, share := range msg.Shares {
+				beaconR.receiveEntropyShare(src, ps, share)
+			}
 		case *ComputedEntropyMessage:
-			beaconR.entropyGen.applyComputedEntropy(msg.Height, msg.GroupSignature)
+			if err := beaconR.entropyGen.applyComputedEntropy(msg.Height, msg.GroupSignature); err != nil {
+				_ = beaconR.reporter.Report(behaviour.InvalidComputedEntropy(src.ID(), err.Error()))
+			}
+		default:
+			beaconR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
+	case DKGPayloadChannel:
+		if beaconR.dkgRunner == nil {
+			return
+		}
+		switch msg := msg.(type) {
+		case *DKGPayloadMessage:
+			isNew, err := beaconR.dkgRunner.OnDKGPayload(msg.Message, msg.Payload)
+			if err != nil {
+				_ = beaconR.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
+			} else if isNew {
+				// Relay new payloads so they reach validators not connected to the sender
+				beaconR.Switch.Broadcast(DKGPayloadChannel, msgBytes)
+			}
+		case *DKGPayloadRequestMessage:
+			if dkgMsg, payload, ok := beaconR.dkgRunner.DKGPayload(msg.PayloadHash); ok {
+				src.TrySend(DKGPayloadChannel, cdc.MustMarshalBinaryBare(&DKGPayloadMessage{dkgMsg, payload}))
+			}
 		default:
 			beaconR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -246,6 +309,17 @@ func (beaconR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	}
 }
 
+// receiveEntropyShare marks the peer as having an entropy share it sent and applies the share
+func (beaconR *Reactor) receiveEntropyShare(src p2p.Peer, ps *PeerState, share *types.EntropyShare) {
+	index, _ := beaconR.entropyGen.validators().GetByAddress(share.SignerAddress)
+	ps.hasEntropyShare(share.Height, index, beaconR.entropyGen.validators().Size())
+	if err := beaconR.entropyGen.applyEntropyShare(share); err != nil {
+		_ = beaconR.reporter.Report(behaviour.BadEntropyShare(src.ID(), err.Error()))
+	} else {
+		_ = beaconR.reporter.Report(behaviour.EntropyShare(src.ID(), "entropy share"))
+	}
+}
+
 // subscribeToBroadcastEvents subscribes for has entropy share messages
 func (beaconR *Reactor) subscribeToBroadcastEvents() {
 	const subscriber = "beacon-reactor"
@@ -267,6 +341,24 @@ func (beaconR *Reactor) broadcastNewEntropyHeightMessage(height int64) {
 	beaconR.Switch.Broadcast(StateChannel, cdc.MustMarshalBinaryBare(esMsg))
 }
 
+// broadcastDKGPayload sends the payload of a dkg message, which only commits to it on chain, to
+// all peers
+func (beaconR *Reactor) broadcastDKGPayload(msg *types.DKGMessage, payload string) {
+	if !beaconR.IsRunning() {
+		return
+	}
+	beaconR.Switch.Broadcast(DKGPayloadChannel, cdc.MustMarshalBinaryBare(&DKGPayloadMessage{msg, payload}))
+}
+
+// requestDKGPayload asks all peers for a payload committed to in a block which the dkg has not
+// received
+func (beaconR *Reactor) requestDKGPayload(payloadHash []byte) {
+	if !beaconR.IsRunning() {
+		return
+	}
+	beaconR.Switch.Broadcast(DKGPayloadChannel, cdc.MustMarshalBinaryBare(&DKGPayloadRequestMessage{payloadHash}))
+}
+
 //--------------------------------------
 
 func (beaconR *Reactor) gossipEntropySharesRoutine(peer p2p.Peer, ps *PeerState) {
@@ -285,16 +377,7 @@ OUTER_LOOP:
 		}
 
 		nextEntropyHeight := ps.getLastComputedEntropyHeight() + 1
-		// Use block chain for entropy that has been included in block
-		if nextEntropyHeight < beaconR.entropyGen.getLastBlockHeight() {
-			block := beaconR.blockStore.LoadBlockMeta(nextEntropyHeight)
-			if block != nil && len(block.Header.Entropy.GroupSignature) != 0 {
-				// Send peer entropy from block store
-				ps.sendEntropy(nextEntropyHeight, block.Header.Entropy.GroupSignature)
-				time.Sleep(beaconR.entropyGen.beaconConfig.PeerGossipSleepDuration)
-				continue OUTER_LOOP
-			}
-		}
+		// Entropy is kept in memory, or in the entropy store once committed
 		entropy := beaconR.entropyGen.getComputedEntropy(nextEntropyHeight)
 		if entropy != nil {
 			ps.sendEntropy(nextEntropyHeight, entropy)
@@ -302,9 +385,13 @@ OUTER_LOOP:
 			continue OUTER_LOOP
 		}
 		if beaconR.entropyGen.isSigningEntropy() {
-			ps.pickSendEntropyShare(nextEntropyHeight,
-				beaconR.entropyGen.getEntropyShares(nextEntropyHeight),
-				beaconR.entropyGen.validators().Size())
+			// Announce the shares held for the next entropy of this node, so the peer only
+			// sends those missing, and send the peer all shares it is missing
+			numValidators := beaconR.entropyGen.validators().Size()
+			workingHeight := beaconR.entropyGen.getLastBlockHeight() + 1
+			ps.sendHasEntropyShares(workingHeight, beaconR.entropyGen.getEntropyShares(workingHeight), numValidators)
+			ps.pickSendEntropyShares(nextEntropyHeight, beaconR.entropyGen.getEntropyShares(nextEntropyHeight),
+				numValidators)
 		}
 
 		time.Sleep(beaconR.entropyGen.beaconConfig.PeerGossipSleepDuration)
@@ -312,6 +399,34 @@ OUTER_LOOP:
 	}
 }
 
+// queryEntropySharesBitsRoutine periodically asks the peer which entropy shares it holds for its
+// next entropy. Shares sent to the peer which it did not receive are then sent again, and shares it
+// received from others are not.
+func (beaconR *Reactor) queryEntropySharesBitsRoutine(peer p2p.Peer, ps *PeerState) {
+	logger := beaconR.Logger.With("peer", peer)
+
+OUTER_LOOP:
+	for {
+		// Manage disconnects from self or peer.
+		if !peer.IsRunning() || !beaconR.IsRunning() {
+			logger.Info("Stopping queryEntropySharesBitsRoutine for peer", "beacon running", beaconR.IsRunning(), "peer running", peer.IsRunning())
+			return
+		}
+
+		if !beaconR.getFastSync() && beaconR.entropyGen.isSigningEntropy() {
+			nextEntropyHeight := ps.getLastComputedEntropyHeight() + 1
+			if len(beaconR.entropyGen.getEntropyShares(nextEntropyHeight)) != 0 {
+				peer.TrySend(StateChannel, cdc.MustMarshalBinaryBare(&EntropySharesBitsRequestMessage{
+					Height: nextEntropyHeight,
+				}))
+			}
+		}
+
+		time.Sleep(beaconR.entropyGen.beaconConfig.PeerQueryEntropySharesSleepDuration)
+		continue OUTER_LOOP
+	}
+}
+
 // String returns a string representation of the Reactor.
 // NOTE: For now, it is just a hard-coded string to avoid accessing unprotected shared variables.
 // TODO: improve!
@@ -347,6 +462,10 @@ type PeerState struct {
 	// Keep track of entropy shares for each block height
 	entropyShares             map[int64]*bits.BitArray
 	lastComputedEntropyHeight int64
+
+	// Entropy shares last announced to the peer
+	announcedSharesHeight int64
+	announcedShares       int
 }
 
 // NewPeerState returns a new PeerState for the given Peer
@@ -376,16 +495,24 @@ func (ps *PeerState) getLastComputedEntropyHeight() int64 {
 }
 
 // setLastComputedEntropyHeight sets the last height peer states it computed
-// entropy for
-func (ps *PeerState) setLastComputedEntropyHeight(height int64) {
+// entropy for. Returns the previous height
+func (ps *PeerState) setLastComputedEntropyHeight(height int64) int64 {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
+	previousHeight := ps.lastComputedEntropyHeight
 	if height > ps.lastComputedEntropyHeight {
 		ps.lastComputedEntropyHeight = height
+		// Shares are no longer needed once the peer has computed entropy
+		for key := range ps.entropyShares {
+			if key <= height {
+				delete(ps.entropyShares, key)
+			}
+		}
 	} else {
 		ps.logger.Debug("SetLastComputedEntropyHeight resetting to past", "peerCurrentHeight", ps.lastComputedEntropyHeight, "resetHeight", height)
 	}
+	return previousHeight
 }
 
 func (ps *PeerState) sendEntropy(nextEntropyHeight int64, entropy types.ThresholdSignature) {
@@ -395,43 +522,108 @@ func (ps *PeerState) sendEntropy(nextEntropyHeight int64, entropy types.Threshol
 	ps.peer.Send(EntropyChannel, cdc.MustMarshalBinaryBare(msg))
 }
 
-// pickSendEntropyShare sends all entropy shares that peer needs
-func (ps *PeerState) pickSendEntropyShare(nextEntropyHeight int64, entropyShares map[uint]types.EntropyShare, numValidators int) {
+// sendHasEntropyShares announces the entropy shares held at height to the peer, if they have
+// changed since the last announcement
+func (ps *PeerState) sendHasEntropyShares(height int64, entropyShares map[uint]types.EntropyShare, numValidators int) {
+	if len(entropyShares) == 0 {
+		return
+	}
+	ps.mtx.Lock()
+	announced := ps.announcedSharesHeight == height && ps.announcedShares == len(entropyShares)
+	ps.mtx.Unlock()
+	if announced {
+		return
+	}
+
+	msg := &HasEntropySharesMessage{Height: height, Shares: entropySharesBitArray(entropyShares, numValidators)}
+	if ps.peer.Send(StateChannel, cdc.MustMarshalBinaryBare(msg)) {
+		ps.mtx.Lock()
+		ps.announcedSharesHeight = height
+		ps.announcedShares = len(entropyShares)
+		ps.mtx.Unlock()
+	}
+}
+
+// pickSendEntropyShares sends all entropy shares that peer needs, in batches
+func (ps *PeerState) pickSendEntropyShares(nextEntropyHeight int64, entropyShares map[uint]types.EntropyShare, numValidators int) {
 	for {
-		if key, value, ok := ps.pickEntropyShare(nextEntropyHeight, entropyShares); ok {
-			msg := &EntropyShareMessage{value}
-			if ps.peer.Send(EntropyChannel, cdc.MustMarshalBinaryBare(msg)) {
-				ps.logger.Debug("pickSendEntropyShare succeeded", "ps", ps, "height", value.Height, "share index", key)
-				ps.hasEntropyShare(nextEntropyHeight, int(key), numValidators)
-			}
-		} else {
+		keys, shares := ps.pickEntropyShares(nextEntropyHeight, entropyShares)
+		if len(shares) == 0 {
 			return
 		}
+		msg := &EntropySharesMessage{Shares: shares}
+		if !ps.peer.Send(EntropyChannel, cdc.MustMarshalBinaryBare(msg)) {
+			return
+		}
+		ps.logger.Debug("pickSendEntropyShares succeeded", "ps", ps, "height", nextEntropyHeight, "shares", len(shares))
+		for _, key := range keys {
+			ps.hasEntropyShare(nextEntropyHeight, int(key), numValidators)
+		}
 	}
 }
 
-func (ps *PeerState) pickEntropyShare(nextEntropyHeight int64, entropyShares map[uint]types.EntropyShare) (uint, *types.EntropyShare, bool) {
+// pickEntropyShares returns up to maxEntropySharesPerMsg entropy shares the peer does not have,
+// with their validator indices
+func (ps *PeerState) pickEntropyShares(nextEntropyHeight int64, entropyShares map[uint]types.EntropyShare) ([]uint, []*types.EntropyShare) {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
-	if entropyShares == nil {
-		return 0, nil, false
-	}
 	if len(entropyShares) == 0 {
-		return 0, nil, false
+		return nil, nil
 	}
 	if ps.lastComputedEntropyHeight+1 != nextEntropyHeight {
 		ps.logger.Debug("PickSendEntropyShare height mismatch", "peer height", ps.lastComputedEntropyHeight, "working height", nextEntropyHeight)
-		return 0, nil, false
+		return nil, nil
 	}
 	peerEntropyShares := ps.entropyShares[nextEntropyHeight]
 
+	var keys []uint
+	var shares []*types.EntropyShare
 	for key, value := range entropyShares {
+		if len(shares) == maxEntropySharesPerMsg {
+			break
+		}
 		if !peerEntropyShares.GetIndex(int(key)) {
-			return key, &value, true
+			share := value
+			keys = append(keys, key)
+			shares = append(shares, &share)
 		}
 	}
-	return 0, nil, false
+	return keys, shares
+}
+
+// applyHasEntropyShares marks the peer as having the entropy shares it announced at height
+func (ps *PeerState) applyHasEntropyShares(height int64, shares *bits.BitArray, numValidators int) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if height <= ps.lastComputedEntropyHeight || height > ps.lastComputedEntropyHeight+entropySharesLookAhead {
+		ps.logger.Debug("applyHasEntropyShares height mismatch", "peer height", ps.lastComputedEntropyHeight, "height", height)
+		return
+	}
+	if shares.Size() != numValidators {
+		ps.logger.Debug("applyHasEntropyShares size mismatch", "size", shares.Size(), "validators", numValidators)
+		return
+	}
+	ps.entropyShares[height] = ps.entropyShares[height].Or(shares)
+}
+
+// applyEntropySharesBits corrects the record of the entropy shares the peer holds at height with
+// the shares it holds in response to a request. The response is authoritative for the shares this
+// node holds, which are the only ones it sends to the peer.
+func (ps *PeerState) applyEntropySharesBits(height int64, shares *bits.BitArray, ourShares *bits.BitArray) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if height <= ps.lastComputedEntropyHeight || height > ps.lastComputedEntropyHeight+entropySharesLookAhead {
+		ps.logger.Debug("applyEntropySharesBits height mismatch", "peer height", ps.lastComputedEntropyHeight, "height", height)
+		return
+	}
+	if shares.Size() != ourShares.Size() {
+		ps.logger.Debug("applyEntropySharesBits size mismatch", "size", shares.Size(), "validators", ourShares.Size())
+		return
+	}
+	ps.entropyShares[height] = ps.entropyShares[height].Sub(ourShares).Or(shares)
 }
 
 // hasEntropyShare marks the peer as having a entropy share at given height from a particular validator index
@@ -451,6 +643,15 @@ func (ps *PeerState) hasEntropyShare(height int64, index int, numValidators int)
 	ps.entropyShares[height].SetIndex(index, true)
 }
 
+// entropySharesBitArray returns the bit array of the validator indices of entropyShares
+func entropySharesBitArray(entropyShares map[uint]types.EntropyShare, numValidators int) *bits.BitArray {
+	shares := bits.NewBitArray(numValidators)
+	for key := range entropyShares {
+		shares.SetIndex(int(key), true)
+	}
+	return shares
+}
+
 // ToJSON returns a json of PeerState, marshalled using go-amino.
 func (ps *PeerState) ToJSON() ([]byte, error) {
 	ps.mtx.Lock()
@@ -491,11 +692,21 @@ func RegisterMessages(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&NewEntropyHeightMessage{}, "tendermint/NewEntropyHeight", nil)
 	cdc.RegisterConcrete(&EntropyShareMessage{}, "tendermint/EntropyShare", nil)
 	cdc.RegisterConcrete(&ComputedEntropyMessage{}, "tendermint/ComputedEntropy", nil)
+	cdc.RegisterConcrete(&HasEntropySharesMessage{}, "tendermint/HasEntropyShares", nil)
+	cdc.RegisterConcrete(&EntropySharesBitsRequestMessage{}, "tendermint/EntropySharesBitsRequest", nil)
+	cdc.RegisterConcrete(&EntropySharesBitsMessage{}, "tendermint/EntropySharesBits", nil)
+	cdc.RegisterConcrete(&EntropySharesMessage{}, "tendermint/EntropyShares", nil)
+	cdc.RegisterConcrete(&DKGPayloadMessage{}, "tendermint/DKGPayload", nil)
+	cdc.RegisterConcrete(&DKGPayloadRequestMessage{}, "tendermint/DKGPayloadRequest", nil)
 }
 
-func decodeMsg(bz []byte) (msg Message, err error) {
-	if len(bz) > maxMsgSize {
-		return msg, fmt.Errorf("msg exceeds max size (%d > %d)", len(bz), maxMsgSize)
+func decodeMsg(chID byte, bz []byte) (msg Message, err error) {
+	maxSize := maxMsgSize
+	if chID == DKGPayloadChannel {
+		maxSize = maxDKGPayloadMsgSize
+	}
+	if len(bz) > maxSize {
+		return msg, fmt.Errorf("msg exceeds max size (%d > %d)", len(bz), maxSize)
 	}
 	err = cdc.UnmarshalBinaryBare(bz, &msg)
 	return
@@ -541,6 +752,115 @@ func (m *EntropyShareMessage) String() string {
 
 //-------------------------------------
 
+// HasEntropySharesMessage announces the entropy shares a node holds at a height
+type HasEntropySharesMessage struct {
+	Height int64
+	Shares *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *HasEntropySharesMessage) ValidateBasic() error {
+	if m.Height < types.GenesisHeight+1 {
+		return errors.New("invalid Height")
+	}
+	if m.Shares == nil {
+		return errors.New("nil Shares")
+	}
+	if m.Shares.Size() > types.MaxVotesCount {
+		return fmt.Errorf("shares bit array is too big: %d, max: %d", m.Shares.Size(), types.MaxVotesCount)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *HasEntropySharesMessage) String() string {
+	return fmt.Sprintf("[HasEntropyShares %v %v]", m.Height, m.Shares)
+}
+
+//-------------------------------------
+
+// EntropySharesBitsRequestMessage asks a peer which entropy shares it holds at a height
+type EntropySharesBitsRequestMessage struct {
+	Height int64
+}
+
+// ValidateBasic performs basic validation.
+func (m *EntropySharesBitsRequestMessage) ValidateBasic() error {
+	if m.Height < types.GenesisHeight+1 {
+		return errors.New("invalid Height")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *EntropySharesBitsRequestMessage) String() string {
+	return fmt.Sprintf("[EntropySharesBitsRequest %v]", m.Height)
+}
+
+//-------------------------------------
+
+// EntropySharesBitsMessage is the response to EntropySharesBitsRequestMessage, with the entropy
+// shares the sender holds at the height
+type EntropySharesBitsMessage struct {
+	Height int64
+	Shares *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *EntropySharesBitsMessage) ValidateBasic() error {
+	if m.Height < types.GenesisHeight+1 {
+		return errors.New("invalid Height")
+	}
+	if m.Shares == nil {
+		return errors.New("nil Shares")
+	}
+	if m.Shares.Size() > types.MaxVotesCount {
+		return fmt.Errorf("shares bit array is too big: %d, max: %d", m.Shares.Size(), types.MaxVotesCount)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *EntropySharesBitsMessage) String() string {
+	return fmt.Sprintf("[EntropySharesBits %v %v]", m.Height, m.Shares)
+}
+
+//-------------------------------------
+
+// EntropySharesMessage carries a batch of entropy shares for a height
+type EntropySharesMessage struct {
+	Shares []*types.EntropyShare
+}
+
+// ValidateBasic performs basic validation.
+func (m *EntropySharesMessage) ValidateBasic() error {
+	if len(m.Shares) == 0 {
+		return errors.New("no Shares")
+	}
+	if len(m.Shares) > maxEntropySharesPerMsg {
+		return fmt.Errorf("too many Shares: %d, max: %d", len(m.Shares), maxEntropySharesPerMsg)
+	}
+	for i, share := range m.Shares {
+		if share == nil {
+			return fmt.Errorf("nil share #%d", i)
+		}
+		if err := share.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong share #%d: %v", i, err)
+		}
+		if share.Height != m.Shares[0].Height {
+			return fmt.Errorf("share #%d has height %v, expected %v", i, share.Height, m.Shares[0].Height)
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *EntropySharesMessage) String() string {
+	return fmt.Sprintf("[EntropyShares %v]", len(m.Shares))
+}
+
+//-------------------------------------
+
 // ComputedEntropyMessage is for catching up peers
 type ComputedEntropyMessage struct {
 	Height         int64
@@ -565,3 +885,51 @@ func (m *ComputedEntropyMessage) ValidateBasic() error {
 func (m *ComputedEntropyMessage) String() string {
 	return fmt.Sprintf("[ComputedEntropy %v/%v]", m.Height, m.GroupSignature)
 }
+
+//-------------------------------------
+
+// DKGPayloadMessage is the payload of a dkg message gossiped off chain, with the signed message
+// committing to it
+type DKGPayloadMessage struct {
+	Message *types.DKGMessage
+	Payload string
+}
+
+// ValidateBasic performs basic validation.
+func (m *DKGPayloadMessage) ValidateBasic() error {
+	if m.Message == nil {
+		return errors.New("nil Message")
+	}
+	if err := m.Message.ValidateBasic(); err != nil {
+		return err
+	}
+	return m.Message.VerifyPayload(m.Payload)
+}
+
+// String returns a string representation.
+func (m *DKGPayloadMessage) String() string {
+	return fmt.Sprintf("[DKGPayload %v/%v]", m.Message.PayloadHash, len(m.Payload))
+}
+
+//-------------------------------------
+
+// DKGPayloadRequestMessage asks peers for the payload of a dkg message committed to in a block
+type DKGPayloadRequestMessage struct {
+	PayloadHash tmbytes.HexBytes
+}
+
+// ValidateBasic performs basic validation.
+func (m *DKGPayloadRequestMessage) ValidateBasic() error {
+	if len(m.PayloadHash) != tmhash.Size {
+		return fmt.Errorf("expected PayloadHash size to be %d bytes, got %d bytes",
+			tmhash.Size,
+			len(m.PayloadHash),
+		)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *DKGPayloadRequestMessage) String() string {
+	return fmt.Sprintf("[DKGPayloadRequest %v]", m.PayloadHash)
+}
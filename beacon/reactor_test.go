@@ -8,13 +8,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/behaviour"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
+	"github.com/tendermint/tendermint/libs/bits"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/mock"
-	"github.com/tendermint/tendermint/store"
 	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestMain(m *testing.M) {
@@ -28,7 +30,7 @@ func TestMain(m *testing.M) {
 //----------------------------------------------
 // in-process testnets
 
-func startBeaconNet(t *testing.T, css []*consensus.State, entropyGenerators []*EntropyGenerator, blockStores []*store.BlockStore, n int, nStart int) (
+func startBeaconNet(t *testing.T, css []*consensus.State, entropyGenerators []*EntropyGenerator, n int, nStart int) (
 	consensusReactors []*consensus.Reactor,
 	reactors []*Reactor,
 	eventBuses []*types.EventBus,
@@ -48,7 +50,7 @@ func startBeaconNet(t *testing.T, css []*consensus.State, entropyGenerators []*E
 			fastSync = true
 		}
 
-		reactors[i] = NewReactor(entropyGenerators[i], fastSync, blockStores[i])
+		reactors[i] = NewReactor(entropyGenerators[i], fastSync)
 		reactors[i].SetLogger(entropyGenerators[i].Logger)
 
 		if css != nil {
@@ -105,7 +107,7 @@ func stopBeaconNet(logger log.Logger, consensusReactors []*consensus.Reactor, ev
 
 func TestReactorEntropy(t *testing.T) {
 	N := 4
-	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", false)
+	css, entropyGenerators, _, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", false)
 	defer cleanup()
 
 	// Add second set of keys so entropy generations has patten ON, OFF, ON
@@ -118,7 +120,7 @@ func TestReactorEntropy(t *testing.T) {
 		entropyGenerators[i].SetNextAeonDetails(newKeys)
 	}
 
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, N)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, N)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	assert.Eventually(t, func() bool {
@@ -135,10 +137,10 @@ func TestReactorEntropy(t *testing.T) {
 }
 
 func TestReactorReceiveDoesNotPanicIfAddPeerHasntBeenCalledYet(t *testing.T) {
-	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(1, "beacon_reactor_test", false)
+	css, entropyGenerators, _, cleanup := randBeaconAndConsensusNet(1, "beacon_reactor_test", false)
 	defer cleanup()
 	N := len(entropyGenerators)
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, N)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, N)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	var (
@@ -157,10 +159,10 @@ func TestReactorReceiveDoesNotPanicIfAddPeerHasntBeenCalledYet(t *testing.T) {
 }
 
 func TestReactorReceivePanicsIfInitPeerHasntBeenCalledYet(t *testing.T) {
-	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(1, "beacon_reactor_test", false)
+	css, entropyGenerators, _, cleanup := randBeaconAndConsensusNet(1, "beacon_reactor_test", false)
 	defer cleanup()
 	N := len(entropyGenerators)
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, N)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, N)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	var (
@@ -175,11 +177,160 @@ func TestReactorReceivePanicsIfInitPeerHasntBeenCalledYet(t *testing.T) {
 	})
 }
 
+func TestReactorReportsPeerBehaviour(t *testing.T) {
+	state, privVals := groupTestSetup(4)
+	entropyGen := testEntropyGen(state.Validators, nil, -1)
+	entropyGen.SetLastComputedEntropy(1, []byte("Test Entropy"))
+	entropyGen.setLastBlockHeight(1)
+
+	reporter := behaviour.NewMockReporter()
+	reactor := NewReactor(entropyGen, false)
+	reactor.SetLogger(log.TestingLogger())
+	reactor.SetReporter(reporter)
+	require.NoError(t, reactor.Start())
+	defer reactor.Stop()
+
+	peer := mock.NewPeer(nil)
+	reactor.InitPeer(peer)
+
+	pubKey := privVals[0].GetPubKey()
+	index, _ := state.Validators.GetByAddress(pubKey.Address())
+	otherGen := testEntropyGen(state.Validators, privVals[0], index)
+	otherGen.SetLastComputedEntropy(1, []byte("Test Entropy"))
+	otherGen.setLastBlockHeight(1)
+	otherGen.sign()
+	share := otherGen.getEntropyShares(2)[uint(index)]
+	invalidShare := share.Copy()
+	privVals[0].SignEntropy("wrong chain ID", &invalidShare)
+
+	reactor.Receive(EntropyChannel, peer, cdc.MustMarshalBinaryBare(&EntropyShareMessage{&invalidShare}))
+	reactor.Receive(EntropyChannel, peer, cdc.MustMarshalBinaryBare(&EntropyShareMessage{&share}))
+	reactor.Receive(EntropyChannel, peer, cdc.MustMarshalBinaryBare(&ComputedEntropyMessage{
+		Height:         2,
+		GroupSignature: []byte(share.SignatureShare),
+	}))
+	reactor.Receive(StateChannel, peer, cdc.MustMarshalBinaryBare(&NewEntropyHeightMessage{Height: 5}))
+	reactor.Receive(StateChannel, peer, cdc.MustMarshalBinaryBare(&NewEntropyHeightMessage{Height: 3}))
+
+	expected := []behaviour.PeerBehaviour{
+		behaviour.BadEntropyShare(peer.ID(), errInvalidShareSignature.Error()),
+		behaviour.EntropyShare(peer.ID(), "entropy share"),
+		behaviour.InvalidComputedEntropy(peer.ID(), errInvalidComputedEntropy.Error()),
+		behaviour.StaleEntropyHeight(peer.ID(), "entropy height 3 below previous height 5"),
+	}
+	assert.Equal(t, expected, reporter.GetBehaviours(peer.ID()))
+}
+
+func TestPeerStateEntropySharesBits(t *testing.T) {
+	state, privVals := groupTestSetup(4)
+	entropyShares := make(map[uint]types.EntropyShare)
+	for i, privVal := range privVals {
+		index, _ := state.Validators.GetByAddress(privVal.GetPubKey().Address())
+		gen := testEntropyGen(state.Validators, privVal, index)
+		gen.SetLastComputedEntropy(1, []byte("Test Entropy"))
+		gen.setLastBlockHeight(1)
+		gen.sign()
+		if i < 3 {
+			entropyShares[uint(index)] = gen.getEntropyShares(2)[uint(index)]
+		}
+	}
+
+	ps := NewPeerState(mock.NewPeer(nil))
+	ps.setLastComputedEntropyHeight(1)
+
+	// Only shares the peer has not announced are picked
+	announced := bits.NewBitArray(4)
+	for key := range entropyShares {
+		announced.SetIndex(int(key), true)
+		break
+	}
+	ps.applyHasEntropyShares(2, announced, 4)
+	keys, shares := ps.pickEntropyShares(2, entropyShares)
+	assert.Len(t, keys, 2)
+	assert.Len(t, shares, 2)
+	for _, key := range keys {
+		assert.False(t, announced.GetIndex(int(key)))
+	}
+	assert.NoError(t, (&EntropySharesMessage{Shares: shares}).ValidateBasic())
+
+	// Shares the peer reports not holding in response to a request are picked again
+	for _, key := range keys {
+		ps.hasEntropyShare(2, int(key), 4)
+	}
+	keys, _ = ps.pickEntropyShares(2, entropyShares)
+	assert.Empty(t, keys)
+	ourShares := entropySharesBitArray(entropyShares, 4)
+	held := announced.Copy()
+	for i := 0; i < 4; i++ {
+		if !ourShares.GetIndex(i) {
+			// Share this node does not hold
+			held.SetIndex(i, true)
+		}
+	}
+	ps.applyEntropySharesBits(2, held, ourShares)
+	keys, _ = ps.pickEntropyShares(2, entropyShares)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, held, ps.entropyShares[2])
+	ps.applyEntropySharesBits(2, bits.NewBitArray(5), ourShares)
+	assert.Equal(t, 4, ps.entropyShares[2].Size())
+
+	// Announcements too far ahead of the peer or of the wrong size are ignored
+	ps.applyHasEntropyShares(4, announced, 4)
+	ps.applyHasEntropyShares(2, bits.NewBitArray(5), 4)
+	assert.Nil(t, ps.entropyShares[4])
+	assert.Equal(t, 4, ps.entropyShares[2].Size())
+
+	// Shares are forgotten once the peer computes entropy
+	ps.setLastComputedEntropyHeight(2)
+	assert.Nil(t, ps.entropyShares[2])
+}
+
+func TestReactorDKGPayloads(t *testing.T) {
+	nodes := exampleDKGNetwork(4, 0, false)
+	dkg := nodes[1].dkg
+	dkgRunner := NewDKGRunner(dkg.config, dkg.chainID, dbm.NewMemDB(), dkg.privValidator, 0)
+	dkgRunner.activeDKG = dkg
+
+	state, _ := groupTestSetup(4)
+	entropyGen := testEntropyGen(state.Validators, nil, -1)
+	reporter := behaviour.NewMockReporter()
+	reactor := NewReactor(entropyGen, false)
+	reactor.SetLogger(log.TestingLogger())
+	reactor.SetReporter(reporter)
+	reactor.SetDKGRunner(dkgRunner)
+	reactor.SetSwitch(p2p.NewSwitch(cfg.DefaultP2PConfig(), nil))
+	require.NoError(t, reactor.Start())
+	defer reactor.Stop()
+
+	peer := mock.NewPeer(nil)
+	reactor.InitPeer(peer)
+
+	payload := "payload"
+	msg := nodes[0].dkg.newDKGCommitment(types.DKGCoefficient, payload, nil)
+	invalidSignature := *msg
+	invalidSignature.Type = types.DKGComplaint
+	reactor.Receive(DKGPayloadChannel, peer, cdc.MustMarshalBinaryBare(&DKGPayloadMessage{&invalidSignature, payload}))
+	reactor.Receive(DKGPayloadChannel, peer, cdc.MustMarshalBinaryBare(&DKGPayloadMessage{msg, "other payload"}))
+	reactor.Receive(DKGPayloadChannel, peer, cdc.MustMarshalBinaryBare(&DKGPayloadMessage{msg, payload}))
+	reactor.Receive(DKGPayloadChannel, peer, cdc.MustMarshalBinaryBare(&DKGPayloadRequestMessage{msg.PayloadHash}))
+
+	expected := []behaviour.PeerBehaviour{
+		behaviour.BadMessage(peer.ID(), "onPayload: failed signature verification"),
+		behaviour.BadMessage(peer.ID(), msg.VerifyPayload("other payload").Error()),
+	}
+	assert.Equal(t, expected, reporter.GetBehaviours(peer.ID()))
+
+	storedMsg, storedPayload, ok := dkgRunner.DKGPayload(msg.PayloadHash)
+	require.True(t, ok)
+	assert.Equal(t, msg, storedMsg)
+	assert.Equal(t, payload, storedPayload)
+}
+
 func TestReactorWithConsensus(t *testing.T) {
 	N := 4
 	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", true)
 	defer cleanup()
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, N)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, N)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	// Wait for everyone to generate 3 blocks
@@ -203,12 +354,12 @@ func TestReactorCatchupWithBlocks(t *testing.T) {
 		t.Skip("skipping testing in short mode")
 	}
 	N := 4
-	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", true)
+	css, entropyGenerators, _, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", true)
 	defer cleanup()
 
 	// Start all beacon reactors except one
 	NStart := N - 1
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, NStart)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, NStart)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	// Wait for reactors that started to generate 5 rounds of entropy
@@ -253,7 +404,7 @@ func TestReactorCatchupWithBlocks(t *testing.T) {
 
 func TestReactorWithDKG(t *testing.T) {
 	N := 4
-	css, entropyGenerators, blockStores, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", false)
+	css, entropyGenerators, _, cleanup := randBeaconAndConsensusNet(N, "beacon_reactor_test", false)
 	defer cleanup()
 
 	aeonStart := int64(20)
@@ -326,7 +477,7 @@ func TestReactorWithDKG(t *testing.T) {
 		entropyGen.sign()
 	}
 
-	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, blockStores, N, N)
+	consensusReactors, entropyReactors, eventBuses := startBeaconNet(t, css, entropyGenerators, N, N)
 	defer stopBeaconNet(log.TestingLogger(), consensusReactors, eventBuses, entropyReactors)
 
 	// Wait for everyone to generate 3 rounds of entropy
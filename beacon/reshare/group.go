@@ -0,0 +1,86 @@
+package reshare
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tendermint/tendermint/beacon/verifier"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// point is an element of the group in which the public key shares of an aeon lie
+type point interface {
+	add(q point) point
+	mul(k *big.Int) point
+	equal(q point) bool
+	String() string
+}
+
+type g1Point struct{ p *bls12381.G1 }
+
+func (p g1Point) add(q point) point    { return g1Point{p.p.Add(q.(g1Point).p)} }
+func (p g1Point) mul(k *big.Int) point { return g1Point{p.p.Mul(k)} }
+func (p g1Point) equal(q point) bool   { return p.p.Equal(q.(g1Point).p) }
+func (p g1Point) String() string       { return p.p.String() }
+
+type g2Point struct{ p *bls12381.G2 }
+
+func (p g2Point) add(q point) point    { return g2Point{p.p.Add(q.(g2Point).p)} }
+func (p g2Point) mul(k *big.Int) point { return g2Point{p.p.Mul(k)} }
+func (p g2Point) equal(q point) bool   { return p.p.Equal(q.(g2Point).p) }
+func (p g2Point) String() string       { return p.p.String() }
+
+// group is the group of the public key shares of an aeon, with the generator they are computed
+// against
+type group struct {
+	generator point
+	infinity  point
+	parse     func(s string) (point, error)
+}
+
+func keyShareGroup(aeon *types.DKGOutput) (*group, error) {
+	if aeon.IsKeyless() {
+		return nil, fmt.Errorf("aeon has no keys")
+	}
+	generator, err := verifier.KeyShareGenerator(aeon)
+	if err != nil {
+		return nil, err
+	}
+
+	var g *group
+	switch verifier.AeonType(aeon) {
+	case verifier.BlsAeon:
+		g = &group{
+			infinity: g2Point{bls12381.G2Infinity()},
+			parse: func(s string) (point, error) {
+				p, err := bls12381.G2FromString(s)
+				return g2Point{p}, err
+			},
+		}
+	default:
+		g = &group{
+			infinity: g1Point{bls12381.G1Infinity()},
+			parse: func(s string) (point, error) {
+				p, err := bls12381.G1FromString(s)
+				return g1Point{p}, err
+			},
+		}
+	}
+	if g.generator, err = g.parse(generator); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *group) parseAll(strs []string) ([]point, error) {
+	points := make([]point, len(strs))
+	for i, s := range strs {
+		p, err := g.parse(s)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = p
+	}
+	return points, nil
+}
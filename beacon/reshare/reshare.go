@@ -0,0 +1,198 @@
+// Package reshare redistributes the key shares of an aeon to a new committee, so that the new
+// committee holds shares of the same group private key and the group public key is unchanged.
+// Each dealer, a qualified member of the aeon, shares its private key share with a polynomial
+// whose commitments are checked against its public key share. Members of the new committee
+// interpolate the shares dealt by any threshold of the dealers to obtain their new key share.
+// Keys are read and written in the string encodings of the mcl library used by the beacon.
+package reshare
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Deal returns commitments to a random polynomial of degree threshold - 1 with privateKey as its
+// constant term, and its evaluations at each of the size members of the new committee. Shares
+// must be sent privately to each member.
+func Deal(aeon *types.DKGOutput, privateKey string, size uint, threshold uint) ([]string, []string, error) {
+	group, err := keyShareGroup(aeon)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, err := bls12381.FrFromString(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if threshold == 0 || threshold > size {
+		return nil, nil, fmt.Errorf("invalid threshold %v for committee size %v", threshold, size)
+	}
+
+	coefficients := []*big.Int{secret}
+	for k := uint(1); k < threshold; k++ {
+		coefficient, err := rand.Int(rand.Reader, bls12381.Order)
+		if err != nil {
+			return nil, nil, err
+		}
+		coefficients = append(coefficients, coefficient)
+	}
+	commitments := make([]string, len(coefficients))
+	for k, coefficient := range coefficients {
+		commitments[k] = group.generator.mul(coefficient).String()
+	}
+	shares := make([]string, size)
+	for member := uint(0); member < size; member++ {
+		shares[member] = evaluate(coefficients, member).String()
+	}
+	return commitments, shares, nil
+}
+
+// VerifyCommitments checks the commitments of the dealer, a member of aeon, are to a polynomial
+// of degree threshold - 1 sharing the dealer's private key share
+func VerifyCommitments(aeon *types.DKGOutput, dealer uint, commitments []string, threshold uint) error {
+	group, err := keyShareGroup(aeon)
+	if err != nil {
+		return err
+	}
+	if !isQual(aeon, dealer) || dealer >= uint(len(aeon.PublicKeyShares)) {
+		return fmt.Errorf("dealer %v not in aeon qual", dealer)
+	}
+	if uint(len(commitments)) != threshold {
+		return fmt.Errorf("expected %v commitments, got %v", threshold, len(commitments))
+	}
+	points, err := group.parseAll(commitments)
+	if err != nil {
+		return err
+	}
+	publicKeyShare, err := group.parse(aeon.PublicKeyShares[dealer])
+	if err != nil {
+		return err
+	}
+	if !points[0].equal(publicKeyShare) {
+		return fmt.Errorf("commitments do not share the public key share of dealer %v", dealer)
+	}
+	return nil
+}
+
+// VerifyShare checks share is the evaluation for member of the polynomial with commitments, which
+// must have been checked with VerifyCommitments
+func VerifyShare(aeon *types.DKGOutput, commitments []string, member uint, share string) bool {
+	group, err := keyShareGroup(aeon)
+	if err != nil {
+		return false
+	}
+	points, err := group.parseAll(commitments)
+	if err != nil {
+		return false
+	}
+	s, err := bls12381.FrFromString(share)
+	if err != nil {
+		return false
+	}
+	return group.generator.mul(s).equal(evaluateCommitments(group, points, member))
+}
+
+// CombineShares returns the new private key share of a member from the shares dealt to it, keyed
+// by the index of the dealer in the aeon. There must be shares from at least the threshold of the
+// aeon.
+func CombineShares(shares map[uint]string) (string, error) {
+	dealers := make([]uint, 0, len(shares))
+	for dealer := range shares {
+		dealers = append(dealers, dealer)
+	}
+	privateKey := big.NewInt(0)
+	for dealer, share := range shares {
+		s, err := bls12381.FrFromString(share)
+		if err != nil {
+			return "", fmt.Errorf("invalid share from dealer %v: %v", dealer, err)
+		}
+		privateKey.Add(privateKey, s.Mul(s, lagrangeCoefficient(dealer, dealers)))
+	}
+	return privateKey.Mod(privateKey, bls12381.Order).String(), nil
+}
+
+// CombinePublicKeyShares returns the public key shares of the size members of the new committee,
+// from the commitments of the dealers whose shares were combined, keyed by the index of the dealer
+// in the aeon
+func CombinePublicKeyShares(aeon *types.DKGOutput, commitments map[uint][]string, size uint) ([]string, error) {
+	group, err := keyShareGroup(aeon)
+	if err != nil {
+		return nil, err
+	}
+	dealers := make([]uint, 0, len(commitments))
+	for dealer := range commitments {
+		dealers = append(dealers, dealer)
+	}
+	publicKeyShares := make([]point, size)
+	for member := range publicKeyShares {
+		publicKeyShares[member] = group.infinity
+	}
+	for dealer, dealerCommitments := range commitments {
+		points, err := group.parseAll(dealerCommitments)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitments from dealer %v: %v", dealer, err)
+		}
+		coefficient := lagrangeCoefficient(dealer, dealers)
+		for member := range publicKeyShares {
+			share := evaluateCommitments(group, points, uint(member)).mul(coefficient)
+			publicKeyShares[member] = publicKeyShares[member].add(share)
+		}
+	}
+	res := make([]string, size)
+	for member, publicKeyShare := range publicKeyShares {
+		res[member] = publicKeyShare.String()
+	}
+	return res, nil
+}
+
+//-----------------------------------------------------------------------------
+
+func isQual(aeon *types.DKGOutput, index uint) bool {
+	for _, member := range aeon.Qual {
+		if member == index {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate returns the polynomial with coefficients at the point of member, which is member + 1
+// as in the dkg
+func evaluate(coefficients []*big.Int, member uint) *big.Int {
+	x := big.NewInt(int64(member) + 1)
+	res := big.NewInt(0)
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		res.Mul(res, x).Add(res, coefficients[k]).Mod(res, bls12381.Order)
+	}
+	return res
+}
+
+// evaluateCommitments returns the commitment to the evaluation of the polynomial at the point of
+// member
+func evaluateCommitments(group *group, commitments []point, member uint) point {
+	x := big.NewInt(int64(member) + 1)
+	res := group.infinity
+	for k := len(commitments) - 1; k >= 0; k-- {
+		res = res.mul(x).add(commitments[k])
+	}
+	return res
+}
+
+// lagrangeCoefficient returns the coefficient of the point of dealer when interpolating at zero
+// from the points of dealers
+func lagrangeCoefficient(dealer uint, dealers []uint) *big.Int {
+	num, den := big.NewInt(1), big.NewInt(1)
+	x := big.NewInt(int64(dealer) + 1)
+	for _, other := range dealers {
+		if other == dealer {
+			continue
+		}
+		y := big.NewInt(int64(other) + 1)
+		num.Mul(num, y).Mod(num, bls12381.Order)
+		den.Mul(den, new(big.Int).Sub(y, x)).Mod(den, bls12381.Order)
+	}
+	return num.Mul(num, den.ModInverse(den, bls12381.Order)).Mod(num, bls12381.Order)
+}
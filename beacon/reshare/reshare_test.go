@@ -0,0 +1,188 @@
+package reshare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/beacon/verifier"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testBlsAeon returns the public dkg output and private keys of the aeon in beacon/test_keys
+func testBlsAeon(t *testing.T) (*types.DKGOutput, []string) {
+	output := &types.DKGOutput{
+		KeyType:         verifier.BlsAeon,
+		ValidatorHeight: 1,
+		Start:           1,
+		End:             10,
+	}
+	var privateKeys []string
+	for i := 0; i < 4; i++ {
+		data, err := ioutil.ReadFile(fmt.Sprintf("../test_keys/validator_%v_of_4.txt", i))
+		require.NoError(t, err)
+		lines := strings.Split(string(data), "\n")
+		if i == 0 {
+			output.Generator = lines[2]
+			output.GroupPublicKey = lines[3]
+			output.PublicKeyShares = lines[5:9]
+			output.Qual = []uint{0, 1, 2, 3}
+		}
+		privateKeys = append(privateKeys, strings.TrimSpace(lines[4]))
+	}
+	return output, privateKeys
+}
+
+// testGlowAeon returns a glow aeon with threshold 2 out of 3, from a polynomial with known
+// coefficients, and its private keys
+func testGlowAeon(t *testing.T) (*types.DKGOutput, []string) {
+	blsAeon, _ := testBlsAeon(t)
+	generator, err := bls12381.G2FromString(blsAeon.Generator)
+	require.NoError(t, err)
+	generatorG1, err := bls12381.HashToG1([]byte("generator"))
+	require.NoError(t, err)
+
+	first, second := generator.String(), generatorG1.String()
+	secret, coefficient := big.NewInt(1234567), big.NewInt(7654321)
+	output := &types.DKGOutput{
+		KeyType:        verifier.GlowAeon,
+		GroupPublicKey: generator.Mul(secret).String(),
+		Generator: fmt.Sprintf("22 serialization::archive 17 0 0 %v %v %v %v", len(first), first,
+			len(second), second),
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1, 2},
+		Start:           1,
+		End:             10,
+	}
+	var privateKeys []string
+	for i := int64(1); i <= 3; i++ {
+		privateKey := new(big.Int).Add(secret, new(big.Int).Mul(coefficient, big.NewInt(i)))
+		privateKeys = append(privateKeys, privateKey.String())
+		output.PublicKeyShares = append(output.PublicKeyShares, generatorG1.Mul(privateKey).String())
+	}
+	return output, privateKeys
+}
+
+// groupSignature interpolates the signatures of message with the private keys of members
+func groupSignature(t *testing.T, message string, privateKeys map[uint]string) types.ThresholdSignature {
+	hash, err := bls12381.HashToG1([]byte(message))
+	require.NoError(t, err)
+	members := make([]uint, 0, len(privateKeys))
+	for member := range privateKeys {
+		members = append(members, member)
+	}
+	sig := bls12381.G1Infinity()
+	for member, privateKey := range privateKeys {
+		key, err := bls12381.FrFromString(privateKey)
+		require.NoError(t, err)
+		sig = sig.Add(hash.Mul(key.Mul(key, lagrangeCoefficient(member, members))))
+	}
+	return []byte(sig.String())
+}
+
+// reshareAeon reshares the keys of aeon from dealers to a committee of size, returning the new
+// aeon and its private keys
+func reshareAeon(t *testing.T, aeon *types.DKGOutput, privateKeys []string, dealers []uint, size uint,
+	threshold uint) (*types.DKGOutput, []string) {
+	commitments := make(map[uint][]string)
+	shares := make([]map[uint]string, size)
+	for member := range shares {
+		shares[member] = make(map[uint]string)
+	}
+	for _, dealer := range dealers {
+		dealerCommitments, dealerShares, err := Deal(aeon, privateKeys[dealer], size, threshold)
+		require.NoError(t, err)
+		require.NoError(t, VerifyCommitments(aeon, dealer, dealerCommitments, threshold))
+		commitments[dealer] = dealerCommitments
+		for member, share := range dealerShares {
+			require.True(t, VerifyShare(aeon, dealerCommitments, uint(member), share))
+			shares[member][dealer] = share
+		}
+	}
+
+	newAeon := *aeon
+	newAeon.Qual = nil
+	for member := uint(0); member < size; member++ {
+		newAeon.Qual = append(newAeon.Qual, member)
+	}
+	var err error
+	newAeon.PublicKeyShares, err = CombinePublicKeyShares(aeon, commitments, size)
+	require.NoError(t, err)
+	newPrivateKeys := make([]string, size)
+	for member := range newPrivateKeys {
+		newPrivateKeys[member], err = CombineShares(shares[member])
+		require.NoError(t, err)
+	}
+	return &newAeon, newPrivateKeys
+}
+
+func TestReshareBls(t *testing.T) {
+	aeon, privateKeys := testBlsAeon(t)
+	newAeon, newPrivateKeys := reshareAeon(t, aeon, privateKeys, []uint{0, 2, 3}, 5, 3)
+	generator, err := bls12381.G2FromString(aeon.Generator)
+	require.NoError(t, err)
+
+	// New private keys match the public key shares
+	for member, privateKey := range newPrivateKeys {
+		key, err := bls12381.FrFromString(privateKey)
+		require.NoError(t, err)
+		assert.Equal(t, generator.Mul(key).String(), newAeon.PublicKeyShares[member])
+	}
+
+	// Any threshold of the new committee signs for the unchanged group public key
+	aeonVerifier := verifier.NewAeonVerifier()
+	message := "message"
+	sig := groupSignature(t, message, map[uint]string{1: newPrivateKeys[1], 3: newPrivateKeys[3], 4: newPrivateKeys[4]})
+	assert.True(t, aeonVerifier.VerifyGroupSignature(newAeon, message, sig))
+	assert.True(t, aeonVerifier.VerifyGroupSignature(aeon, message, sig))
+	sig = groupSignature(t, message, map[uint]string{0: newPrivateKeys[0], 2: newPrivateKeys[2]})
+	assert.False(t, aeonVerifier.VerifyGroupSignature(newAeon, message, sig))
+}
+
+func TestReshareGlow(t *testing.T) {
+	aeon, privateKeys := testGlowAeon(t)
+	newAeon, newPrivateKeys := reshareAeon(t, aeon, privateKeys, []uint{0, 2}, 4, 3)
+
+	aeonVerifier := verifier.NewAeonVerifier()
+	message := "message"
+	sig := groupSignature(t, message, map[uint]string{0: newPrivateKeys[0], 1: newPrivateKeys[1],
+		3: newPrivateKeys[3]})
+	assert.True(t, aeonVerifier.VerifyGroupSignature(newAeon, message, sig))
+	assert.Equal(t, groupSignature(t, message, map[uint]string{0: privateKeys[0], 1: privateKeys[1]}), sig)
+
+	// Reshared keys can be reshared again
+	againAeon, againPrivateKeys := reshareAeon(t, newAeon, newPrivateKeys, []uint{1, 2, 3}, 3, 2)
+	sig = groupSignature(t, message, map[uint]string{0: againPrivateKeys[0], 2: againPrivateKeys[2]})
+	assert.True(t, aeonVerifier.VerifyGroupSignature(againAeon, message, sig))
+}
+
+func TestReshareInvalidDealing(t *testing.T) {
+	aeon, privateKeys := testGlowAeon(t)
+	commitments, shares, err := Deal(aeon, privateKeys[1], 4, 3)
+	require.NoError(t, err)
+
+	// Commitments must share the dealer's own key share
+	assert.Error(t, VerifyCommitments(aeon, 0, commitments, 3))
+	assert.Error(t, VerifyCommitments(aeon, 1, commitments, 2))
+	assert.Error(t, VerifyCommitments(aeon, 3, commitments, 3))
+	otherCommitments, _, err := Deal(aeon, privateKeys[0], 4, 3)
+	require.NoError(t, err)
+	assert.Error(t, VerifyCommitments(aeon, 1, append([]string{otherCommitments[0]}, commitments[1:]...), 3))
+
+	// Shares must match the commitments
+	assert.False(t, VerifyShare(aeon, commitments, 0, shares[1]))
+	assert.False(t, VerifyShare(aeon, commitments, 0, "1"))
+	assert.False(t, VerifyShare(aeon, commitments, 0, "invalid"))
+	assert.True(t, VerifyShare(aeon, commitments, 0, shares[0]))
+
+	_, _, err = Deal(aeon, privateKeys[0], 4, 5)
+	assert.Error(t, err)
+	_, _, err = Deal(&types.DKGOutput{Start: 1, End: 10}, privateKeys[0], 4, 3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package verifier
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Readers for the boost text archives in which the beacon serialises glow signatures and
+// generators
+
+const archiveSignature = "serialization::archive"
+
+type archiveReader struct {
+	data string
+	pos  int
+}
+
+// newArchiveReader returns a reader positioned after the archive header
+func newArchiveReader(data string) (*archiveReader, error) {
+	reader := &archiveReader{data: data}
+	signature, err := reader.readString()
+	if err != nil {
+		return nil, err
+	}
+	if signature != archiveSignature {
+		return nil, fmt.Errorf("invalid archive signature %q", signature)
+	}
+	// Library version
+	if _, err := reader.readInt(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (reader *archiveReader) readInt() (int, error) {
+	for reader.pos < len(reader.data) && (reader.data[reader.pos] == ' ' || reader.data[reader.pos] == '\n') {
+		reader.pos++
+	}
+	start := reader.pos
+	for reader.pos < len(reader.data) && reader.data[reader.pos] >= '0' && reader.data[reader.pos] <= '9' {
+		reader.pos++
+	}
+	n, err := strconv.Atoi(reader.data[start:reader.pos])
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer in archive at %v", start)
+	}
+	return n, nil
+}
+
+// readString reads a string written as its length, a space and its contents
+func (reader *archiveReader) readString() (string, error) {
+	length, err := reader.readInt()
+	if err != nil {
+		return "", err
+	}
+	if length == 0 {
+		return "", nil
+	}
+	start := reader.pos + 1
+	if start+length > len(reader.data) || reader.data[reader.pos] != ' ' {
+		return "", fmt.Errorf("string of length %v overruns archive", length)
+	}
+	reader.pos = start + length
+	return reader.data[start:reader.pos], nil
+}
+
+// readClassInfo skips the tracking level and version written before a class
+func (reader *archiveReader) readClassInfo() error {
+	for i := 0; i < 2; i++ {
+		if _, err := reader.readInt(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEnd returns an error if anything but whitespace is left in the archive
+func (reader *archiveReader) checkEnd() error {
+	if strings.TrimSpace(reader.data[reader.pos:]) != "" {
+		return fmt.Errorf("unexpected data at end of archive")
+	}
+	return nil
+}
+
+// deserialiseStrings reads a std::vector<std::string>
+func deserialiseStrings(data string) ([]string, error) {
+	reader, err := newArchiveReader(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := reader.readClassInfo(); err != nil {
+		return nil, err
+	}
+	count, err := reader.readInt()
+	if err != nil {
+		return nil, err
+	}
+	// Item version
+	if _, err := reader.readInt(); err != nil {
+		return nil, err
+	}
+	if count > len(data) {
+		return nil, fmt.Errorf("invalid vector length %v", count)
+	}
+	strs := make([]string, count)
+	for i := range strs {
+		if strs[i], err = reader.readString(); err != nil {
+			return nil, err
+		}
+	}
+	return strs, reader.checkEnd()
+}
+
+// deserialisePair reads a std::pair<std::string, std::string>
+func deserialisePair(data string) (string, string, error) {
+	reader, err := newArchiveReader(data)
+	if err != nil {
+		return "", "", err
+	}
+	if err := reader.readClassInfo(); err != nil {
+		return "", "", err
+	}
+	first, err := reader.readString()
+	if err != nil {
+		return "", "", err
+	}
+	second, err := reader.readString()
+	if err != nil {
+		return "", "", err
+	}
+	return first, second, reader.checkEnd()
+}
@@ -0,0 +1,236 @@
+// Package verifier verifies aeon group signatures and signature shares in pure Go, so that
+// consumers which only verify, such as light clients and rpc tooling, need not link the mcl
+// library used by the beacon. Results match the beacon execution units in beacon/aeon_exec_unit.cpp
+// for the BLS and Glow aeon types.
+package verifier
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Aeon types, matching the names of the beacon execution units
+const (
+	BlsAeon  = types.BlsAeonKeyType
+	GlowAeon = types.GlowAeonKeyType
+
+	// DefaultAeonType is assumed for dkg outputs without key type, and must match AeonType
+	// in beacon/beacon_setup_service.hpp
+	DefaultAeonType = GlowAeon
+)
+
+// AeonVerifier verifies group signatures and signature shares against the public dkg output of
+// each aeon. Implements types.AeonSignatureVerifier
+type AeonVerifier struct {
+	mtx   sync.Mutex
+	aeons map[string]*aeonKeys // keyed by dkg output hash
+}
+
+var _ types.AeonSignatureVerifier = (*AeonVerifier)(nil)
+
+// NewAeonVerifier returns a new AeonVerifier
+func NewAeonVerifier() *AeonVerifier {
+	return &AeonVerifier{
+		aeons: make(map[string]*aeonKeys),
+	}
+}
+
+// VerifyGroupSignature checks the signature is a valid group signature of message by aeon
+func (verifier *AeonVerifier) VerifyGroupSignature(aeon *types.DKGOutput, message string,
+	signature types.ThresholdSignature) bool {
+	keys := verifier.aeonKeys(aeon)
+	if keys == nil {
+		return false
+	}
+	sig, err := bls12381.G1FromString(string(signature))
+	if err != nil {
+		return false
+	}
+	return pairingVerify(message, sig, keys.groupPublicKey, keys.generator)
+}
+
+// VerifySignatureShare checks the share is a valid signature share of message by the member of aeon
+// with index
+func (verifier *AeonVerifier) VerifySignatureShare(aeon *types.DKGOutput, message string, share string,
+	index uint) bool {
+	keys := verifier.aeonKeys(aeon)
+	if keys == nil || !keys.qual[index] || index >= uint(len(aeon.PublicKeyShares)) {
+		return false
+	}
+	switch keys.aeonType {
+	case BlsAeon:
+		sig, err := bls12381.G1FromString(share)
+		if err != nil {
+			return false
+		}
+		return pairingVerify(message, sig, keys.blsKeyShares[index], keys.generator)
+	case GlowAeon:
+		sigAndProof, err := deserialiseStrings(share)
+		if err != nil || len(sigAndProof) != 3 {
+			return false
+		}
+		sig, err := bls12381.G1FromString(sigAndProof[0])
+		if err != nil {
+			return false
+		}
+		challenge, err := bls12381.FrFromString(sigAndProof[1])
+		if err != nil {
+			return false
+		}
+		response, err := bls12381.FrFromString(sigAndProof[2])
+		if err != nil {
+			return false
+		}
+		return verifyProof(keys.glowKeyShares[index], message, sig, keys.generatorG1, challenge, response)
+	}
+	return false
+}
+
+// aeonKeys returns the cached keys of aeon, or nil for an invalid aeon
+func (verifier *AeonVerifier) aeonKeys(aeon *types.DKGOutput) *aeonKeys {
+	if aeon == nil || aeon.IsKeyless() || aeon.ValidateBasic() != nil {
+		return nil
+	}
+
+	verifier.mtx.Lock()
+	defer verifier.mtx.Unlock()
+
+	// Aeons with keys reshared from a previous aeon have the same group public key but different
+	// key shares
+	hash := string(aeon.Hash())
+	keys, ok := verifier.aeons[hash]
+	if !ok {
+		// Invalid keys are cached as nil
+		keys, _ = newAeonKeys(aeon)
+		verifier.aeons[hash] = keys
+	}
+	return keys
+}
+
+// AeonType returns the type of aeon, which is DefaultAeonType for dkg outputs without key type
+func AeonType(aeon *types.DKGOutput) string {
+	if len(aeon.KeyType) == 0 {
+		return DefaultAeonType
+	}
+	return aeon.KeyType
+}
+
+// KeyShareGenerator returns the generator against which the public key shares of aeon are
+// computed, which is in G2 for BLS aeons and in G1 for Glow aeons
+func KeyShareGenerator(aeon *types.DKGOutput) (string, error) {
+	switch AeonType(aeon) {
+	case BlsAeon:
+		return aeon.Generator, nil
+	case GlowAeon:
+		_, generatorG1, err := deserialisePair(aeon.Generator)
+		return generatorG1, err
+	}
+	return "", fmt.Errorf("unknown aeon type %v", aeon.KeyType)
+}
+
+//-----------------------------------------------------------------------------
+
+// aeonKeys holds the parsed public keys of an aeon
+type aeonKeys struct {
+	aeonType       string
+	generator      *bls12381.G2
+	groupPublicKey *bls12381.G2
+	qual           map[uint]bool
+
+	// BLS aeons only
+	blsKeyShares []*bls12381.G2
+
+	// Glow aeons only
+	generatorG1   *bls12381.G1
+	glowKeyShares []*bls12381.G1
+}
+
+func newAeonKeys(aeon *types.DKGOutput) (*aeonKeys, error) {
+	keys := &aeonKeys{
+		aeonType: AeonType(aeon),
+		qual:     make(map[uint]bool),
+	}
+	for _, index := range aeon.Qual {
+		keys.qual[index] = true
+	}
+
+	var err error
+	if keys.groupPublicKey, err = bls12381.G2FromString(aeon.GroupPublicKey); err != nil {
+		return nil, err
+	}
+
+	switch keys.aeonType {
+	case BlsAeon:
+		if keys.generator, err = bls12381.G2FromString(aeon.Generator); err != nil {
+			return nil, err
+		}
+		keys.blsKeyShares = make([]*bls12381.G2, len(aeon.PublicKeyShares))
+		for i, keyShare := range aeon.PublicKeyShares {
+			if keys.blsKeyShares[i], err = bls12381.G2FromString(keyShare); err != nil {
+				return nil, err
+			}
+		}
+	case GlowAeon:
+		generator, generatorG1, err := deserialisePair(aeon.Generator)
+		if err != nil {
+			return nil, err
+		}
+		if keys.generator, err = bls12381.G2FromString(generator); err != nil {
+			return nil, err
+		}
+		if keys.generatorG1, err = bls12381.G1FromString(generatorG1); err != nil {
+			return nil, err
+		}
+		keys.glowKeyShares = make([]*bls12381.G1, len(aeon.PublicKeyShares))
+		for i, keyShare := range aeon.PublicKeyShares {
+			if keys.glowKeyShares[i], err = bls12381.G1FromString(keyShare); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown aeon type %v", keys.aeonType)
+	}
+	return keys, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// pairingVerify checks e(sig, generator) = e(H(message), publicKey), as mcl::PairingVerify
+func pairingVerify(message string, sig *bls12381.G1, publicKey *bls12381.G2, generator *bls12381.G2) bool {
+	hash, err := bls12381.HashToG1([]byte(message))
+	if err != nil {
+		return false
+	}
+	return bls12381.PairingCheck([]*bls12381.G1{sig, hash.Neg()}, []*bls12381.G2{generator, publicKey})
+}
+
+// verifyProof checks the proof (challenge, response) that sig and publicKey have the same discrete
+// logarithm with respect to H(message) and generator, as mcl::VerifyProof
+func verifyProof(publicKey *bls12381.G1, message string, sig *bls12381.G1, generator *bls12381.G1,
+	challenge *big.Int, response *big.Int) bool {
+	hash, err := bls12381.HashToG1([]byte(message))
+	if err != nil {
+		return false
+	}
+	negChallenge := new(big.Int).Sub(bls12381.Order, challenge)
+	negChallenge.Mod(negChallenge, bls12381.Order)
+
+	commitment1 := generator.Mul(response).Add(publicKey.Mul(negChallenge))
+	commitment2 := hash.Mul(response).Add(sig.Mul(negChallenge))
+	expected := glowChallenge(generator, hash, publicKey, sig, commitment1, commitment2)
+	return expected.Cmp(challenge) == 0
+}
+
+// glowChallenge hashes the concatenated string encodings of the points to the scalar field, as
+// mcl::PrivateKey::SetHashOf
+func glowChallenge(points ...*bls12381.G1) *big.Int {
+	var data []byte
+	for _, point := range points {
+		data = append(data, point.String()...)
+	}
+	return bls12381.HashToFr(data)
+}
@@ -0,0 +1,213 @@
+package verifier
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// serialiseStrings writes a std::vector<std::string> as a boost text archive
+func serialiseStrings(strs ...string) string {
+	archive := fmt.Sprintf("%v %v 17 0 0 %v 0", len(archiveSignature), archiveSignature, len(strs))
+	for _, s := range strs {
+		archive += fmt.Sprintf(" %v %v", len(s), s)
+	}
+	return archive
+}
+
+// serialisePair writes a std::pair<std::string, std::string> as a boost text archive
+func serialisePair(first string, second string) string {
+	return fmt.Sprintf("%v %v 17 0 0 %v %v %v %v", len(archiveSignature), archiveSignature, len(first), first,
+		len(second), second)
+}
+
+func TestDeserialise(t *testing.T) {
+	strs, err := deserialiseStrings(serialiseStrings("1 2 3", "", "45"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1 2 3", "", "45"}, strs)
+
+	first, second, err := deserialisePair("22 serialization::archive 17 0 0 5 1 2 3 3 4 5\n")
+	require.NoError(t, err)
+	assert.Equal(t, "1 2 3", first)
+	assert.Equal(t, "4 5", second)
+
+	for _, archive := range []string{
+		"",
+		"22 serialization::archiv 17 0 0 1 0 1 a",
+		"22 serialization::archive 17 0 0 2 0 1 a",
+		"22 serialization::archive 17 0 0 1 0 3 a",
+		"22 serialization::archive 17 0 0 1 0 1 a 1 b",
+	} {
+		_, err := deserialiseStrings(archive)
+		assert.Error(t, err, archive)
+	}
+}
+
+// testBlsAeon returns the public dkg output and private keys of the aeon in beacon/test_keys
+func testBlsAeon(t *testing.T) (*types.DKGOutput, []*big.Int) {
+	output := &types.DKGOutput{
+		KeyType:         BlsAeon,
+		ValidatorHeight: 1,
+		Start:           1,
+		End:             10,
+	}
+	var privateKeys []*big.Int
+	for i := 0; i < 4; i++ {
+		data, err := ioutil.ReadFile(fmt.Sprintf("../test_keys/validator_%v_of_4.txt", i))
+		require.NoError(t, err)
+		lines := strings.Split(string(data), "\n")
+		if i == 0 {
+			output.Generator = lines[2]
+			output.GroupPublicKey = lines[3]
+			output.PublicKeyShares = lines[5:9]
+			output.Qual = []uint{0, 1, 2, 3}
+		}
+		privateKey, err := bls12381.FrFromString(lines[4])
+		require.NoError(t, err)
+		privateKeys = append(privateKeys, privateKey)
+	}
+	return output, privateKeys
+}
+
+// testGlowAeon returns a glow aeon with threshold 2 out of 3, from a polynomial with known
+// coefficients, and its private keys
+func testGlowAeon(t *testing.T) (*types.DKGOutput, []*big.Int) {
+	blsAeon, _ := testBlsAeon(t)
+	generator, err := bls12381.G2FromString(blsAeon.Generator)
+	require.NoError(t, err)
+	generatorG1, err := bls12381.HashToG1([]byte("generator"))
+	require.NoError(t, err)
+
+	secret, coefficient := big.NewInt(1234567), big.NewInt(7654321)
+	output := &types.DKGOutput{
+		KeyType:         GlowAeon,
+		GroupPublicKey:  generator.Mul(secret).String(),
+		Generator:       serialisePair(generator.String(), generatorG1.String()),
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1, 2},
+		Start:           1,
+		End:             10,
+	}
+	var privateKeys []*big.Int
+	for i := int64(1); i <= 3; i++ {
+		privateKey := new(big.Int).Add(secret, new(big.Int).Mul(coefficient, big.NewInt(i)))
+		privateKeys = append(privateKeys, privateKey)
+		output.PublicKeyShares = append(output.PublicKeyShares, generatorG1.Mul(privateKey).String())
+	}
+	return output, privateKeys
+}
+
+func testSign(t *testing.T, message string, privateKey *big.Int) *bls12381.G1 {
+	hash, err := bls12381.HashToG1([]byte(message))
+	require.NoError(t, err)
+	return hash.Mul(privateKey)
+}
+
+// testGroupSignature interpolates the signature shares of the first threshold members
+func testGroupSignature(t *testing.T, message string, privateKeys []*big.Int, threshold int64) types.ThresholdSignature {
+	sig := bls12381.G1Infinity()
+	for i := int64(1); i <= threshold; i++ {
+		// Lagrange coefficient at zero of x = i
+		coeff := big.NewInt(1)
+		for j := int64(1); j <= threshold; j++ {
+			if i != j {
+				coeff.Mul(coeff, big.NewInt(j))
+				coeff.Mul(coeff, new(big.Int).ModInverse(new(big.Int).Mod(big.NewInt(j-i), bls12381.Order),
+					bls12381.Order))
+			}
+		}
+		sig = sig.Add(testSign(t, message, privateKeys[i-1]).Mul(coeff.Mod(coeff, bls12381.Order)))
+	}
+	return []byte(sig.String())
+}
+
+// testGlowShare computes the signature share of member index, with proof, as mcl::ComputeProof
+func testGlowShare(t *testing.T, aeon *types.DKGOutput, message string, privateKey *big.Int, index int) string {
+	_, generatorStr, err := deserialisePair(aeon.Generator)
+	require.NoError(t, err)
+	generator, err := bls12381.G1FromString(generatorStr)
+	require.NoError(t, err)
+	publicKey, err := bls12381.G1FromString(aeon.PublicKeyShares[index])
+	require.NoError(t, err)
+	hash, err := bls12381.HashToG1([]byte(message))
+	require.NoError(t, err)
+
+	sig := hash.Mul(privateKey)
+	r := big.NewInt(int64(1000 + index))
+	challenge := glowChallenge(generator, hash, publicKey, sig, generator.Mul(r), hash.Mul(r))
+	response := new(big.Int).Add(r, new(big.Int).Mul(privateKey, challenge))
+	response.Mod(response, bls12381.Order)
+	return serialiseStrings(sig.String(), challenge.String(), response.String())
+}
+
+func TestAeonVerifierBls(t *testing.T) {
+	aeon, privateKeys := testBlsAeon(t)
+	verifier := NewAeonVerifier()
+	message := "message"
+
+	share := testSign(t, message, privateKeys[1]).String()
+	assert.True(t, verifier.VerifySignatureShare(aeon, message, share, 1))
+	assert.False(t, verifier.VerifySignatureShare(aeon, "other message", share, 1))
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, share, 2))
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, share, 4))
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, "1 1 2", 1))
+
+	groupSignature := testGroupSignature(t, message, privateKeys, 3)
+	assert.True(t, verifier.VerifyGroupSignature(aeon, message, groupSignature))
+	assert.False(t, verifier.VerifyGroupSignature(aeon, "other message", groupSignature))
+	assert.False(t, verifier.VerifyGroupSignature(aeon, message, testGroupSignature(t, message, privateKeys, 2)))
+	assert.False(t, verifier.VerifyGroupSignature(aeon, message, []byte(share)))
+
+	// Members outside qual can not sign
+	aeon.Qual = []uint{0, 2, 3, 4}
+	aeon.GroupPublicKey += " "
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, share, 1))
+}
+
+func TestAeonVerifierGlow(t *testing.T) {
+	aeon, privateKeys := testGlowAeon(t)
+	verifier := NewAeonVerifier()
+	message := "message"
+
+	share := testGlowShare(t, aeon, message, privateKeys[1], 1)
+	assert.True(t, verifier.VerifySignatureShare(aeon, message, share, 1))
+	assert.False(t, verifier.VerifySignatureShare(aeon, "other message", share, 1))
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, share, 0))
+
+	// Shares with invalid proofs are rejected
+	sigAndProof, err := deserialiseStrings(share)
+	require.NoError(t, err)
+	badShare := serialiseStrings(sigAndProof[0], sigAndProof[1], sigAndProof[1])
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, badShare, 1))
+	assert.False(t, verifier.VerifySignatureShare(aeon, message, sigAndProof[0], 1))
+
+	groupSignature := testGroupSignature(t, message, privateKeys, 2)
+	assert.True(t, verifier.VerifyGroupSignature(aeon, message, groupSignature))
+	assert.False(t, verifier.VerifyGroupSignature(aeon, "other message", groupSignature))
+}
+
+func TestAeonVerifierInvalidAeon(t *testing.T) {
+	aeon, privateKeys := testBlsAeon(t)
+	verifier := NewAeonVerifier()
+	message := "message"
+	groupSignature := testGroupSignature(t, message, privateKeys, 3)
+
+	assert.False(t, verifier.VerifyGroupSignature(nil, message, groupSignature))
+	assert.False(t, verifier.VerifyGroupSignature(&types.DKGOutput{Start: 1, End: 10}, message, groupSignature))
+
+	aeon.KeyType = "UnknownAeon"
+	assert.False(t, verifier.VerifyGroupSignature(aeon, message, groupSignature))
+
+	// Aeons without key type are assumed to have the default type
+	aeon.KeyType = ""
+	aeon.GroupPublicKey += " "
+	assert.Equal(t, DefaultAeonType == BlsAeon, verifier.VerifyGroupSignature(aeon, message, groupSignature))
+}
@@ -47,3 +47,39 @@ type blockPart struct {
 func BlockPart(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: blockPart{explanation}}
 }
+
+type entropyShare struct {
+	explanation string
+}
+
+// EntropyShare returns an entropyShare PeerBehaviour.
+func EntropyShare(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: entropyShare{explanation}}
+}
+
+type badEntropyShare struct {
+	explanation string
+}
+
+// BadEntropyShare returns a badEntropyShare PeerBehaviour.
+func BadEntropyShare(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: badEntropyShare{explanation}}
+}
+
+type invalidComputedEntropy struct {
+	explanation string
+}
+
+// InvalidComputedEntropy returns an invalidComputedEntropy PeerBehaviour.
+func InvalidComputedEntropy(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: invalidComputedEntropy{explanation}}
+}
+
+type staleEntropyHeight struct {
+	explanation string
+}
+
+// StaleEntropyHeight returns a staleEntropyHeight PeerBehaviour.
+func StaleEntropyHeight(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: staleEntropyHeight{explanation}}
+}
@@ -2,9 +2,12 @@ package behaviour
 
 import (
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 // Reporter provides an interface for reactors to report the behaviour
@@ -13,15 +16,40 @@ type Reporter interface {
 	Report(behaviour PeerBehaviour) error
 }
 
+// DefaultBanTime is how long peers are banned for misbehaviour by a
+// SwitchReporter without trust metrics.
+const DefaultBanTime = 10 * time.Minute
+
 // SwitchReporter reports peer behaviour to an internal Switch.
 type SwitchReporter struct {
 	sw *p2p.Switch
+
+	// Optional trust metrics of peers, used to tolerate occasional misbehaviour
+	trustStore     *trust.MetricStore
+	trustThreshold int
+
+	// Peers are banned from the switch for this long when stopped for misbehaviour
+	banTime time.Duration
 }
 
 // NewSwitchReporter return a new SwitchReporter instance which wraps the Switch.
 func NewSwitchReporter(sw *p2p.Switch) *SwitchReporter {
 	return &SwitchReporter{
-		sw: sw,
+		sw:      sw,
+		banTime: DefaultBanTime,
+	}
+}
+
+// NewSwitchReporterWithTrustMetrics returns a new SwitchReporter which records
+// tolerable behaviours in the trust metrics of peers and only stops peers whose
+// trust score falls below threshold, banning them for banTime.
+func NewSwitchReporterWithTrustMetrics(sw *p2p.Switch, trustStore *trust.MetricStore,
+	threshold int, banTime time.Duration) *SwitchReporter {
+	return &SwitchReporter{
+		sw:             sw,
+		trustStore:     trustStore,
+		trustThreshold: threshold,
+		banTime:        banTime,
 	}
 }
 
@@ -39,6 +67,16 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 		spbr.sw.StopPeerForError(peer, reason.explanation)
 	case messageOutOfOrder:
 		spbr.sw.StopPeerForError(peer, reason.explanation)
+	case entropyShare:
+		if spbr.trustStore != nil {
+			spbr.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+		}
+	case badEntropyShare:
+		spbr.badEvent(peer, reason.explanation)
+	case invalidComputedEntropy:
+		spbr.badEvent(peer, reason.explanation)
+	case staleEntropyHeight:
+		spbr.badEvent(peer, reason.explanation)
 	default:
 		return errors.New("unknown reason reported")
 	}
@@ -46,6 +84,21 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 	return nil
 }
 
+// badEvent stops and bans the peer if no trust metrics are kept or if its
+// trust score falls below the threshold.
+func (spbr *SwitchReporter) badEvent(peer p2p.Peer, explanation string) {
+	if spbr.trustStore == nil {
+		spbr.sw.BanPeerForError(peer, explanation, spbr.banTime)
+		return
+	}
+
+	metric := spbr.trustStore.GetPeerTrustMetric(string(peer.ID()))
+	metric.BadEvents(1)
+	if score := metric.TrustScore(); score < spbr.trustThreshold {
+		spbr.sw.BanPeerForError(peer, fmt.Sprintf("%v (trust score %v)", explanation, score), spbr.banTime)
+	}
+}
+
 // MockReporter is a concrete implementation of the Reporter
 // interface used in reactor tests to ensure reactors report the correct
 // behaviour in manufactured scenarios.
@@ -3,9 +3,14 @@ package behaviour_test
 import (
 	"sync"
 	"testing"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
 
 	bh "github.com/tendermint/tendermint/behaviour"
+	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 // TestMockReporter tests the MockReporter's ability to store reported
@@ -199,3 +204,87 @@ func TestMockPeerBehaviourReporterConcurrency(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchReporterTrustMetrics tests that the SwitchReporter tolerates
+// occasional beacon misbehaviour from peers with a good trust metric and
+// stops peers once their trust score falls below the threshold.
+func TestSwitchReporterTrustMetrics(t *testing.T) {
+	switches := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), 2,
+		func(i int, sw *p2p.Switch) *p2p.Switch { return sw }, p2p.Connect2Switches)
+	defer func() {
+		for _, sw := range switches {
+			sw.Stop()
+		}
+	}()
+
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	if err := trustStore.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer trustStore.Stop()
+
+	var (
+		sw       = switches[0]
+		peerID   = switches[1].NodeInfo().ID()
+		reporter = bh.NewSwitchReporterWithTrustMetrics(sw, trustStore, 50, time.Minute)
+	)
+
+	for i := 0; i < 9; i++ {
+		if err := reporter.Report(bh.EntropyShare(peerID, "entropy share")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := reporter.Report(bh.BadEntropyShare(peerID, "bad entropy share")); err != nil {
+		t.Fatal(err)
+	}
+	if !sw.Peers().Has(peerID) {
+		t.Error("Expected peer with good trust score to remain connected")
+	}
+
+	for i := 0; i < 5 && sw.Peers().Has(peerID); i++ {
+		if err := reporter.Report(bh.StaleEntropyHeight(peerID, "stale entropy height")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sw.Peers().Has(peerID) {
+		t.Error("Expected peer with bad trust score to be stopped")
+	}
+	if score := trustStore.GetPeerTrustMetric(string(peerID)).TrustScore(); score >= 50 {
+		t.Errorf("Expected trust score below threshold, got %v", score)
+	}
+}
+
+// TestSwitchReporterBansPeers tests that peers stopped by the SwitchReporter
+// for misbehaviour are banned and can not be reconnected to.
+func TestSwitchReporterBansPeers(t *testing.T) {
+	switches := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), 2,
+		func(i int, sw *p2p.Switch) *p2p.Switch { return sw }, p2p.Connect2Switches)
+	defer func() {
+		for _, sw := range switches {
+			sw.Stop()
+		}
+	}()
+
+	var (
+		sw       = switches[0]
+		peerID   = switches[1].NodeInfo().ID()
+		reporter = bh.NewSwitchReporter(sw)
+	)
+
+	if err := reporter.Report(bh.InvalidComputedEntropy(peerID, "invalid computed entropy")); err != nil {
+		t.Fatal(err)
+	}
+	if sw.Peers().Has(peerID) {
+		t.Error("Expected misbehaving peer to be stopped")
+	}
+	if !sw.IsPeerBanned(peerID) {
+		t.Error("Expected misbehaving peer to be banned")
+	}
+	err := sw.DialPeerWithAddress(switches[1].NetAddress())
+	if _, ok := err.(p2p.ErrSwitchBannedPeer); !ok {
+		t.Errorf("Expected redial of banned peer to fail with ErrSwitchBannedPeer, got %v", err)
+	}
+	if sw.Peers().Has(peerID) {
+		t.Error("Expected banned peer to remain disconnected")
+	}
+}
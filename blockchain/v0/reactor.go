@@ -312,6 +312,11 @@ FOR_LOOP:
 			// currently necessary.
 			err := state.Validators.VerifyCommit(
 				chainID, firstID, first.Height, second.LastCommit)
+			if err == nil {
+				// Also verify the entropy in the block header, which is not covered by the commit
+				// check and would otherwise cause ApplyBlock to fail
+				err = bcR.blockExec.ValidateBlockEntropy(state, first)
+			}
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
 				peerID := bcR.pool.RedoRequest(first.Height)
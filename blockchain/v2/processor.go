@@ -148,6 +148,9 @@ func (state *pcState) handle(event Event) (Event, error) {
 		firstID := types.BlockID{Hash: first.Hash(), PartsHeader: firstPartsHeader}
 
 		err = state.context.verifyCommit(tmState.ChainID, firstID, first.Height, second.LastCommit)
+		if err == nil {
+			err = state.context.verifyEntropy(first)
+		}
 		if err != nil {
 			state.purgePeer(firstItem.peerID)
 			state.purgePeer(secondItem.peerID)
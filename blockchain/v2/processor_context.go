@@ -10,6 +10,7 @@ import (
 type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block) error
 	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
+	verifyEntropy(block *types.Block) error
 	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 	tmState() state.State
 }
@@ -42,6 +43,10 @@ func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height in
 	return pc.state.Validators.VerifyCommit(chainID, blockID, height, commit)
 }
 
+func (pc pContext) verifyEntropy(block *types.Block) error {
+	return pc.applier.ValidateBlockEntropy(pc.state, block)
+}
+
 func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 	pc.store.SaveBlock(block, blockParts, seenCommit)
 }
@@ -82,6 +87,10 @@ func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, hei
 	return nil
 }
 
+func (mpc *mockPContext) verifyEntropy(block *types.Block) error {
+	return nil
+}
+
 func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 
 }
@@ -136,6 +136,7 @@ type blockVerifier interface {
 //nolint:deadcode
 type blockApplier interface {
 	ApplyBlock(state state.State, blockID types.BlockID, block *types.Block) (state.State, error)
+	ValidateBlockEntropy(state state.State, block *types.Block) error
 }
 
 // XXX: unify naming in this package around tmState
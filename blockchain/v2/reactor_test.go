@@ -81,6 +81,10 @@ func (mba *mockBlockApplier) ApplyBlock(state sm.State, blockID types.BlockID, b
 	return state, nil
 }
 
+func (mba *mockBlockApplier) ValidateBlockEntropy(state sm.State, block *types.Block) error {
+	return nil
+}
+
 type mockSwitchIo struct {
 	mtx                 sync.Mutex
 	switchedToConsensus bool
@@ -0,0 +1,233 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/beacon"
+	"github.com/tendermint/tendermint/beacon/verifier"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/tx_extensions"
+	"github.com/tendermint/tendermint/types"
+)
+
+// BeaconCmd inspects and manages the aeon key files and the beacon data of this node.
+var BeaconCmd = &cobra.Command{
+	Use:   "beacon",
+	Short: "Inspect and manage the aeon key files and beacon data",
+}
+
+var inspectAeonsCmd = &cobra.Command{
+	Use:   "inspect-aeons",
+	Short: "Print the public info of the aeons in the old, current and next entropy key files, and check they are valid",
+	RunE:  inspectAeons,
+}
+
+var verifyEntropyCmd = &cobra.Command{
+	Use:   "verify-entropy",
+	Short: "Verify the chain of block entropy in the block store against the stored aeons. The node must be stopped",
+	RunE:  verifyEntropy,
+}
+
+var dkgTranscriptCmd = &cobra.Command{
+	Use:   "dkg-transcript",
+	Short: "Print the dkg messages of a dkg included in blocks as json. The node must be stopped",
+	RunE:  dkgTranscript,
+}
+
+var resetAeonsCmd = &cobra.Command{
+	Use: "reset-aeons",
+	Short: "Move the entropy key files to backups, so the node runs a new dkg. " +
+		"The node must be stopped",
+	RunE: resetAeons,
+}
+
+var (
+	verifyEntropyFrom int64
+	verifyEntropyTo   int64
+	transcriptDKGID   int64
+)
+
+func init() {
+	verifyEntropyCmd.Flags().Int64Var(&verifyEntropyFrom, "from", 1, "First height to verify")
+	verifyEntropyCmd.Flags().Int64Var(&verifyEntropyTo, "to", 0, "Last height to verify (0 for the latest)")
+	dkgTranscriptCmd.Flags().Int64Var(&transcriptDKGID, "dkg-id", -1, "Id of the dkg")
+	BeaconCmd.AddCommand(inspectAeonsCmd, verifyEntropyCmd, dkgTranscriptCmd, resetAeonsCmd)
+}
+
+func entropyKeyFiles() []string {
+	return []string{config.OldEntropyKeyFile(), config.EntropyKeyFile(), config.NextEntropyKeyFile()}
+}
+
+// aeonFileInfo is printed for each aeon in an entropy key file. The private key is never printed
+type aeonFileInfo struct {
+	File          string          `json:"file"`
+	DKGID         int64           `json:"dkg_id"`
+	PublicInfo    types.DKGOutput `json:"public_info"`
+	HasPrivateKey bool            `json:"has_private_key"`
+	Error         string          `json:"error,omitempty"`
+}
+
+func inspectAeons(cmd *cobra.Command, args []string) error {
+	var keyEncryptionKey []byte
+	if config.KeyEncryptionKeyFile() != "" {
+		var err error
+		if keyEncryptionKey, err = loadConfigKeyEncryptionKey(); err != nil {
+			return err
+		}
+	}
+
+	var infos []aeonFileInfo
+	invalid := 0
+	for _, filePath := range entropyKeyFiles() {
+		if !tmos.FileExists(filePath) {
+			continue
+		}
+		aeonFiles, err := beacon.LoadAeonDetailsFiles(filePath, keyEncryptionKey)
+		if err != nil && aeonFiles == nil {
+			return fmt.Errorf("error reading entropy key file %v: %v", filePath, err)
+		}
+		for _, aeonFile := range aeonFiles {
+			info := aeonFileInfo{
+				File:          filePath,
+				DKGID:         aeonFile.DKGID(),
+				PublicInfo:    aeonFile.PublicInfo,
+				HasPrivateKey: len(aeonFile.PrivateKey) != 0,
+			}
+			if err := aeonFile.ValidateBasic(); err != nil {
+				info.Error = err.Error()
+				invalid++
+			}
+			infos = append(infos, info)
+		}
+	}
+
+	jsonBytes, err := cdc.MarshalJSONIndent(infos, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(jsonBytes))
+	if invalid != 0 {
+		return fmt.Errorf("%v invalid aeon(s)", invalid)
+	}
+	return nil
+}
+
+func loadBlockAndStateDBs() (*store.BlockStore, dbm.DB) {
+	dbType := dbm.BackendType(config.DBBackend)
+	blockStore := store.NewBlockStore(dbm.NewDB("blockstore", dbType, config.DBDir()))
+	stateDB := dbm.NewDB("state", dbType, config.DBDir())
+	return blockStore, stateDB
+}
+
+func verifyEntropy(cmd *cobra.Command, args []string) error {
+	blockStore, stateDB := loadBlockAndStateDBs()
+	to := verifyEntropyTo
+	if to == 0 || to > blockStore.Height() {
+		to = blockStore.Height()
+	}
+	from := verifyEntropyFrom
+	if from < 1 {
+		from = 1
+	}
+	if from > to {
+		return fmt.Errorf("no blocks to verify between %v and %v", verifyEntropyFrom, to)
+	}
+
+	previousEntropy, err := sm.LoadPreviousEntropy(stateDB, from)
+	if err != nil {
+		return err
+	}
+	entropyVerifier := verifier.NewAeonVerifier()
+	verified := 0
+	for height := from; height <= to; height++ {
+		blockMeta := blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return fmt.Errorf("no block at height %v", height)
+		}
+		entropy := blockMeta.Header.Entropy
+		if types.IsEmptyBlockEntropy(&entropy) {
+			continue
+		}
+		aeon, err := sm.LoadAeonPublicInfo(stateDB, entropy.DKGID)
+		if err != nil {
+			return fmt.Errorf("entropy at height %v: %v", height, err)
+		}
+		if !bytes.Equal(blockMeta.Header.AeonHash, aeon.Hash()) {
+			return fmt.Errorf("entropy at height %v: expected aeon hash %X, got %v", height, aeon.Hash(),
+				blockMeta.Header.AeonHash)
+		}
+		err = types.VerifyBlockEntropy(entropyVerifier, aeon, height, &entropy, previousEntropy)
+		if err != nil {
+			return fmt.Errorf("entropy at height %v: %v", height, err)
+		}
+		previousEntropy = entropy.GroupSignature
+		verified++
+	}
+	logger.Info("Verified block entropy", "from", from, "to", to, "blocksWithEntropy", verified)
+	return nil
+}
+
+// dkgTranscriptEntry is a dkg message with the height of the block which included it
+type dkgTranscriptEntry struct {
+	Height  int64             `json:"height"`
+	Message *types.DKGMessage `json:"message"`
+}
+
+func dkgTranscript(cmd *cobra.Command, args []string) error {
+	if transcriptDKGID < 0 {
+		return fmt.Errorf("--dkg-id must be set")
+	}
+	blockStore, _ := loadBlockAndStateDBs()
+
+	transcript := []dkgTranscriptEntry{}
+	for height := int64(1); height <= blockStore.Height(); height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("no block at height %v", height)
+		}
+		for _, tx := range block.Data.Txs {
+			if !tx_extensions.IsDKGRelated(tx) {
+				continue
+			}
+			msg, err := tx_extensions.FromBytes(tx)
+			if err != nil {
+				logger.Error("Failed to decode dkg message", "height", height, "err", err)
+				continue
+			}
+			if msg.DKGID == transcriptDKGID {
+				transcript = append(transcript, dkgTranscriptEntry{Height: height, Message: msg})
+			}
+		}
+	}
+
+	jsonBytes, err := cdc.MarshalJSONIndent(transcript, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(jsonBytes))
+	return nil
+}
+
+// resetAeons renames the entropy key files rather than removing them, so the keys can be restored
+func resetAeons(cmd *cobra.Command, args []string) error {
+	suffix := fmt.Sprintf(".%v.bak", time.Now().Unix())
+	for _, filePath := range entropyKeyFiles() {
+		if !tmos.FileExists(filePath) {
+			continue
+		}
+		backup := filePath + suffix
+		if err := os.Rename(filePath, backup); err != nil {
+			return fmt.Errorf("error backing up entropy key file %v: %v", filePath, err)
+		}
+		logger.Info("Moved entropy key file to backup", "file", filePath, "backup", backup)
+	}
+	return nil
+}
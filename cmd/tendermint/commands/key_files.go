@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/crypto/keyfile"
+	tmos "github.com/tendermint/tendermint/libs/os"
+)
+
+// KeyFilesCmd encrypts, decrypts and rotates the key-encryption key of the
+// entropy and noise key files of this node.
+var KeyFilesCmd = &cobra.Command{
+	Use:   "key_files",
+	Short: "Manage encryption of the entropy and noise key files",
+}
+
+var encryptKeyFilesCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt the entropy and noise key files with the key in key_encryption_key_file",
+	RunE:  encryptKeyFiles,
+}
+
+var decryptKeyFilesCmd = &cobra.Command{
+	Use:   "decrypt",
+	Short: "Decrypt the entropy and noise key files with the key in key_encryption_key_file",
+	RunE:  decryptKeyFiles,
+}
+
+var rotateKeyFilesCmd = &cobra.Command{
+	Use: "rotate",
+	Short: "Re-encrypt the entropy and noise key files with a new key-encryption key. " +
+		"key_encryption_key_file must be updated to the new key afterwards",
+	RunE: rotateKeyFiles,
+}
+
+var newKeyEncryptionKeyFile string
+
+func init() {
+	rotateKeyFilesCmd.Flags().StringVar(&newKeyEncryptionKeyFile, "new-key-encryption-key-file", "",
+		"Path to the new key-encryption key")
+	KeyFilesCmd.AddCommand(encryptKeyFilesCmd, decryptKeyFilesCmd, rotateKeyFilesCmd)
+}
+
+func encryptKeyFiles(cmd *cobra.Command, args []string) error {
+	keyEncryptionKey, err := loadConfigKeyEncryptionKey()
+	if err != nil {
+		return err
+	}
+	return rewriteKeyFiles(keyEncryptionKey, keyEncryptionKey)
+}
+
+func decryptKeyFiles(cmd *cobra.Command, args []string) error {
+	keyEncryptionKey, err := loadConfigKeyEncryptionKey()
+	if err != nil {
+		return err
+	}
+	return rewriteKeyFiles(keyEncryptionKey, nil)
+}
+
+func rotateKeyFiles(cmd *cobra.Command, args []string) error {
+	keyEncryptionKey, err := loadConfigKeyEncryptionKey()
+	if err != nil {
+		return err
+	}
+	if newKeyEncryptionKeyFile == "" {
+		return fmt.Errorf("--new-key-encryption-key-file must be set")
+	}
+	newKeyEncryptionKey, err := keyfile.LoadKeyEncryptionKey(newKeyEncryptionKeyFile)
+	if err != nil {
+		return err
+	}
+	return rewriteKeyFiles(keyEncryptionKey, newKeyEncryptionKey)
+}
+
+func loadConfigKeyEncryptionKey() ([]byte, error) {
+	if config.KeyEncryptionKeyFile() == "" {
+		return nil, fmt.Errorf("key_encryption_key_file is not set in config")
+	}
+	return keyfile.LoadKeyEncryptionKey(config.KeyEncryptionKeyFile())
+}
+
+// rewriteKeyFiles reads the existing entropy and noise key files and dkg checkpoint, decrypting them
+// with keyEncryptionKey if encrypted, and writes them back encrypted with
+// newKeyEncryptionKey, or in plaintext if it is empty. Files are checked to be
+// readable before any are rewritten.
+func rewriteKeyFiles(keyEncryptionKey []byte, newKeyEncryptionKey []byte) error {
+	keyFiles := []string{
+		config.OldEntropyKeyFile(),
+		config.EntropyKeyFile(),
+		config.NextEntropyKeyFile(),
+		config.NoiseKeyFile(),
+		config.DKGCheckpointFile(),
+	}
+
+	contents := make(map[string][]byte)
+	for _, filePath := range keyFiles {
+		if !tmos.FileExists(filePath) {
+			continue
+		}
+		data, err := keyfile.ReadFile(filePath, keyEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("error reading key file %v: %v", filePath, err)
+		}
+		contents[filePath] = data
+	}
+
+	for _, filePath := range keyFiles {
+		data, ok := contents[filePath]
+		if !ok {
+			continue
+		}
+		if err := keyfile.WriteFile(filePath, data, newKeyEncryptionKey); err != nil {
+			return fmt.Errorf("error writing key file %v: %v", filePath, err)
+		}
+		logger.Info("Rewrote key file", "file", filePath, "encrypted", len(newKeyEncryptionKey) != 0)
+	}
+	return nil
+}
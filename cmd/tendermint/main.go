@@ -27,6 +27,8 @@ func main() {
 		cmd.TestnetFilesCmd,
 		cmd.ShowNodeIDCmd,
 		cmd.GenNodeKeyCmd,
+		cmd.KeyFilesCmd,
+		cmd.BeaconCmd,
 		cmd.VersionCmd,
 		debug.DebugCmd,
 	)
@@ -43,6 +43,7 @@ var (
 	defaultEntropyKeyName     = "entropy_key.json"
 	defaultNextEntropyKeyName = "next_entropy_key.json"
 	defaultNoiseKeyName       = "noise_key.json"
+	defaultDKGCheckpointName  = "dkg_checkpoint.json"
 
 	defaultNodeKeyName  = "node_key.json"
 	defaultAddrBookName = "addrbook.json"
@@ -55,6 +56,7 @@ var (
 	defaultEntropyKeyPath     = filepath.Join(defaultDataDir, defaultEntropyKeyName)
 	defaultNextEntropyKeyPath = filepath.Join(defaultDataDir, defaultNextEntropyKeyName)
 	defaultNoiseKeyPath       = filepath.Join(defaultDataDir, defaultNoiseKeyName)
+	defaultDKGCheckpointPath  = filepath.Join(defaultDataDir, defaultDKGCheckpointName)
 
 	defaultNodeKeyPath  = filepath.Join(defaultConfigDir, defaultNodeKeyName)
 	defaultAddrBookPath = filepath.Join(defaultConfigDir, defaultAddrBookName)
@@ -227,6 +229,14 @@ type BaseConfig struct { //nolint: maligned
 	// Path to the JSON file containing the noise keys
 	NoiseKey string `mapstructure:"noise_key_file"`
 
+	// Path to the file in which the secret state of a dkg in progress is saved, encrypted
+	// with the noise key, so that the node can finish the dkg after a restart
+	DKGCheckpoint string `mapstructure:"dkg_checkpoint_file"`
+
+	// Path to the file containing the key-encryption key, with which the entropy
+	// key files and noise key file are encrypted at rest. Not encrypted if empty
+	KeyEncryptionKey string `mapstructure:"key_encryption_key_file"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -251,6 +261,7 @@ func DefaultBaseConfig() BaseConfig {
 		OldEntropyKey:      defaultOldEntropyKeyPath,
 		NextEntropyKey:     defaultNextEntropyKeyPath,
 		NoiseKey:           defaultNoiseKeyPath,
+		DKGCheckpoint:      defaultDKGCheckpointPath,
 		NodeKey:            defaultNodeKeyPath,
 		Moniker:            defaultMoniker,
 		ProxyApp:           "tcp://127.0.0.1:26658",
@@ -314,6 +325,20 @@ func (cfg BaseConfig) NoiseKeyFile() string {
 	return rootify(cfg.NoiseKey, cfg.RootDir)
 }
 
+// DKGCheckpointFile returns the full path to the dkg_checkpoint.json file
+func (cfg BaseConfig) DKGCheckpointFile() string {
+	return rootify(cfg.DKGCheckpoint, cfg.RootDir)
+}
+
+// KeyEncryptionKeyFile returns the full path to the key-encryption key file, or
+// an empty string if key files are not encrypted
+func (cfg BaseConfig) KeyEncryptionKeyFile() string {
+	if cfg.KeyEncryptionKey == "" {
+		return ""
+	}
+	return rootify(cfg.KeyEncryptionKey, cfg.RootDir)
+}
+
 // OldPrivValidatorFile returns the full path of the priv_validator.json from pre v0.28.0.
 // TODO: eventually remove.
 func (cfg BaseConfig) OldPrivValidatorFile() string {
@@ -810,6 +835,11 @@ type ConsensusConfig struct {
 	TimeoutPrecommitDelta time.Duration `mapstructure:"timeout_precommit_delta"`
 	TimeoutCommit         time.Duration `mapstructure:"timeout_commit"`
 
+	// How long to wait for entropy from the beacon at each height before prevoting flagged as
+	// timed out. Once validators with more than 2/3 of the voting power have timed out, the
+	// block at the height falls back to empty entropy. Zero waits indefinitely
+	TimeoutEntropy time.Duration `mapstructure:"timeout_entropy"`
+
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
@@ -833,6 +863,7 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommit:            1000 * time.Millisecond,
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
+		TimeoutEntropy:              30 * time.Second,
 		SkipTimeoutCommit:           false,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
@@ -926,6 +957,9 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return errors.New("timeout_commit can't be negative")
 	}
+	if cfg.TimeoutEntropy < 0 {
+		return errors.New("timeout_entropy can't be negative")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return errors.New("create_empty_blocks_interval can't be negative")
 	}
@@ -1048,25 +1082,65 @@ type BeaconConfig struct {
 
 	// Reactor sleep duration parameters
 	PeerGossipSleepDuration time.Duration `mapstructure:"peer_gossip_sleep_duration"`
+	// Sleep time in between asking peers which entropy shares they hold, to correct
+	// the record of the shares sent to them
+	PeerQueryEntropySharesSleepDuration time.Duration `mapstructure:"peer_query_entropy_shares_sleep_duration"`
 	// computeEntropySleepDuration sleep time in between checking if group signature
 	// can be computed. Note peerGossipSleepDuration must be greater than
 	// computeEntropySleepDuration so that peer does not send entropy for next height
 	// before the current height has been computed
 	ComputeEntropySleepDuration time.Duration `mapstructure:"compute_entropy_sleep_duration"`
 
+	// Peers whose trust score, between 0 and 100, falls below this threshold due to
+	// invalid entropy shares or computed entropy are disconnected and refused until
+	// their score recovers
+	PeerTrustThreshold int `mapstructure:"peer_trust_threshold"`
+	// Peers disconnected for misbehaving in the beacon are refused for this long,
+	// including when persistent
+	PeerBanDuration time.Duration `mapstructure:"peer_ban_duration"`
+
+	// The entropy of committed blocks is saved in the entropy store, for recovery after
+	// a restart, rpc queries and catching up peers. Entropy older than this number of
+	// blocks is pruned from the store, or never if 0.
+	EntropyRetainBlocks int64 `mapstructure:"entropy_retain_blocks"`
+	// Save the signature shares each entropy was computed from in the entropy store
+	SaveEntropyShares bool `mapstructure:"save_entropy_shares"`
+
+	// Run dkgs, and request signature shares of aeons loaded without private key,
+	// with the remote signer at priv_validator_laddr. The signer holds the ephemeral
+	// keys and shares of dkgs and the aeon key shares computed from them, which the
+	// node never saves. Such nodes only observe dkgs which reshare aeon keys.
+	RemoteAeonSigner bool `mapstructure:"remote_aeon_signer"`
+
+	// Implementation used to verify block entropy and the entropy shares and
+	// entropy of peers, either "mcl" (the beacon's C++ library) or "go"
+	SignatureVerifier string `mapstructure:"signature_verifier"`
+
 	// DKG parameters
 	RunDKG            bool `mapstructure:"run_dkg"`
 	StrictTxFiltering bool `mapstructure:"strict_tx_filtering"`
+	// Gossip the payloads of dkg messages, other than encryption keys and dry runs, on
+	// the beacon reactor and only include their hashes in blocks. Payloads committed to
+	// in blocks are fetched from peers whether or not this is set.
+	OffChainDKGPayloads bool `mapstructure:"off_chain_dkg_payloads"`
 }
 
 // DefaultBeaconConfig returns a default configuration for the beacon service
 func DefaultBeaconConfig() *BeaconConfig {
 	return &BeaconConfig{
-		PeerGossipSleepDuration:     100 * time.Millisecond,
-		EntropyChannelCapacity:      3,
-		ComputeEntropySleepDuration: 50 * time.Millisecond,
-		RunDKG:                      true,
-		StrictTxFiltering:           false,
+		PeerGossipSleepDuration:             100 * time.Millisecond,
+		PeerQueryEntropySharesSleepDuration: 2000 * time.Millisecond,
+		EntropyChannelCapacity:              3,
+		ComputeEntropySleepDuration:         50 * time.Millisecond,
+		PeerTrustThreshold:                  50,
+		PeerBanDuration:                     10 * time.Minute,
+		EntropyRetainBlocks:                 0,
+		SaveEntropyShares:                   false,
+		RemoteAeonSigner:                    false,
+		SignatureVerifier:                   "mcl",
+		RunDKG:                              true,
+		StrictTxFiltering:                   false,
+		OffChainDKGPayloads:                 false,
 	}
 }
 
@@ -1074,6 +1148,7 @@ func DefaultBeaconConfig() *BeaconConfig {
 func TestBeaconConfig() *BeaconConfig {
 	cfg := DefaultBeaconConfig()
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
+	cfg.PeerQueryEntropySharesSleepDuration = 250 * time.Millisecond
 	cfg.RunDKG = false
 	return cfg
 }
@@ -1084,12 +1159,29 @@ func (cfg *BeaconConfig) ValidateBasic() error {
 	if cfg.PeerGossipSleepDuration < 0 {
 		return errors.New("peer_gossip_sleep_duration can't be negative")
 	}
+	if cfg.PeerQueryEntropySharesSleepDuration < 0 {
+		return errors.New("peer_query_entropy_shares_sleep_duration can't be negative")
+	}
 	if cfg.EntropyChannelCapacity < 0 {
 		return errors.New("entropy_channel_capacity can't be negative")
 	}
 	if cfg.ComputeEntropySleepDuration < 0 {
 		return errors.New("compute_entropy_sleep_duration can't be negative")
 	}
+	if cfg.PeerTrustThreshold < 0 || cfg.PeerTrustThreshold > 100 {
+		return errors.New("peer_trust_threshold must be between 0 and 100")
+	}
+	if cfg.PeerBanDuration < 0 {
+		return errors.New("peer_ban_duration can't be negative")
+	}
+	if cfg.EntropyRetainBlocks < 0 {
+		return errors.New("entropy_retain_blocks can't be negative")
+	}
+	switch cfg.SignatureVerifier {
+	case "mcl", "go":
+	default:
+		return fmt.Errorf("unknown signature_verifier %s", cfg.SignatureVerifier)
+	}
 	return nil
 }
 
@@ -140,6 +140,15 @@ next_entropy_key_file = "{{ .BaseConfig.NextEntropyKey }}"
 # Path to the JSON file containing the noise key for the dkg
 noise_key_file = "{{ .BaseConfig.NoiseKey}}"
 
+# Path to the file in which the secret state of a dkg in progress is saved, encrypted
+# with the noise key, so that the node can finish the dkg after a restart
+dkg_checkpoint_file = "{{ js .BaseConfig.DKGCheckpoint }}"
+
+# Path to the file containing the key-encryption key, with which the entropy key
+# files and the noise key file are encrypted at rest. Key files are not encrypted
+# if empty. Use "tendermint key_files" to encrypt, decrypt or rotate existing files.
+key_encryption_key_file = "{{ js .BaseConfig.KeyEncryptionKey }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -349,6 +358,11 @@ timeout_precommit = "{{ .Consensus.TimeoutPrecommit }}"
 timeout_precommit_delta = "{{ .Consensus.TimeoutPrecommitDelta }}"
 timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 
+# How long to wait for entropy from the beacon at each height. Once validators with
+# more than 2/3 of the voting power have timed out, the block falls back to empty
+# entropy. 0 waits indefinitely
+timeout_entropy = "{{ .Consensus.TimeoutEntropy }}"
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
@@ -419,11 +433,42 @@ entropy_channel_capacity = "{{ .Beacon.EntropyChannelCapacity }}"
 
 # Reactor sleep duration parameters
 peer_gossip_sleep_duration = "{{ .Beacon.PeerGossipSleepDuration }}"
+peer_query_entropy_shares_sleep_duration = "{{ .Beacon.PeerQueryEntropySharesSleepDuration }}"
 compute_entropy_sleep_duration = "{{ .Beacon.ComputeEntropySleepDuration }}"
 
+# Peers whose trust score, between 0 and 100, falls below this threshold due to
+# invalid entropy shares or computed entropy are disconnected and refused until
+# their score recovers
+peer_trust_threshold = {{ .Beacon.PeerTrustThreshold }}
+
+# Peers disconnected for misbehaving in the beacon are refused for this long,
+# including when persistent
+peer_ban_duration = "{{ .Beacon.PeerBanDuration }}"
+
+# The entropy of committed blocks is saved in the entropy store, for recovery after
+# a restart, rpc queries and catching up peers. Entropy older than this number of
+# blocks is pruned from the store, or never if 0.
+entropy_retain_blocks = {{ .Beacon.EntropyRetainBlocks }}
+# Save the signature shares each entropy was computed from in the entropy store
+save_entropy_shares = {{ .Beacon.SaveEntropyShares }}
+
+# Run dkgs, and request signature shares of aeons loaded without private key,
+# with the remote signer at priv_validator_laddr. The signer holds the ephemeral
+# keys and shares of dkgs and the aeon key shares computed from them, which the
+# node never saves. Such nodes only observe dkgs which reshare aeon keys.
+remote_aeon_signer = {{ .Beacon.RemoteAeonSigner }}
+
+# Implementation used to verify block entropy and the entropy shares and
+# entropy of peers, either "mcl" (the beacon's C++ library) or "go"
+signature_verifier = "{{ .Beacon.SignatureVerifier }}"
+
 # DKG parameters
 run_dkg = "{{ .Beacon.RunDKG }}"
 strict_tx_filtering = "{{ .Beacon.StrictTxFiltering }}"
+# Gossip the payloads of dkg messages, other than encryption keys and dry runs, on
+# the beacon reactor and only include their hashes in blocks. Payloads committed to
+# in blocks are fetched from peers whether or not this is set.
+off_chain_dkg_payloads = {{ .Beacon.OffChainDKGPayloads }}
 `
 
 /****** these are for test settings ***********/
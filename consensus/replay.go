@@ -202,6 +202,7 @@ type Handshaker struct {
 	initialState sm.State
 	store        sm.BlockStore
 	eventBus     types.BlockEventPublisher
+	entropyStore sm.EntropyStore
 	genDoc       *types.GenesisDoc
 	logger       log.Logger
 
@@ -232,6 +233,12 @@ func (h *Handshaker) SetEventBus(eventBus types.BlockEventPublisher) {
 	h.eventBus = eventBus
 }
 
+// SetEntropyStore sets the store in which the entropy of replayed blocks is saved.
+// If not called, entropy is not saved.
+func (h *Handshaker) SetEntropyStore(entropyStore sm.EntropyStore) {
+	h.entropyStore = entropyStore
+}
+
 // NBlocks returns the number of blocks applied to the state.
 func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
@@ -468,7 +475,8 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 	block := h.store.LoadBlock(height)
 	meta := h.store.LoadBlockMeta(height)
 
-	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, mock.Mempool{}, sm.MockEvidencePool{})
+	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, mock.Mempool{}, sm.MockEvidencePool{},
+		sm.BlockExecutorWithEntropyStore(h.entropyStore))
 	blockExec.SetEventBus(h.eventBus)
 
 	var err error
@@ -100,6 +100,9 @@ type State struct {
 	// likely in the first few blocks of the chain.
 	strictFiltering bool
 
+	// special txs, of which only priority ones are allowed by strict filtering
+	specialTxs *tx_extensions.Registry
+
 	// add evidence to the pool
 	// when it's detected
 	evpool evidencePool
@@ -152,6 +155,12 @@ type State struct {
 	newEntropy            map[int64]*types.ChannelEntropy
 	haveSetEntropyChannel bool
 	entropyChannel        <-chan types.ChannelEntropy
+
+	// Start of the wait for entropy at the current height, and the callback for blocks
+	// committed with empty entropy after the beacon timed out
+	entropyWaitHeight      int64
+	entropyWaitStart       time.Time
+	entropyTimeoutCallback func(height int64)
 }
 
 // StateOption sets an optional parameter on the State.
@@ -183,6 +192,7 @@ func NewState(
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
 		newEntropy:       make(map[int64]*types.ChannelEntropy),
+		specialTxs:       tx_extensions.NewDefaultRegistry(nil),
 	}
 	// set function defaults (may be overwritten before calling Start)
 	cs.decideProposal = cs.defaultDecideProposal
@@ -225,6 +235,12 @@ func (cs *State) SetEntropyChannel(channel <-chan types.ChannelEntropy) {
 	}
 }
 
+// SetEntropyTimeoutCallback sets a function called with the height of each committed block
+// which fell back to empty entropy after the beacon timed out
+func (cs *State) SetEntropyTimeoutCallback(callback func(height int64)) {
+	cs.entropyTimeoutCallback = callback
+}
+
 // StateMetrics sets the metrics.
 func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
@@ -235,6 +251,11 @@ func StrictTxFiltering(filtering bool) StateOption {
 	return func(cs *State) { cs.strictFiltering = filtering }
 }
 
+// SpecialTxRegistry sets the special tx types. Defaults to the DKG.
+func SpecialTxRegistry(specialTxs *tx_extensions.Registry) StateOption {
+	return func(cs *State) { cs.specialTxs = specialTxs }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -1024,14 +1045,19 @@ func (cs *State) getProposer(height int64, round int) *types.Validator {
 		return cs.Validators.GetProposer()
 	}
 
-	index := round
-	if round >= cs.Validators.Size() {
-		cs.Logger.Debug("getProposer, looping validator list", "height", height, "round", round, "validator size", cs.Validators.Size())
-		index = round % cs.Validators.Size()
-	}
-
 	entropy := tmhash.Sum(newEntropy.Entropy.GroupSignature)
-	proposer := cs.shuffledCabinet(entropy)[index]
+	var proposer *types.Validator
+	if cs.state.ConsensusParams.Entropy.ProposerSampling == types.WeightedProposerSampling {
+		proposer = cs.Validators.SampleProposer(entropy, round)
+	} else {
+		// Chains with params saved before proposer sampling was chosen also shuffle
+		index := round
+		if round >= cs.Validators.Size() {
+			cs.Logger.Debug("getProposer, looping validator list", "height", height, "round", round, "validator size", cs.Validators.Size())
+			index = round % cs.Validators.Size()
+		}
+		proposer = cs.shuffledCabinet(entropy)[index]
+	}
 	cs.Logger.Debug("getProposer with entropy", "height", height, "round", round, "entropyProposer", proposer.Address, "nonEntropyProposer", cs.Validators.GetProposer().Address)
 	return proposer
 }
@@ -1039,7 +1065,7 @@ func (cs *State) getProposer(height int64, round int) *types.Validator {
 // Populate entropy into a map for the current block height and the next from the entropy
 // generator. Older entropy is trimmed. Subsequent requests for entropy can thus either
 // use the map, or the block store to get entropy. This function can block for as long
-// as it takes to generate entropy (possibly forever)
+// as it takes to generate entropy, or until the entropy timeout for the height has passed
 func (cs *State) getNewEntropy(height int64) {
 
 	debugThing := fmt.Sprintf("getNewEntropy for height %v", height)
@@ -1081,13 +1107,19 @@ func (cs *State) getNewEntropy(height int64) {
 				heightFound = true
 			}
 
+			// Stop waiting once the entropy timeout has passed. The channel is still
+			// polled on later calls so entropy arriving late is kept
+			if cs.entropyWaitTimedOut(height) {
+				heightFound = true
+			}
+
 			// If there is no entropy set, attempt to get some from the channel.
 			// Note it is important not to unnecessarily pull entropy from
 			// the channel as it will cause it to run ahead
 			var newEntropy types.ChannelEntropy
 			receivedEntropy := false
 
-			if heightFound == false {
+			if heightFound == false || cs.entropyTimedOut(height) {
 				select {
 				case newEntropy = <-cs.entropyChannel:
 					receivedEntropy = true
@@ -1128,6 +1160,42 @@ func (cs *State) getNewEntropy(height int64) {
 	}
 }
 
+// entropyWaitTimedOut returns whether the wait for entropy at height, started on the first
+// call for the height, has exceeded the entropy timeout. Must be called with mtx held.
+func (cs *State) entropyWaitTimedOut(height int64) bool {
+	if cs.config.TimeoutEntropy <= 0 {
+		return false
+	}
+	if cs.entropyWaitHeight != height {
+		cs.entropyWaitHeight = height
+		cs.entropyWaitStart = tmtime.Now()
+	}
+	return tmtime.Now().Sub(cs.entropyWaitStart) >= cs.config.TimeoutEntropy
+}
+
+// entropyTimedOut returns whether this node timed out waiting for entropy at height without
+// receiving it. Must be called with mtx held.
+func (cs *State) entropyTimedOut(height int64) bool {
+	if !cs.haveSetEntropyChannel || cs.config.TimeoutEntropy <= 0 || cs.entropyWaitHeight != height {
+		return false
+	}
+	if _, ok := cs.newEntropy[height]; ok {
+		return false
+	}
+	return tmtime.Now().Sub(cs.entropyWaitStart) >= cs.config.TimeoutEntropy
+}
+
+// entropyFallback returns whether blocks at the current height fall back to empty entropy and
+// the round robin proposer, either because validators with more than two thirds of the voting
+// power have timed out waiting for entropy, or because this node timed out without receiving it.
+// In the latter case only blocks carrying an entropy timeout are prevoted.
+func (cs *State) entropyFallback(height int64) bool {
+	if height != cs.Height || !cs.haveSetEntropyChannel {
+		return false
+	}
+	return cs.entropyTimedOut(height) || cs.Votes.EntropyTimeout() != nil
+}
+
 // Convenience function to return the entropy, checking that when it is requested,
 // it is set and the height is correct
 func (cs *State) getEntropy(height int64) *types.ChannelEntropy {
@@ -1140,6 +1208,11 @@ func (cs *State) getEntropy(height int64) *types.ChannelEntropy {
 		return types.NewChannelEntropy(1, *types.EmptyBlockEntropy(), false, nil)
 	}
 
+	// Empty entropy after the beacon timed out
+	if cs.entropyFallback(height) {
+		return types.NewChannelEntropy(height, *types.EmptyBlockEntropy(), false, nil)
+	}
+
 	// Attempt to get from the map
 	if entropy, ok := cs.newEntropy[height]; ok {
 
@@ -1165,7 +1238,8 @@ func (cs *State) getEntropy(height int64) *types.ChannelEntropy {
 	return chanEnt
 }
 
-// TODO: Check that rand.Shuffle is same across different platforms
+// shuffledCabinet orders the validators for ShuffleProposerSampling. The order depends on the
+// implementation of math/rand, so WeightedProposerSampling should be preferred by new chains
 func (cs *State) shuffledCabinet(entropy []byte) types.ValidatorsByAddress {
 	if len(entropy) < 8 {
 		cs.Logger.Error("Entropy byte array too small for int64 for random seed", "size", len(entropy))
@@ -1197,11 +1271,18 @@ func (cs *State) defaultDecideProposal(height int64, round int) {
 		// If there is valid block, choose that.
 		block, blockParts = cs.ValidBlock, cs.ValidBlockParts
 	} else {
+		// Blocks falling back to empty entropy must carry proof that a quorum timed out
+		if cs.entropyFallback(height) && cs.Votes.EntropyTimeout() == nil {
+			cs.Logger.Info("enterPropose: Timed out waiting for entropy. Waiting for a quorum to time out before proposing",
+				"height", height, "round", round)
+			return
+		}
 		// Create a new proposal block from state/txs from the mempool.
 		block, blockParts = cs.createProposalBlock()
 		// Add entropy and reset blockParts
 
 		block.Header.Entropy = cs.getEntropy(height).Entropy
+		block.Header.AeonHash = cs.blockExec.AeonHash(&block.Header.Entropy)
 		blockParts = block.MakePartSet(types.BlockPartSizeBytes)
 		if block == nil { // on error
 			return
@@ -1281,7 +1362,12 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		onlyDKGTxs = true
 	}
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, onlyDKGTxs)
+	var entropyTimeout *types.EntropyTimeout
+	if cs.entropyFallback(cs.Height) {
+		entropyTimeout = cs.Votes.EntropyTimeout()
+	}
+
+	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, onlyDKGTxs, entropyTimeout)
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1337,16 +1423,23 @@ func (cs *State) defaultDoPrevote(height int64, round int) bool {
 		return false
 	}
 
-	// Check block entropy (note this can be empty in fallback mode which is fine)
-	if !cs.ProposalBlock.Header.Entropy.Equal(&cs.getEntropy(height).Entropy) {
+	// Check block entropy (note this can be empty in fallback mode which is fine). Blocks
+	// falling back to empty entropy after the beacon timed out have their proof checked when
+	// validating the block, and are the only blocks accepted once this node has timed out
+	if cs.ProposalBlock.EntropyTimeout == nil && cs.entropyFallback(height) {
+		logger.Error("enterPrevote: ProposalBlock has no entropy timeout after timing out waiting for entropy")
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return false
+	}
+	if cs.ProposalBlock.EntropyTimeout == nil && !cs.ProposalBlock.Header.Entropy.Equal(&cs.getEntropy(height).Entropy) {
 		logger.Error(fmt.Sprintf("enterPrevote: ProposalBlock has invalid entropy. Note: enabled: %v entropy: %v", cs.getEntropy(height).Enabled, cs.ProposalBlock.Header.Entropy))
 		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
 		return false
 	}
 
 	// Verify if we are in fallback and strict tx filtering that the block has only
-	// dkg TXs
-	if cs.strictFiltering && !cs.getEntropy(height).Enabled && !allDKGTxs(&cs.ProposalBlock.Data.Txs) {
+	// priority special TXs, such as dkg TXs
+	if cs.strictFiltering && !cs.getEntropy(height).Enabled && !cs.allPriorityTxs(&cs.ProposalBlock.Data.Txs) {
 		logger.Error(fmt.Sprintf("enterPrevote: ProposalBlock fails the strict tx check "))
 		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
 		return false
@@ -1739,6 +1832,11 @@ func (cs *State) finalizeCommit(height int64) {
 		}
 	}
 
+	// Let the beacon move past a height committed without entropy
+	if block.EntropyTimeout != nil && cs.entropyTimeoutCallback != nil {
+		cs.entropyTimeoutCallback(height)
+	}
+
 	fail.Fail() // XXX
 
 	// cs.StartTime is already set.
@@ -1820,7 +1918,7 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 	txsInBlock := 0
 
 	for _, tx := range block.Data.Txs {
-		if !tx_extensions.IsDKGRelated(tx) {
+		if reg := cs.specialTxs.Lookup(tx); reg == nil || reg.Type != tx_extensions.SpecialTxType_DKG {
 			txsInBlock++
 		}
 	}
@@ -2176,6 +2274,7 @@ func (cs *State) signVote(
 		Timestamp:        cs.voteTime(),
 		Type:             msgType,
 		BlockID:          types.BlockID{Hash: hash, PartsHeader: header},
+		EntropyTimedOut:  msgType == types.PrevoteType && cs.entropyTimedOut(cs.Height),
 	}
 	err := cs.privValidator.SignVote(cs.state.ChainID, vote)
 	return vote, err
@@ -2218,10 +2317,10 @@ func (cs *State) signAddVote(msgType types.SignedMsgType, hash []byte, header ty
 	return nil
 }
 
-func allDKGTxs(txs *types.Txs) bool {
+func (cs *State) allPriorityTxs(txs *types.Txs) bool {
 
 	for _, tx := range *txs {
-		if !tx_extensions.IsDKGRelated(tx) {
+		if !cs.specialTxs.IsPriority(tx) {
 			return false
 		}
 	}
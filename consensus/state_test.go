@@ -18,6 +18,7 @@ import (
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	p2pmock "github.com/tendermint/tendermint/p2p/mock"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 /*
@@ -283,6 +284,73 @@ func TestStateBeaconProposerSelection(t *testing.T) {
 	assert.True(t, countEqual != 8)
 }
 
+func TestStateBeaconWeightedProposerSelection(t *testing.T) {
+	cs1, _ := randState(4)
+	cs1.state.ConsensusParams.Entropy.ProposerSampling = types.WeightedProposerSampling
+
+	entropyChannel := make(chan types.ChannelEntropy, 5)
+	cs1.SetEntropyChannel(entropyChannel)
+
+	entropyChannel <- *types.NewChannelEntropy(1, *types.NewBlockEntropy([]byte{0, 0, 0, 0, 1, 2, 3, 4}, 0, 100, 0), true, cs1.Validators.Hash())
+	entropyChannel <- *types.NewChannelEntropy(2, *types.EmptyBlockEntropy(), false, nil) // Push through extra empty entropy so consensus can 'look ahead'
+
+	// Proposers are sampled for each round, including those beyond the number of validators
+	entropy := tmhash.Sum([]byte{0, 0, 0, 0, 1, 2, 3, 4})
+	for i := 0; i < 8; i++ {
+		cs1.getNewEntropy(1)
+		prop := cs1.getProposer(1, i)
+		assert.True(t, bytes.Equal(prop.Address, cs1.Validators.SampleProposer(entropy, i).Address))
+	}
+}
+
+func TestStateBeaconEntropyTimeout(t *testing.T) {
+	cs1, vss := randState(4)
+	cs1.config.TimeoutEntropy = 10 * time.Millisecond
+
+	entropyChannel := make(chan types.ChannelEntropy, 5)
+	cs1.SetEntropyChannel(entropyChannel)
+
+	// Waiting for entropy stops after the timeout and prevotes are flagged
+	cs1.getNewEntropy(1)
+	assert.False(t, cs1.getEntropy(1).Enabled)
+	assert.True(t, bytes.Equal(cs1.getProposer(1, 0).Address, cs1.Validators.GetProposer().Address))
+	vote, err := cs1.signVote(types.PrevoteType, nil, types.PartSetHeader{})
+	require.NoError(t, err)
+	assert.True(t, vote.EntropyTimedOut)
+
+	// Without a quorum of timed out prevotes no block can be created with empty entropy
+	block, _ := cs1.createProposalBlock()
+	assert.Nil(t, block.EntropyTimeout)
+
+	// Entropy arriving late is still used
+	entropyChannel <- *types.NewChannelEntropy(1, *types.NewBlockEntropy([]byte{0, 0, 0, 0, 1, 2, 3, 4}, 0, 100, 0), true, cs1.Validators.Hash())
+	entropyChannel <- *types.NewChannelEntropy(2, *types.EmptyBlockEntropy(), false, nil)
+	cs1.getNewEntropy(1)
+	assert.True(t, cs1.getEntropy(1).Enabled)
+
+	// Until validators with more than two thirds of the voting power have timed out
+	for _, vs := range vss[1:] {
+		vote := &types.Vote{
+			ValidatorIndex:   vs.Index,
+			ValidatorAddress: vs.PrivValidator.GetPubKey().Address(),
+			Height:           vs.Height,
+			Round:            vs.Round,
+			Timestamp:        tmtime.Now(),
+			Type:             types.PrevoteType,
+			EntropyTimedOut:  true,
+		}
+		require.NoError(t, vs.PrivValidator.SignVote(config.ChainID(), vote))
+		_, err := cs1.Votes.AddVote(vote, "peer")
+		require.NoError(t, err)
+	}
+	assert.False(t, cs1.getEntropy(1).Enabled)
+
+	block, _ = cs1.createProposalBlock()
+	require.NotNil(t, block.EntropyTimeout)
+	assert.Len(t, block.EntropyTimeout.Votes, 3)
+	assert.NoError(t, cs1.blockExec.ValidateBlock(cs1.state, block))
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -159,6 +159,19 @@ func (hvs *HeightVoteSet) POLInfo() (polRound int, polBlockID types.BlockID) {
 	return -1, types.BlockID{}
 }
 
+// EntropyTimeout returns the flagged prevotes of the earliest round in which validators with more
+// than two thirds of the voting power timed out waiting for entropy, or nil if there is none.
+func (hvs *HeightVoteSet) EntropyTimeout() *types.EntropyTimeout {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	for r := 0; r <= hvs.round; r++ {
+		if timeout := hvs.getVoteSet(r, types.PrevoteType).EntropyTimeout(); timeout != nil {
+			return timeout
+		}
+	}
+	return nil
+}
+
 func (hvs *HeightVoteSet) getVoteSet(round int, voteType types.SignedMsgType) *types.VoteSet {
 	rvs, ok := hvs.roundVoteSets[round]
 	if !ok {
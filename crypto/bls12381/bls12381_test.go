@@ -0,0 +1,188 @@
+package bls12381
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeys are the keys of a member of the 4 validator aeon in beacon/test_keys, written by mcl
+type testKeys struct {
+	generator       *G2
+	groupPublicKey  *G2
+	privateKey      *big.Int
+	publicKeyShares []*G2
+}
+
+func loadTestKeys(t *testing.T, index int) *testKeys {
+	data, err := ioutil.ReadFile(fmt.Sprintf("../../beacon/test_keys/validator_%v_of_4.txt", index))
+	require.NoError(t, err)
+	lines := strings.Split(string(data), "\n")
+
+	keys := &testKeys{}
+	keys.generator, err = G2FromString(lines[2])
+	require.NoError(t, err)
+	keys.groupPublicKey, err = G2FromString(lines[3])
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(lines[3]), keys.groupPublicKey.String())
+	keys.privateKey, err = FrFromString(lines[4])
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		share, err := G2FromString(lines[5+i])
+		require.NoError(t, err)
+		keys.publicKeyShares = append(keys.publicKeyShares, share)
+	}
+	return keys
+}
+
+func testFp12() fp12 {
+	var coeffs []*big.Int
+	for i := int64(0); i < 12; i++ {
+		coeffs = append(coeffs, big.NewInt(3*i*i+7*i+2))
+	}
+	return fp12{
+		c0: fp6{fp2{coeffs[0], coeffs[1]}, fp2{coeffs[2], coeffs[3]}, fp2{coeffs[4], coeffs[5]}},
+		c1: fp6{fp2{coeffs[6], coeffs[7]}, fp2{coeffs[8], coeffs[9]}, fp2{coeffs[10], coeffs[11]}},
+	}
+}
+
+func TestFp12Inverse(t *testing.T) {
+	a := testFp12()
+	assert.True(t, a.mul(a.inv()).isOne())
+}
+
+func TestFp12Frobenius(t *testing.T) {
+	a := testFp12()
+	assert.True(t, a.frobenius().equal(a.exp(fieldModulus)))
+}
+
+func TestFinalExponentiation(t *testing.T) {
+	// 3 (p^4 - p^2 + 1) / r = (z - 1)^2 (z + p) (z^2 + p^2 - 1) + 3
+	p := fieldModulus
+	z := new(big.Int).Neg(curveParamAbs)
+	p2 := new(big.Int).Mul(p, p)
+	expected := new(big.Int).Mul(p2, p2)
+	expected.Sub(expected, p2).Add(expected, bigOne).Mul(expected, bigThree)
+	expected, remainder := expected.QuoRem(expected, Order, new(big.Int))
+	require.Zero(t, remainder.Sign())
+
+	zMinusOne := new(big.Int).Sub(z, bigOne)
+	hard := new(big.Int).Mul(zMinusOne, zMinusOne)
+	hard.Mul(hard, new(big.Int).Add(z, p))
+	hard.Mul(hard, new(big.Int).Sub(new(big.Int).Add(new(big.Int).Mul(z, z), p2), bigOne))
+	hard.Add(hard, bigThree)
+	assert.Equal(t, expected, hard)
+
+	// Final exponentiation raises to 3 (p^12 - 1) / r
+	exponent := new(big.Int).Exp(p, big.NewInt(12), nil)
+	exponent.Sub(exponent, bigOne).Mul(exponent, bigThree).Div(exponent, Order)
+	a := testFp12()
+	assert.True(t, finalExponentiation(a).equal(a.exp(exponent)))
+}
+
+func TestG2FromStringTestKeys(t *testing.T) {
+	keys := loadTestKeys(t, 0)
+	assert.True(t, keys.generator.InSubgroup())
+	assert.False(t, keys.generator.IsInfinity())
+
+	// Public key shares are the generator multiplied by the private key of each member
+	for i := 0; i < 4; i++ {
+		memberKeys := loadTestKeys(t, i)
+		assert.True(t, keys.generator.Mul(memberKeys.privateKey).Equal(keys.publicKeyShares[i]))
+	}
+
+	// Any threshold of them interpolate to the group public key at zero
+	lagrange := func(indices ...int64) *G2 {
+		res := G2Infinity()
+		for _, i := range indices {
+			coeff := big.NewInt(1)
+			for _, j := range indices {
+				if i != j {
+					coeff.Mul(coeff, big.NewInt(j+1))
+					coeff.Mul(coeff, new(big.Int).ModInverse(new(big.Int).Mod(big.NewInt(j-i), Order), Order))
+				}
+			}
+			res = res.Add(keys.publicKeyShares[i].Mul(coeff.Mod(coeff, Order)))
+		}
+		return res
+	}
+	assert.True(t, lagrange(0, 1, 2).Equal(keys.groupPublicKey))
+	assert.True(t, lagrange(1, 2, 3).Equal(keys.groupPublicKey))
+	assert.False(t, lagrange(0, 1).Equal(keys.groupPublicKey))
+
+	parsed, err := G2FromString(keys.groupPublicKey.String())
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(keys.groupPublicKey))
+}
+
+func TestPointFromStringInvalid(t *testing.T) {
+	keys := loadTestKeys(t, 0)
+	fields := strings.Fields(keys.generator.String())
+
+	for _, s := range []string{
+		"",
+		"1",
+		"2 " + strings.Join(fields[1:3], " "),
+		"1 " + strings.Join(fields[1:4], " "),
+		"1 " + strings.Join(fields[1:4], " ") + " 1",
+		"1 " + strings.Join(fields[1:4], " ") + " -" + fields[4],
+		"1 " + fields[1] + " " + fieldModulus.String() + " " + strings.Join(fields[3:], " "),
+	} {
+		_, err := G2FromString(s)
+		assert.Error(t, err, s)
+	}
+	infinity, err := G2FromString("0")
+	require.NoError(t, err)
+	assert.True(t, infinity.IsInfinity())
+
+	_, err = G1FromString("1 1 2")
+	assert.Error(t, err)
+	_, err = FrFromString(Order.String())
+	assert.Error(t, err)
+}
+
+func TestHashToG1(t *testing.T) {
+	p, err := HashToG1([]byte("message"))
+	require.NoError(t, err)
+	assert.True(t, p.InSubgroup())
+	assert.False(t, p.IsInfinity())
+
+	q, err := HashToG1([]byte("message"))
+	require.NoError(t, err)
+	assert.True(t, p.Equal(q))
+	q, err = HashToG1([]byte("other message"))
+	require.NoError(t, err)
+	assert.False(t, p.Equal(q))
+
+	parsed, err := G1FromString(p.String())
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(p))
+
+	assert.True(t, HashToFr([]byte("message")).Cmp(Order) < 0)
+}
+
+func TestPairing(t *testing.T) {
+	keys := loadTestKeys(t, 0)
+	p, err := HashToG1([]byte("message"))
+	require.NoError(t, err)
+	q := keys.generator
+
+	e := pairing(p, q)
+	assert.False(t, e.isOne())
+	assert.True(t, e.exp(Order).isOne())
+
+	// Bilinearity
+	a, b := big.NewInt(123456789), big.NewInt(987654321)
+	assert.True(t, pairing(p.Mul(a), q.Mul(b)).equal(e.exp(new(big.Int).Mul(a, b))))
+
+	// Signature with the private key against its public key share
+	sig := p.Mul(keys.privateKey)
+	assert.True(t, PairingCheck([]*G1{sig, p.Neg()}, []*G2{q, keys.publicKeyShares[0]}))
+	assert.False(t, PairingCheck([]*G1{sig, p.Neg()}, []*G2{q, keys.publicKeyShares[1]}))
+	assert.True(t, PairingCheck([]*G1{G1Infinity()}, []*G2{q}))
+}
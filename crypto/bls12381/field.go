@@ -0,0 +1,287 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+// Tower of extension fields used by the pairing:
+//
+//	Fp2  = Fp[u] / (u^2 + 1)
+//	Fp6  = Fp2[v] / (v^3 - xi), xi = u + 1
+//	Fp12 = Fp6[w] / (w^2 - v)
+//
+// Elements are immutable; every operation returns a new value.
+
+var (
+	// fieldModulus is the characteristic p of the base field
+	fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfff"+
+		"eb153ffffb9feffffffffaaab", 16)
+	// Order is the prime order r of G1, G2 and of the scalar field Fr
+	Order, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+	bigOne   = big.NewInt(1)
+	bigThree = big.NewInt(3)
+
+	// sqrtExponent = (p + 1) / 4, valid since p = 3 mod 4
+	sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(fieldModulus, bigOne), 2)
+
+	// frobeniusCoeffs[k] = gamma_k = xi^(k (p - 1) / 6) = w^(k (p - 1))
+	frobeniusCoeffs = computeFrobeniusCoeffs()
+)
+
+func computeFrobeniusCoeffs() [6]fp2 {
+	var coeffs [6]fp2
+	e := new(big.Int).Div(new(big.Int).Sub(fieldModulus, bigOne), big.NewInt(6))
+	gamma := fp2{big.NewInt(1), big.NewInt(1)}.exp(e)
+	coeffs[0] = fp2One()
+	for k := 1; k < 6; k++ {
+		coeffs[k] = coeffs[k-1].mul(gamma)
+	}
+	return coeffs
+}
+
+//-----------------------------------------------------------------------------
+// Fp
+
+func fpAdd(a, b *big.Int) *big.Int {
+	c := new(big.Int).Add(a, b)
+	if c.Cmp(fieldModulus) >= 0 {
+		c.Sub(c, fieldModulus)
+	}
+	return c
+}
+
+func fpSub(a, b *big.Int) *big.Int {
+	c := new(big.Int).Sub(a, b)
+	if c.Sign() < 0 {
+		c.Add(c, fieldModulus)
+	}
+	return c
+}
+
+func fpNeg(a *big.Int) *big.Int {
+	if a.Sign() == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Sub(fieldModulus, a)
+}
+
+func fpMul(a, b *big.Int) *big.Int {
+	c := new(big.Int).Mul(a, b)
+	return c.Mod(c, fieldModulus)
+}
+
+func fpInv(a *big.Int) *big.Int {
+	return new(big.Int).ModInverse(a, fieldModulus)
+}
+
+// fpSqrt returns a^((p + 1) / 4) and whether it is a square root of a
+func fpSqrt(a *big.Int) (*big.Int, bool) {
+	root := new(big.Int).Exp(a, sqrtExponent, fieldModulus)
+	return root, fpMul(root, root).Cmp(a) == 0
+}
+
+//-----------------------------------------------------------------------------
+// Fp2
+
+type fp2 struct {
+	c0, c1 *big.Int
+}
+
+func fp2Zero() fp2 {
+	return fp2{new(big.Int), new(big.Int)}
+}
+
+func fp2One() fp2 {
+	return fp2{big.NewInt(1), new(big.Int)}
+}
+
+func fp2FromFp(a *big.Int) fp2 {
+	return fp2{a, new(big.Int)}
+}
+
+func (a fp2) isZero() bool {
+	return a.c0.Sign() == 0 && a.c1.Sign() == 0
+}
+
+func (a fp2) equal(b fp2) bool {
+	return a.c0.Cmp(b.c0) == 0 && a.c1.Cmp(b.c1) == 0
+}
+
+func (a fp2) add(b fp2) fp2 {
+	return fp2{fpAdd(a.c0, b.c0), fpAdd(a.c1, b.c1)}
+}
+
+func (a fp2) sub(b fp2) fp2 {
+	return fp2{fpSub(a.c0, b.c0), fpSub(a.c1, b.c1)}
+}
+
+func (a fp2) neg() fp2 {
+	return fp2{fpNeg(a.c0), fpNeg(a.c1)}
+}
+
+func (a fp2) mul(b fp2) fp2 {
+	// (a0 + a1 u)(b0 + b1 u) = a0 b0 - a1 b1 + ((a0 + a1)(b0 + b1) - a0 b0 - a1 b1) u
+	t0 := fpMul(a.c0, b.c0)
+	t1 := fpMul(a.c1, b.c1)
+	t2 := fpMul(fpAdd(a.c0, a.c1), fpAdd(b.c0, b.c1))
+	return fp2{fpSub(t0, t1), fpSub(fpSub(t2, t0), t1)}
+}
+
+func (a fp2) mulFp(b *big.Int) fp2 {
+	return fp2{fpMul(a.c0, b), fpMul(a.c1, b)}
+}
+
+func (a fp2) sqr() fp2 {
+	return a.mul(a)
+}
+
+// mulXi multiplies by xi = u + 1
+func (a fp2) mulXi() fp2 {
+	return fp2{fpSub(a.c0, a.c1), fpAdd(a.c0, a.c1)}
+}
+
+// conj returns a^p
+func (a fp2) conj() fp2 {
+	return fp2{a.c0, fpNeg(a.c1)}
+}
+
+func (a fp2) exp(e *big.Int) fp2 {
+	res := fp2One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = res.sqr()
+		if e.Bit(i) == 1 {
+			res = res.mul(a)
+		}
+	}
+	return res
+}
+
+func (a fp2) inv() fp2 {
+	// 1 / (a0 + a1 u) = (a0 - a1 u) / (a0^2 + a1^2)
+	t := fpInv(fpAdd(fpMul(a.c0, a.c0), fpMul(a.c1, a.c1)))
+	return fp2{fpMul(a.c0, t), fpNeg(fpMul(a.c1, t))}
+}
+
+//-----------------------------------------------------------------------------
+// Fp6
+
+type fp6 struct {
+	c0, c1, c2 fp2
+}
+
+func fp6Zero() fp6 {
+	return fp6{fp2Zero(), fp2Zero(), fp2Zero()}
+}
+
+func fp6One() fp6 {
+	return fp6{fp2One(), fp2Zero(), fp2Zero()}
+}
+
+func (a fp6) isZero() bool {
+	return a.c0.isZero() && a.c1.isZero() && a.c2.isZero()
+}
+
+func (a fp6) equal(b fp6) bool {
+	return a.c0.equal(b.c0) && a.c1.equal(b.c1) && a.c2.equal(b.c2)
+}
+
+func (a fp6) add(b fp6) fp6 {
+	return fp6{a.c0.add(b.c0), a.c1.add(b.c1), a.c2.add(b.c2)}
+}
+
+func (a fp6) sub(b fp6) fp6 {
+	return fp6{a.c0.sub(b.c0), a.c1.sub(b.c1), a.c2.sub(b.c2)}
+}
+
+func (a fp6) neg() fp6 {
+	return fp6{a.c0.neg(), a.c1.neg(), a.c2.neg()}
+}
+
+func (a fp6) mul(b fp6) fp6 {
+	t0 := a.c0.mul(b.c0)
+	t1 := a.c1.mul(b.c1)
+	t2 := a.c2.mul(b.c2)
+	// c0 = a0 b0 + xi (a1 b2 + a2 b1)
+	c0 := a.c1.add(a.c2).mul(b.c1.add(b.c2)).sub(t1).sub(t2).mulXi().add(t0)
+	// c1 = a0 b1 + a1 b0 + xi a2 b2
+	c1 := a.c0.add(a.c1).mul(b.c0.add(b.c1)).sub(t0).sub(t1).add(t2.mulXi())
+	// c2 = a0 b2 + a1 b1 + a2 b0
+	c2 := a.c0.add(a.c2).mul(b.c0.add(b.c2)).sub(t0).sub(t2).add(t1)
+	return fp6{c0, c1, c2}
+}
+
+// mulV multiplies by v
+func (a fp6) mulV() fp6 {
+	return fp6{a.c2.mulXi(), a.c0, a.c1}
+}
+
+func (a fp6) inv() fp6 {
+	t0 := a.c0.sqr().sub(a.c1.mul(a.c2).mulXi())
+	t1 := a.c2.sqr().mulXi().sub(a.c0.mul(a.c1))
+	t2 := a.c1.sqr().sub(a.c0.mul(a.c2))
+	det := a.c0.mul(t0).add(a.c2.mul(t1).add(a.c1.mul(t2)).mulXi()).inv()
+	return fp6{t0.mul(det), t1.mul(det), t2.mul(det)}
+}
+
+//-----------------------------------------------------------------------------
+// Fp12
+
+type fp12 struct {
+	c0, c1 fp6
+}
+
+func fp12One() fp12 {
+	return fp12{fp6One(), fp6Zero()}
+}
+
+func (a fp12) isOne() bool {
+	return a.equal(fp12One())
+}
+
+func (a fp12) equal(b fp12) bool {
+	return a.c0.equal(b.c0) && a.c1.equal(b.c1)
+}
+
+func (a fp12) mul(b fp12) fp12 {
+	t0 := a.c0.mul(b.c0)
+	t1 := a.c1.mul(b.c1)
+	c0 := t0.add(t1.mulV())
+	c1 := a.c0.add(a.c1).mul(b.c0.add(b.c1)).sub(t0).sub(t1)
+	return fp12{c0, c1}
+}
+
+func (a fp12) sqr() fp12 {
+	return a.mul(a)
+}
+
+// conj returns a^(p^6)
+func (a fp12) conj() fp12 {
+	return fp12{a.c0, a.c1.neg()}
+}
+
+// frobenius returns a^p. Writing a = sum_k a_k w^k with a_k in Fp2, a^p = sum_k conj(a_k) gamma_k w^k
+func (a fp12) frobenius() fp12 {
+	return fp12{
+		c0: fp6{a.c0.c0.conj(), a.c0.c1.conj().mul(frobeniusCoeffs[2]), a.c0.c2.conj().mul(frobeniusCoeffs[4])},
+		c1: fp6{a.c1.c0.conj().mul(frobeniusCoeffs[1]), a.c1.c1.conj().mul(frobeniusCoeffs[3]),
+			a.c1.c2.conj().mul(frobeniusCoeffs[5])},
+	}
+}
+
+func (a fp12) inv() fp12 {
+	// 1 / (a0 + a1 w) = (a0 - a1 w) / (a0^2 - a1^2 v)
+	t := a.c0.mul(a.c0).sub(a.c1.mul(a.c1).mulV()).inv()
+	return fp12{a.c0.mul(t), a.c1.mul(t).neg()}
+}
+
+func (a fp12) exp(e *big.Int) fp12 {
+	res := fp12One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = res.sqr()
+		if e.Bit(i) == 1 {
+			res = res.mul(a)
+		}
+	}
+	return res
+}
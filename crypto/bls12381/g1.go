@@ -0,0 +1,107 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+// G1 is a point in affine coordinates on the curve y^2 = x^3 + 4 over Fp
+type G1 struct {
+	x, y     *big.Int
+	infinity bool
+}
+
+var g1B = big.NewInt(4)
+
+// G1Infinity returns the point at infinity of G1
+func G1Infinity() *G1 {
+	return &G1{x: new(big.Int), y: new(big.Int), infinity: true}
+}
+
+// IsInfinity returns true for the point at infinity
+func (p *G1) IsInfinity() bool {
+	return p.infinity
+}
+
+// Equal returns true if p and q are the same point
+func (p *G1) Equal(q *G1) bool {
+	if p.infinity || q.infinity {
+		return p.infinity == q.infinity
+	}
+	return p.x.Cmp(q.x) == 0 && p.y.Cmp(q.y) == 0
+}
+
+// IsOnCurve checks that p satisfies the curve equation
+func (p *G1) IsOnCurve() bool {
+	if p.infinity {
+		return true
+	}
+	if p.x.Cmp(fieldModulus) >= 0 || p.y.Cmp(fieldModulus) >= 0 {
+		return false
+	}
+	return fpMul(p.y, p.y).Cmp(g1Weierstrass(p.x)) == 0
+}
+
+// InSubgroup checks that p is on the curve and has order dividing the group order
+func (p *G1) InSubgroup() bool {
+	return p.IsOnCurve() && p.Mul(Order).infinity
+}
+
+// Neg returns -p
+func (p *G1) Neg() *G1 {
+	if p.infinity {
+		return G1Infinity()
+	}
+	return &G1{x: p.x, y: fpNeg(p.y)}
+}
+
+// Add returns p + q
+func (p *G1) Add(q *G1) *G1 {
+	if p.infinity {
+		return q
+	}
+	if q.infinity {
+		return p
+	}
+	if p.x.Cmp(q.x) == 0 {
+		if p.y.Cmp(q.y) == 0 {
+			return p.double()
+		}
+		return G1Infinity()
+	}
+	lambda := fpMul(fpSub(q.y, p.y), fpInv(fpSub(q.x, p.x)))
+	return p.chord(lambda, q.x)
+}
+
+func (p *G1) double() *G1 {
+	if p.infinity || p.y.Sign() == 0 {
+		return G1Infinity()
+	}
+	xx := fpMul(p.x, p.x)
+	lambda := fpMul(fpAdd(fpAdd(xx, xx), xx), fpInv(fpAdd(p.y, p.y)))
+	return p.chord(lambda, p.x)
+}
+
+// chord returns the third intersection, negated, of the line through p with slope lambda and
+// the curve, with qx the x coordinate of the second point on the line
+func (p *G1) chord(lambda *big.Int, qx *big.Int) *G1 {
+	x := fpSub(fpSub(fpMul(lambda, lambda), p.x), qx)
+	y := fpSub(fpMul(lambda, fpSub(p.x, x)), p.y)
+	return &G1{x: x, y: y}
+}
+
+// Mul returns k p for a non-negative integer k
+func (p *G1) Mul(k *big.Int) *G1 {
+	res := G1Infinity()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = res.double()
+		if k.Bit(i) == 1 {
+			res = res.Add(p)
+		}
+	}
+	return res
+}
+
+// g1Weierstrass returns x^3 + 4
+func g1Weierstrass(x *big.Int) *big.Int {
+	return fpAdd(fpMul(fpMul(x, x), x), g1B)
+}
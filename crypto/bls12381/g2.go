@@ -0,0 +1,112 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+// G2 is a point in affine coordinates on the twist y^2 = x^3 + 4 (u + 1) over Fp2
+type G2 struct {
+	x, y     fp2
+	infinity bool
+}
+
+var g2B = fp2{big.NewInt(4), big.NewInt(4)}
+
+// G2Infinity returns the point at infinity of G2
+func G2Infinity() *G2 {
+	return &G2{x: fp2Zero(), y: fp2Zero(), infinity: true}
+}
+
+// IsInfinity returns true for the point at infinity
+func (p *G2) IsInfinity() bool {
+	return p.infinity
+}
+
+// Equal returns true if p and q are the same point
+func (p *G2) Equal(q *G2) bool {
+	if p.infinity || q.infinity {
+		return p.infinity == q.infinity
+	}
+	return p.x.equal(q.x) && p.y.equal(q.y)
+}
+
+// IsOnCurve checks that p satisfies the curve equation
+func (p *G2) IsOnCurve() bool {
+	if p.infinity {
+		return true
+	}
+	for _, c := range []*big.Int{p.x.c0, p.x.c1, p.y.c0, p.y.c1} {
+		if c.Cmp(fieldModulus) >= 0 {
+			return false
+		}
+	}
+	return p.y.sqr().equal(p.x.sqr().mul(p.x).add(g2B))
+}
+
+// InSubgroup checks that p is on the curve and has order dividing the group order
+func (p *G2) InSubgroup() bool {
+	return p.IsOnCurve() && p.Mul(Order).infinity
+}
+
+// Neg returns -p
+func (p *G2) Neg() *G2 {
+	if p.infinity {
+		return G2Infinity()
+	}
+	return &G2{x: p.x, y: p.y.neg()}
+}
+
+// Add returns p + q
+func (p *G2) Add(q *G2) *G2 {
+	if p.infinity {
+		return q
+	}
+	if q.infinity {
+		return p
+	}
+	if p.x.equal(q.x) {
+		if p.y.equal(q.y) {
+			return p.double()
+		}
+		return G2Infinity()
+	}
+	return p.chord(p.addSlope(q), q.x)
+}
+
+func (p *G2) double() *G2 {
+	if p.infinity || p.y.isZero() {
+		return G2Infinity()
+	}
+	return p.chord(p.tangentSlope(), p.x)
+}
+
+// tangentSlope returns the slope of the tangent at p. Requires p.y != 0
+func (p *G2) tangentSlope() fp2 {
+	xx := p.x.sqr()
+	return xx.add(xx).add(xx).mul(p.y.add(p.y).inv())
+}
+
+// addSlope returns the slope of the line through p and q. Requires p.x != q.x
+func (p *G2) addSlope(q *G2) fp2 {
+	return q.y.sub(p.y).mul(q.x.sub(p.x).inv())
+}
+
+// chord returns the third intersection, negated, of the line through p with slope lambda and
+// the curve, with qx the x coordinate of the second point on the line
+func (p *G2) chord(lambda fp2, qx fp2) *G2 {
+	x := lambda.sqr().sub(p.x).sub(qx)
+	y := lambda.mul(p.x.sub(x)).sub(p.y)
+	return &G2{x: x, y: y}
+}
+
+// Mul returns k p for a non-negative integer k
+func (p *G2) Mul(k *big.Int) *G2 {
+	res := G2Infinity()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = res.double()
+		if k.Bit(i) == 1 {
+			res = res.Add(p)
+		}
+	}
+	return res
+}
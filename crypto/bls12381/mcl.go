@@ -0,0 +1,196 @@
+package bls12381
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Encodings and hash functions compatible with the mcl library, as used by the beacon with
+// default (decimal) io mode and the original map-to-curve mode
+
+var (
+	// g1Cofactor = (z - 1)^2 / 3
+	g1Cofactor, _ = new(big.Int).SetString("396c8c005555e1568c00aaab0000aaab", 16)
+
+	// Constants of the Fouque-Tibouchi map to G1
+	sqrtMinusThree = computeSqrtMinusThree()
+	// (-1 + sqrt(-3)) / 2
+	cubeRootOfUnity = fpMul(fpSub(sqrtMinusThree, bigOne), fpInv(big.NewInt(2)))
+)
+
+func computeSqrtMinusThree() *big.Int {
+	root, ok := fpSqrt(fpNeg(bigThree))
+	if !ok {
+		panic("bls12381: -3 is not a square")
+	}
+	return root
+}
+
+// G1FromString parses a G1 point written by mcl, either "0" for infinity or "1 x y" in decimal.
+// Returns an error if the point is not in G1
+func G1FromString(s string) (*G1, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 1 && fields[0] == "0" {
+		return G1Infinity(), nil
+	}
+	if len(fields) != 3 || fields[0] != "1" {
+		return nil, fmt.Errorf("invalid G1 point %q", s)
+	}
+	coords, err := parseFpElements(fields[1:])
+	if err != nil {
+		return nil, err
+	}
+	p := &G1{x: coords[0], y: coords[1]}
+	if !p.InSubgroup() {
+		return nil, fmt.Errorf("G1 point %q not in group", s)
+	}
+	return p, nil
+}
+
+// String returns p as written by mcl
+func (p *G1) String() string {
+	if p.infinity {
+		return "0"
+	}
+	return "1 " + p.x.Text(10) + " " + p.y.Text(10)
+}
+
+// G2FromString parses a G2 point written by mcl, either "0" for infinity or "1 x.a x.b y.a y.b"
+// in decimal, where x = x.a + x.b u. Returns an error if the point is not in G2
+func G2FromString(s string) (*G2, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 1 && fields[0] == "0" {
+		return G2Infinity(), nil
+	}
+	if len(fields) != 5 || fields[0] != "1" {
+		return nil, fmt.Errorf("invalid G2 point %q", s)
+	}
+	coords, err := parseFpElements(fields[1:])
+	if err != nil {
+		return nil, err
+	}
+	p := &G2{x: fp2{coords[0], coords[1]}, y: fp2{coords[2], coords[3]}}
+	if !p.InSubgroup() {
+		return nil, fmt.Errorf("G2 point %q not in group", s)
+	}
+	return p, nil
+}
+
+// String returns p as written by mcl
+func (p *G2) String() string {
+	if p.infinity {
+		return "0"
+	}
+	return strings.Join([]string{"1", p.x.c0.Text(10), p.x.c1.Text(10), p.y.c0.Text(10), p.y.c1.Text(10)}, " ")
+}
+
+// FrFromString parses an element of the scalar field written by mcl in decimal
+func FrFromString(s string) (*big.Int, error) {
+	return parseDecimal(s, Order)
+}
+
+func parseFpElements(fields []string) ([]*big.Int, error) {
+	elements := make([]*big.Int, len(fields))
+	for i, field := range fields {
+		element, err := parseDecimal(field, fieldModulus)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = element
+	}
+	return elements, nil
+}
+
+// parseDecimal parses an unsigned decimal integer less than modulus
+func parseDecimal(s string, modulus *big.Int) (*big.Int, error) {
+	if len(s) == 0 || strings.TrimLeft(s, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Cmp(modulus) >= 0 {
+		return nil, fmt.Errorf("decimal %q out of range", s)
+	}
+	return n, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// HashToG1 hashes msg to a point in G1 as mcl does with Fp::setHashOf followed by mapToG1
+func HashToG1(msg []byte) (*G1, error) {
+	digest := sha512.Sum512(msg)
+	t := setArrayMask(digest[:], fieldModulus)
+	p, ok := mapToCurveG1(t)
+	if !ok {
+		return nil, fmt.Errorf("could not map hash of message to G1")
+	}
+	return p.Mul(g1Cofactor), nil
+}
+
+// HashToFr hashes data to the scalar field as mcl does with Fr::setHashOf
+func HashToFr(data []byte) *big.Int {
+	digest := sha256.Sum256(data)
+	return setArrayMask(digest[:], Order)
+}
+
+// setArrayMask interprets buf as a little-endian integer, truncated to the byte size of the
+// modulus and masked to its bit length. If the result is not less than the modulus its top bit
+// is cleared as well
+func setArrayMask(buf []byte, modulus *big.Int) *big.Int {
+	byteSize := (modulus.BitLen() + 63) / 64 * 8
+	if len(buf) > byteSize {
+		buf = buf[:byteSize]
+	}
+	bigEndian := make([]byte, len(buf))
+	for i := range buf {
+		bigEndian[len(buf)-1-i] = buf[i]
+	}
+	n := new(big.Int).SetBytes(bigEndian)
+	mask(n, modulus.BitLen())
+	if n.Cmp(modulus) >= 0 {
+		mask(n, modulus.BitLen()-1)
+	}
+	return n
+}
+
+func mask(n *big.Int, bits int) {
+	for i := n.BitLen() - 1; i >= bits; i-- {
+		n.SetBit(n, i, 0)
+	}
+}
+
+// mapToCurveG1 maps t to a point on the curve, not necessarily in G1, with the map of
+// P.-A. Fouque and M. Tibouchi, "Indifferentiable hashing to Barreto Naehrig curves", 2012
+func mapToCurveG1(t *big.Int) (*G1, bool) {
+	if t.Sign() == 0 {
+		return nil, false
+	}
+	negative := big.Jacobi(t, fieldModulus) < 0
+
+	// w = sqrt(-3) t / (1 + b + t^2)
+	w := fpAdd(fpAdd(fpMul(t, t), g1B), bigOne)
+	if w.Sign() == 0 {
+		return nil, false
+	}
+	w = fpMul(fpMul(fpInv(w), sqrtMinusThree), t)
+
+	x := fpSub(cubeRootOfUnity, fpMul(t, w))
+	for i := 0; i < 3; i++ {
+		switch i {
+		case 1:
+			x = fpSub(fpNeg(x), bigOne)
+		case 2:
+			x = fpAdd(fpInv(fpMul(w, w)), bigOne)
+		}
+		y, ok := fpSqrt(g1Weierstrass(x))
+		if ok {
+			if negative {
+				y = fpNeg(y)
+			}
+			return &G1{x: x, y: y}, true
+		}
+	}
+	return nil, false
+}
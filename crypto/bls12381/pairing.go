@@ -0,0 +1,87 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+// curveParamAbs is |z| for the curve parameter z = -0xd201000000010000
+var curveParamAbs, _ = new(big.Int).SetString("d201000000010000", 16)
+
+// PairingCheck returns true if the product of the pairings e(g1s[i], g2s[i]) is one. Panics if
+// the slices differ in length
+func PairingCheck(g1s []*G1, g2s []*G2) bool {
+	if len(g1s) != len(g2s) {
+		panic("bls12381: PairingCheck with different numbers of G1 and G2 points")
+	}
+	f := fp12One()
+	for i := range g1s {
+		f = f.mul(millerLoop(g1s[i], g2s[i]))
+	}
+	return finalExponentiation(f).isOne()
+}
+
+// pairing computes the optimal ate pairing e(p, q)
+func pairing(p *G1, q *G2) fp12 {
+	return finalExponentiation(millerLoop(p, q))
+}
+
+// millerLoop evaluates at p the Miller function f_{|z|, q}, with q mapped into E(Fp12) by the
+// untwisting isomorphism (x, y) -> (x / w^2, y / w^3)
+func millerLoop(p *G1, q *G2) fp12 {
+	f := fp12One()
+	if p.infinity || q.infinity {
+		return f
+	}
+	t := q
+	for i := curveParamAbs.BitLen() - 2; i >= 0; i-- {
+		f = f.sqr().mul(lineFunction(t.tangentSlope(), t, p))
+		t = t.double()
+		if curveParamAbs.Bit(i) == 1 {
+			f = f.mul(lineFunction(t.addSlope(q), t, p))
+			t = t.Add(q)
+		}
+	}
+	// z is negative
+	return f.conj()
+}
+
+// lineFunction evaluates at p the line through t with slope lambda on the twist. The line
+// on E(Fp12) is
+//
+//	l(p) = y_p - y_t / w^3 - lambda (x_p - x_t / w^2) / w
+//
+// which is multiplied by w^3 to avoid inversions. w^3 lies in the subfield Fp4 so the factor is
+// removed by the final exponentiation, as are vertical lines
+func lineFunction(lambda fp2, t *G2, p *G1) fp12 {
+	return fp12{
+		c0: fp6{lambda.mul(t.x).sub(t.y), lambda.mulFp(p.x).neg(), fp2Zero()},
+		c1: fp6{fp2Zero(), fp2FromFp(p.y), fp2Zero()},
+	}
+}
+
+// finalExponentiation raises f to the power 3 (p^12 - 1) / r. Cubing keeps the pairing bilinear
+// and non-degenerate, as 3 does not divide r, and allows the hard part of the exponent to be
+// written in terms of z:
+//
+//	3 (p^4 - p^2 + 1) / r = (z - 1)^2 (z + p) (z^2 + p^2 - 1) + 3
+func finalExponentiation(f fp12) fp12 {
+	// Easy part, f^((p^6 - 1) (p^2 + 1)). The result is unitary so inverses are conjugates
+	f = f.conj().mul(f.inv())
+	f = f.frobenius().frobenius().mul(f)
+
+	// Hard part
+	a := f.expZMinusOne().expZMinusOne()
+	b := a.expZ().mul(a.frobenius())
+	c := b.expZ().expZ().mul(b.frobenius().frobenius()).mul(b.conj())
+	return c.mul(f.sqr()).mul(f)
+}
+
+// expZ returns a^z for unitary a
+func (a fp12) expZ() fp12 {
+	return a.exp(curveParamAbs).conj()
+}
+
+// expZMinusOne returns a^(z - 1) = a^-(|z| + 1) for unitary a
+func (a fp12) expZMinusOne() fp12 {
+	return a.exp(curveParamAbs).mul(a).conj()
+}
@@ -0,0 +1,140 @@
+// Package keyfile encrypts key files at rest with a key-encryption key.
+//
+// Encrypted files are ASCII armored. The symmetric key is derived from the
+// key-encryption key with scrypt, using a random salt stored in the armor
+// headers, and the file contents are sealed with xsalsa20symmetric.
+package keyfile
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	"github.com/tendermint/tendermint/libs/tempfile"
+)
+
+const (
+	blockType = "TENDERMINT ENCRYPTED KEY FILE"
+	kdfScrypt = "scrypt"
+
+	saltLen   = 16
+	secretLen = 32
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrNoKeyEncryptionKey is returned when reading an encrypted key file without
+// a key-encryption key
+var ErrNoKeyEncryptionKey = errors.New("key file is encrypted but no key-encryption key is set")
+
+var armorPrefix = []byte("-----BEGIN " + blockType + "-----")
+
+// LoadKeyEncryptionKey reads the key-encryption key from file, ignoring leading
+// and trailing whitespace. Returns nil if filePath is empty.
+func LoadKeyEncryptionKey(filePath string) ([]byte, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	keyBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading key-encryption key file")
+	}
+	keyBytes = bytes.TrimSpace(keyBytes)
+	if len(keyBytes) == 0 {
+		return nil, fmt.Errorf("key-encryption key file %v is empty", filePath)
+	}
+	return keyBytes, nil
+}
+
+// IsEncrypted returns true if data is an encrypted key file
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), armorPrefix)
+}
+
+// Encrypt encrypts the contents of a key file with keyEncryptionKey
+func Encrypt(plaintext []byte, keyEncryptionKey []byte) ([]byte, error) {
+	salt := crypto.CRandBytes(saltLen)
+	secret, err := deriveSecret(keyEncryptionKey, salt)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{
+		"kdf":  kdfScrypt,
+		"salt": fmt.Sprintf("%X", salt),
+	}
+	ciphertext := xsalsa20symmetric.EncryptSymmetric(plaintext, secret)
+	return []byte(armor.EncodeArmor(blockType, headers, ciphertext)), nil
+}
+
+// Decrypt decrypts a key file encrypted with keyEncryptionKey
+func Decrypt(data []byte, keyEncryptionKey []byte) ([]byte, error) {
+	bt, headers, ciphertext, err := armor.DecodeArmor(string(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding encrypted key file")
+	}
+	if bt != blockType {
+		return nil, fmt.Errorf("unrecognized armor type %q", bt)
+	}
+	if headers["kdf"] != kdfScrypt {
+		return nil, fmt.Errorf("unrecognized kdf %q", headers["kdf"])
+	}
+	salt, err := hex.DecodeString(headers["salt"])
+	if err != nil || len(salt) != saltLen {
+		return nil, errors.New("invalid salt in encrypted key file")
+	}
+	secret, err := deriveSecret(keyEncryptionKey, salt)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := xsalsa20symmetric.DecryptSymmetric(ciphertext, secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decrypting key file, wrong key-encryption key?")
+	}
+	return plaintext, nil
+}
+
+// ReadFile reads a key file, decrypting it with keyEncryptionKey if it is
+// encrypted. Files which are not encrypted are read as they are.
+func ReadFile(filePath string, keyEncryptionKey []byte) ([]byte, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if len(keyEncryptionKey) == 0 {
+		return nil, ErrNoKeyEncryptionKey
+	}
+	return Decrypt(data, keyEncryptionKey)
+}
+
+// WriteFile atomically writes a key file readable only by the owner, encrypted
+// with keyEncryptionKey unless it is empty
+func WriteFile(filePath string, data []byte, keyEncryptionKey []byte) error {
+	if len(keyEncryptionKey) != 0 {
+		var err error
+		data, err = Encrypt(data, keyEncryptionKey)
+		if err != nil {
+			return err
+		}
+	}
+	return tempfile.WriteFileAtomic(filePath, data, 0600)
+}
+
+func deriveSecret(keyEncryptionKey []byte, salt []byte) ([]byte, error) {
+	if len(keyEncryptionKey) == 0 {
+		return nil, errors.New("empty key-encryption key")
+	}
+	return scrypt.Key(keyEncryptionKey, salt, scryptN, scryptR, scryptP, secretLen)
+}
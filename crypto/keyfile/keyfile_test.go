@@ -0,0 +1,87 @@
+package keyfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	plaintext := []byte(`{"private_key": "secret"}`)
+	keyEncryptionKey := []byte("key-encryption-key")
+
+	encrypted, err := Encrypt(plaintext, keyEncryptionKey)
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.False(t, IsEncrypted(plaintext))
+	assert.NotContains(t, string(encrypted), "secret")
+
+	decrypted, err := Decrypt(encrypted, keyEncryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	_, err = Decrypt(encrypted, []byte("wrong-key"))
+	assert.Error(t, err)
+	_, err = Decrypt(encrypted, nil)
+	assert.Error(t, err)
+	_, err = Decrypt(plaintext, keyEncryptionKey)
+	assert.Error(t, err)
+}
+
+func TestReadWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyfile_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "key.json")
+	plaintext := []byte(`{"private_key": "secret"}`)
+	keyEncryptionKey := []byte("key-encryption-key")
+
+	// Plaintext files are read as they are, with or without key-encryption key
+	require.NoError(t, WriteFile(filePath, plaintext, nil))
+	data, err := ReadFile(filePath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, data)
+	data, err = ReadFile(filePath, keyEncryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, data)
+
+	require.NoError(t, WriteFile(filePath, plaintext, keyEncryptionKey))
+	info, err := os.Stat(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, err = ReadFile(filePath, nil)
+	assert.Equal(t, ErrNoKeyEncryptionKey, err)
+	data, err = ReadFile(filePath, keyEncryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, data)
+}
+
+func TestLoadKeyEncryptionKey(t *testing.T) {
+	keyEncryptionKey, err := LoadKeyEncryptionKey("")
+	require.NoError(t, err)
+	assert.Nil(t, keyEncryptionKey)
+
+	file, err := ioutil.TempFile("", "kek")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	// Empty key files are rejected
+	_, err = LoadKeyEncryptionKey(file.Name())
+	assert.Error(t, err)
+
+	_, err = file.WriteString("  key-encryption-key\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	keyEncryptionKey, err = LoadKeyEncryptionKey(file.Name())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key-encryption-key"), keyEncryptionKey)
+
+	_, err = LoadKeyEncryptionKey(filepath.Join(os.TempDir(), "does_not_exist"))
+	assert.Error(t, err)
+}
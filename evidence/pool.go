@@ -24,6 +24,9 @@ type Pool struct {
 	// needed to load validators to verify evidence
 	stateDB dbm.DB
 
+	// verifies the threshold cryptography in beacon evidence
+	beaconVerifier types.BeaconEvidenceVerifier
+
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
@@ -54,6 +57,12 @@ func (evpool *Pool) SetLogger(l log.Logger) {
 	evpool.logger = l
 }
 
+// SetBeaconEvidenceVerifier sets the verifier for beacon evidence. Beacon evidence is
+// rejected if no verifier is set.
+func (evpool *Pool) SetBeaconEvidenceVerifier(verifier types.BeaconEvidenceVerifier) {
+	evpool.beaconVerifier = verifier
+}
+
 // PriorityEvidence returns the priority evidence.
 func (evpool *Pool) PriorityEvidence() []types.Evidence {
 	return evpool.store.PriorityEvidence()
@@ -100,13 +109,13 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) (err error) {
 	// TODO: check if we already have evidence for this
 	// validator at this height so we dont get spammed
 
-	if err := sm.VerifyEvidence(evpool.stateDB, evpool.State(), evidence); err != nil {
+	if err := sm.VerifyEvidence(evpool.stateDB, evpool.State(), evidence, evpool.beaconVerifier); err != nil {
 		return err
 	}
 
 	// fetch the validator and return its voting power as its priority
 	// TODO: something better ?
-	valset, _ := sm.LoadValidators(evpool.stateDB, evidence.Height())
+	valset, _ := sm.LoadEvidenceValidators(evpool.stateDB, evidence)
 	_, val := valset.GetByAddress(evidence.Address())
 	priority := val.VotingPower
 
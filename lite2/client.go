@@ -89,6 +89,18 @@ func MaxRetryAttempts(max uint16) Option {
 	}
 }
 
+// EntropyVerification option configures the light client to also verify the
+// entropy in each new header: that it was generated by an aeon agreed by the
+// validators and chains from the previous entropy. verifier is used to check
+// group signatures and genesisEntropy is the entropy in the chain's genesis
+// file (if any). Providers must implement provider.AeonProvider.
+func EntropyVerification(verifier types.GroupSignatureVerifier, genesisEntropy types.ThresholdSignature) Option {
+	return func(c *Client) {
+		c.entropyVerifier = verifier
+		c.genesisEntropy = genesisEntropy
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // headers from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -101,6 +113,10 @@ type Client struct {
 	trustLevel       tmmath.Fraction
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 
+	// See EntropyVerification option
+	entropyVerifier types.GroupSignatureVerifier
+	genesisEntropy  types.ThresholdSignature
+
 	// Mutex for locking during changes of the lite clients providers
 	providerMutex sync.Mutex
 	// Primary provider of new headers.
@@ -217,6 +233,15 @@ func NewClientFromTrustedStore(
 		return nil, err
 	}
 
+	// Verify all providers can supply aeons if entropy is verified.
+	if c.entropyVerifier != nil {
+		for i, p := range append([]provider.Provider{primary}, witnesses...) {
+			if _, ok := p.(provider.AeonProvider); !ok {
+				return nil, errors.Errorf("provider #%d: %v can not provide aeons for entropy verification", i, p)
+			}
+		}
+	}
+
 	if err := c.restoreTrustedHeaderAndVals(); err != nil {
 		return nil, err
 	}
@@ -548,6 +573,30 @@ func (c *Client) verifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 	c.logger.Info("VerifyHeader", "height", newHeader.Height, "hash", hash2str(newHeader.Hash()),
 		"vals", hash2str(newVals.Hash()))
 
+	if err := c.verifyHeaderChain(newHeader, newVals, now); err != nil {
+		c.logger.Error("Can't verify", "err", err)
+		return err
+	}
+
+	// Verify the entropy, now the header itself is trusted
+	if c.entropyVerifier != nil {
+		if err := c.verifyEntropy(newHeader, now); err != nil {
+			c.logger.Error("Can't verify entropy", "err", err)
+			return err
+		}
+	}
+
+	if err := c.compareNewHeaderWithWitnesses(newHeader); err != nil {
+		c.logger.Error("Error when comparing new header with witnesses", "err", err)
+		return err
+	}
+
+	return c.updateTrustedHeaderAndVals(newHeader, newVals)
+}
+
+// verifyHeaderChain verifies newHeader against the trusted headers, without
+// verifying its entropy.
+func (c *Client) verifyHeaderChain(newHeader *types.SignedHeader, newVals *types.ValidatorSet, now time.Time) error {
 	var err error
 
 	// 1) If going forward, perform either bisection or sequential verification
@@ -571,17 +620,7 @@ func (c *Client) verifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 
 		err = c.backwards(closestHeader, newHeader, now)
 	}
-	if err != nil {
-		c.logger.Error("Can't verify", "err", err)
-		return err
-	}
-
-	if err := c.compareNewHeaderWithWitnesses(newHeader); err != nil {
-		c.logger.Error("Error when comparing new header with witnesses", "err", err)
-		return err
-	}
-
-	return c.updateTrustedHeaderAndVals(newHeader, newVals)
+	return err
 }
 
 // Primary returns the primary provider.
@@ -1025,6 +1064,97 @@ func (c *Client) validatorSetFromPrimary(height int64) (*types.ValidatorSet, err
 	return c.validatorSetFromPrimary(height)
 }
 
+// see EntropyVerification
+func (c *Client) verifyEntropy(h *types.SignedHeader, now time.Time) error {
+	if types.IsEmptyBlockEntropy(&h.Entropy) {
+		return nil
+	}
+
+	aeon, dryRuns, err := c.aeonFromPrimary(h.Height)
+	if err != nil {
+		return errors.Wrapf(err, "failed to obtain the aeon for #%d", h.Height)
+	}
+
+	// The validators which ran the dkg must be trusted
+	aeonVals, err := c.trustedStore.ValidatorSet(aeon.ValidatorHeight)
+	if err != nil {
+		if _, err := c.VerifyHeaderAtHeight(aeon.ValidatorHeight, now); err != nil {
+			return errors.Wrapf(err, "failed to verify the header at aeon validator height #%d", aeon.ValidatorHeight)
+		}
+		aeonVals, err = c.trustedStore.ValidatorSet(aeon.ValidatorHeight)
+		if err != nil {
+			return errors.Wrapf(err, "can't get trusted validators at #%d", aeon.ValidatorHeight)
+		}
+	}
+
+	previousEntropy, err := c.previousEntropy(h, now)
+	if err != nil {
+		return err
+	}
+
+	return VerifyEntropy(c.chainID, h, previousEntropy, aeon, dryRuns, aeonVals, c.entropyVerifier)
+}
+
+// previousEntropy returns the last non-empty entropy before the trusted header
+// h. Its height is provided by the primary and the header at the height, if not
+// in the trusted store, is verified without verifying its own entropy. A wrong
+// height from the primary yields entropy which the group signature in h does
+// not verify against.
+func (c *Client) previousEntropy(h *types.SignedHeader, now time.Time) (types.ThresholdSignature, error) {
+	height, err := c.previousEntropyHeightFromPrimary(h.Height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain the previous entropy height for #%d", h.Height)
+	}
+	if height <= types.GenesisHeight {
+		return c.genesisEntropy, nil
+	}
+	if height >= h.Height {
+		return nil, ErrInvalidEntropy{errors.Errorf("previous entropy height %d is not before #%d", height, h.Height)}
+	}
+
+	previousHeader, err := c.trustedStore.SignedHeader(height)
+	if err != nil {
+		var previousVals *types.ValidatorSet
+		previousHeader, previousVals, err = c.fetchHeaderAndValsAtHeight(height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain the header #%d", height)
+		}
+		if err := c.verifyHeaderChain(previousHeader, previousVals, now); err != nil {
+			return nil, errors.Wrapf(err, "failed to verify the header #%d", height)
+		}
+	}
+	if types.IsEmptyBlockEntropy(&previousHeader.Entropy) {
+		return nil, ErrInvalidEntropy{errors.Errorf("header #%d has no entropy", height)}
+	}
+	return previousHeader.Entropy.GroupSignature, nil
+}
+
+// previousEntropyHeightFromPrimary retrieves the height of the last header
+// with entropy before the specified height from the primary provider.
+func (c *Client) previousEntropyHeightFromPrimary(height int64) (int64, error) {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	aeonProvider, ok := c.primary.(provider.AeonProvider)
+	if !ok {
+		return 0, errors.Errorf("primary %v can not provide aeons", c.primary)
+	}
+	return aeonProvider.PreviousEntropyHeight(height)
+}
+
+// aeonFromPrimary retrieves the aeon which generated the entropy at the
+// specified height from the primary provider.
+func (c *Client) aeonFromPrimary(height int64) (*types.DKGOutput, []*types.DKGMessage, error) {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	aeonProvider, ok := c.primary.(provider.AeonProvider)
+	if !ok {
+		return nil, nil, errors.Errorf("primary %v can not provide aeons", c.primary)
+	}
+	return aeonProvider.Aeon(height)
+}
+
 // exponential backoff (with jitter)
 //		0.5s -> 2s -> 4.5s -> 8s -> 12.5 with 1s variation
 func backoffTimeout(attempt uint16) time.Duration {
@@ -904,3 +904,71 @@ func TestClientTrustedValidatorSet(t *testing.T) {
 	assert.NotNil(t, valSet)
 	assert.EqualValues(t, 2, height)
 }
+
+// previousEntropyHeightProvider reports a fixed height for the previous entropy
+type previousEntropyHeightProvider struct {
+	provider.Provider
+	height int64
+}
+
+func (p previousEntropyHeightProvider) Aeon(height int64) (*types.DKGOutput, []*types.DKGMessage, error) {
+	return p.Provider.(provider.AeonProvider).Aeon(height)
+}
+
+func (p previousEntropyHeightProvider) PreviousEntropyHeight(height int64) (int64, error) {
+	return p.height, nil
+}
+
+func TestClient_EntropyVerification(t *testing.T) {
+	var (
+		genesisEntropy = []byte("genesis_entropy")
+		aeon           = genAeon(vals, 1, 1, 10)
+		dryRuns        = keys.signDryRuns(chainID, 1, aeon, 0, len(keys))
+		entropy2       = mockEntropy(genesisEntropy, 1, 9, 1)
+		eh1            = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		eh2 = keys.GenSignedHeaderWithEntropy(chainID, 2, bTime.Add(30*time.Minute), vals, vals,
+			entropy2, aeon, types.BlockID{Hash: eh1.Hash()}, 0, len(keys))
+		eh3 = keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
+			types.BlockID{Hash: eh2.Hash()})
+		eh4 = keys.GenSignedHeaderWithEntropy(chainID, 4, bTime.Add(90*time.Minute), vals, vals,
+			mockEntropy(entropy2.GroupSignature, 3, 9, 1), aeon, types.BlockID{Hash: eh3.Hash()}, 0, len(keys))
+		node = mockp.NewWithAeons(chainID, map[int64]*types.SignedHeader{1: eh1, 2: eh2, 3: eh3, 4: eh4}, valSet,
+			map[int64]*types.DKGOutput{1: aeon}, map[int64][]*types.DKGMessage{1: dryRuns})
+	)
+
+	testCases := []struct {
+		name      string
+		primary   provider.Provider
+		verifyErr bool
+	}{
+		{"good: previous entropy header is verified", node, false},
+		{"bad: previous entropy height without entropy", previousEntropyHeightProvider{node, 3}, true},
+		{"bad: previous entropy height too low", previousEntropyHeightProvider{node, 0}, true},
+		{"bad: previous entropy height not before header", previousEntropyHeightProvider{node, 4}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewClient(
+				chainID,
+				TrustOptions{Period: trustPeriod, Height: 1, Hash: eh1.Hash()},
+				tc.primary,
+				[]provider.Provider{node},
+				dbs.New(dbm.NewMemDB(), chainID),
+				SkippingVerification(DefaultTrustLevel),
+				EntropyVerification(mockEntropyVerifier{}, genesisEntropy),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyHeaderAtHeight(4, bTime.Add(2*time.Hour))
+			if tc.verifyErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
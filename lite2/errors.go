@@ -29,6 +29,16 @@ func (e ErrNewValSetCantBeTrusted) Error() string {
 	return fmt.Sprintf("cant trust new val set: %v", e.Reason)
 }
 
+// ErrInvalidEntropy means the entropy in the header was not generated by an
+// aeon agreed by the validators, or does not follow from the previous entropy.
+type ErrInvalidEntropy struct {
+	Reason error
+}
+
+func (e ErrInvalidEntropy) Error() string {
+	return fmt.Sprintf("invalid entropy: %v", e.Reason)
+}
+
 // ErrInvalidHeader means the header either failed the basic validation or
 // commit is not signed by 2/3+.
 type ErrInvalidHeader struct {
@@ -9,4 +9,7 @@ var (
 	// ErrValidatorSetNotFound is returned when a provider can't find the
 	// requested validator set.
 	ErrValidatorSetNotFound = errors.New("validator set not found")
+	// ErrAeonNotFound is returned when a provider can't find the requested
+	// aeon.
+	ErrAeonNotFound = errors.New("aeon not found")
 )
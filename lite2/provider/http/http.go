@@ -119,6 +119,54 @@ func (p *http) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	return types.NewValidatorSet(vals), nil
 }
 
+// Aeon fetches the public info of the aeon which generated the entropy at the
+// given height. Requires the client to be a rpcclient.BeaconClient.
+func (p *http) Aeon(height int64) (*types.DKGOutput, []*types.DKGMessage, error) {
+	h, err := validateHeight(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	beaconClient, ok := p.client.(rpcclient.BeaconClient)
+	if !ok {
+		return nil, nil, errors.New("client does not support beacon queries")
+	}
+	res, err := beaconClient.Aeon(h)
+	if err != nil {
+		// TODO: standartise errors on the RPC side
+		if strings.Contains(err.Error(), "no aeon found") {
+			return nil, nil, provider.ErrAeonNotFound
+		}
+		return nil, nil, err
+	}
+
+	return &res.Aeon, res.DryRuns, nil
+}
+
+// PreviousEntropyHeight fetches the height of the last header with entropy
+// before the given height. Requires the client to be a rpcclient.BeaconClient.
+func (p *http) PreviousEntropyHeight(height int64) (int64, error) {
+	h, err := validateHeight(height)
+	if err != nil {
+		return 0, err
+	}
+
+	beaconClient, ok := p.client.(rpcclient.BeaconClient)
+	if !ok {
+		return 0, errors.New("client does not support beacon queries")
+	}
+	res, err := beaconClient.Entropy(h)
+	if err != nil {
+		// TODO: standartise errors on the RPC side
+		if strings.Contains(err.Error(), "height must be less than or equal") {
+			return 0, provider.ErrSignedHeaderNotFound
+		}
+		return 0, err
+	}
+
+	return res.PreviousEntropyHeight, nil
+}
+
 func validateHeight(height int64) (*int64, error) {
 	if height < 0 {
 		return nil, fmt.Errorf("expected height >= 0, got height %d", height)
@@ -12,6 +12,8 @@ type mock struct {
 	chainID string
 	headers map[int64]*types.SignedHeader
 	vals    map[int64]*types.ValidatorSet
+	aeons   map[int64]*types.DKGOutput    // keyed by dkg id
+	dryRuns map[int64][]*types.DKGMessage // keyed by dkg id
 }
 
 // New creates a mock provider with the given set of headers and validator
@@ -24,6 +26,19 @@ func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*
 	}
 }
 
+// NewWithAeons creates a mock provider which can also supply aeons, and their
+// dry runs, keyed by dkg id.
+func NewWithAeons(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*types.ValidatorSet,
+	aeons map[int64]*types.DKGOutput, dryRuns map[int64][]*types.DKGMessage) provider.Provider {
+	return &mock{
+		chainID: chainID,
+		headers: headers,
+		vals:    vals,
+		aeons:   aeons,
+		dryRuns: dryRuns,
+	}
+}
+
 // ChainID returns the blockchain ID.
 func (p *mock) ChainID() string {
 	return p.chainID
@@ -62,3 +77,27 @@ func (p *mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	}
 	return nil, provider.ErrValidatorSetNotFound
 }
+
+func (p *mock) Aeon(height int64) (*types.DKGOutput, []*types.DKGMessage, error) {
+	h, err := p.SignedHeader(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	if aeon, ok := p.aeons[h.Entropy.DKGID]; ok && !types.IsEmptyBlockEntropy(&h.Entropy) {
+		return aeon, p.dryRuns[h.Entropy.DKGID], nil
+	}
+	return nil, nil, provider.ErrAeonNotFound
+}
+
+func (p *mock) PreviousEntropyHeight(height int64) (int64, error) {
+	h, err := p.SignedHeader(height)
+	if err != nil {
+		return 0, err
+	}
+	for previous := h.Height - 1; previous > types.GenesisHeight; previous-- {
+		if header, ok := p.headers[previous]; ok && !types.IsEmptyBlockEntropy(&header.Entropy) {
+			return previous, nil
+		}
+	}
+	return types.GenesisHeight, nil
+}
@@ -33,3 +33,23 @@ type Provider interface {
 	// error is returned.
 	ValidatorSet(height int64) (*types.ValidatorSet, error)
 }
+
+// AeonProvider is implemented by providers which can supply the public
+// information of aeons, needed to verify the entropy in headers.
+type AeonProvider interface {
+	// Aeon returns the public information of the aeon which generated the
+	// entropy at the given height, together with the signed dry run messages in
+	// which validators agreed to it.
+	//
+	// If there's no aeon for the given height, ErrAeonNotFound error is
+	// returned.
+	Aeon(height int64) (*types.DKGOutput, []*types.DKGMessage, error)
+
+	// PreviousEntropyHeight returns the height of the last header before the
+	// given height with non-empty entropy, the group signature of which the
+	// entropy at height signs. 0 if there is no such header.
+	//
+	// If there's no SignedHeader for the given height, ErrSignedHeaderNotFound
+	// error is returned.
+	PreviousEntropyHeight(height int64) (int64, error)
+}
@@ -159,6 +159,22 @@ func (c *Client) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams,
 	return c.next.ConsensusParams(height)
 }
 
+func (c *Client) Entropy(height *int64) (*ctypes.ResultEntropy, error) {
+	return c.next.Entropy(height)
+}
+
+func (c *Client) EntropyShares(height *int64) (*ctypes.ResultEntropyShares, error) {
+	return c.next.EntropyShares(height)
+}
+
+func (c *Client) Aeon(height *int64) (*ctypes.ResultAeon, error) {
+	return c.next.Aeon(height)
+}
+
+func (c *Client) DKGStatus() (*ctypes.ResultDKGStatus, error) {
+	return c.next.DKGStatus()
+}
+
 func (c *Client) Health() (*ctypes.ResultHealth, error) {
 	return c.next.Health()
 }
@@ -1,6 +1,7 @@
 package lite
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -160,3 +161,75 @@ func (pkz privKeys) GenSignedHeaderLastBlockID(chainID string, height int64, bTi
 		Commit: pkz.signHeader(header, first, last),
 	}
 }
+
+// genAeon produces the public info of an aeon whose dkg was run by valset.
+func genAeon(valset *types.ValidatorSet, validatorHeight, start, end int64) *types.DKGOutput {
+	aeon := &types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		Generator:       "generator",
+		ValidatorHeight: validatorHeight,
+		Start:           start,
+		End:             end,
+	}
+	for i := 0; i < valset.Size(); i++ {
+		aeon.PublicKeyShares = append(aeon.PublicKeyShares, fmt.Sprintf("public_key_share_%d", i))
+		aeon.Qual = append(aeon.Qual, uint(i))
+	}
+	return aeon
+}
+
+// signDryRuns produces dry run messages agreeing to aeon, signed by all keys
+// from first to last exclusive.
+func (pkz privKeys) signDryRuns(chainID string, dkgID int64, aeon *types.DKGOutput,
+	first, last int) []*types.DKGMessage {
+
+	dryRun := types.DryRunSignature{PublicInfo: *aeon, SignatureShare: "signature_share"}
+	data := string(types.GetCodec().MustMarshalBinaryBare(&dryRun))
+
+	msgs := make([]*types.DKGMessage, 0, last-first)
+	for i := first; i < last && i < len(pkz); i++ {
+		msg := &types.DKGMessage{
+			Type:        types.DKGDryRun,
+			FromAddress: pkz[i].PubKey().Address(),
+			DKGID:       dkgID,
+			Data:        data,
+		}
+		sig, err := pkz[i].Sign(msg.SignBytes(chainID))
+		if err != nil {
+			panic(err)
+		}
+		msg.Signature = sig
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// GenSignedHeaderWithEntropy calls genHeader, adds entropy generated by aeon and signs the header.
+func (pkz privKeys) GenSignedHeaderWithEntropy(chainID string, height int64, bTime time.Time,
+	valset, nextValset *types.ValidatorSet, entropy *types.BlockEntropy, aeon *types.DKGOutput,
+	lastBlockID types.BlockID, first, last int) *types.SignedHeader {
+
+	header := genHeader(chainID, height, bTime, nil, valset, nextValset,
+		[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"))
+	header.LastBlockID = lastBlockID
+	header.Entropy = *entropy
+	header.AeonHash = aeon.Hash()
+	return &types.SignedHeader{
+		Header: header,
+		Commit: pkz.signHeader(header, first, last),
+	}
+}
+
+// mockEntropyVerifier accepts group signatures equal to the message signed.
+type mockEntropyVerifier struct{}
+
+func (mockEntropyVerifier) VerifyGroupSignature(aeon *types.DKGOutput, message string,
+	signature types.ThresholdSignature) bool {
+	return message == string(signature)
+}
+
+// mockEntropy produces entropy accepted by mockEntropyVerifier.
+func mockEntropy(previousEntropy types.ThresholdSignature, round, aeonLength,
+	dkgID int64) *types.BlockEntropy {
+	return types.NewBlockEntropy([]byte(types.EntropyMessage(previousEntropy)), round, aeonLength, dkgID)
+}
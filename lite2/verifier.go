@@ -183,6 +183,50 @@ func verifyNewHeaderAndVals(
 	return nil
 }
 
+// VerifyEntropy verifies the entropy in trustedHeader. It ensures that:
+//
+//  a) aeon is the one committed to by hash in trustedHeader
+//  b) aeon was agreed by the validator set aeonVals, which must be the trusted
+//  validator set at aeon.ValidatorHeight, in signed dry run messages for the
+//  dkg which generated the entropy
+//  c) the entropy is a valid group signature of aeon on the hash of
+//  previousEntropy, which is the last non-empty entropy before trustedHeader
+//
+// For any of these cases ErrInvalidEntropy is returned. Empty entropy is
+// always valid.
+func VerifyEntropy(
+	chainID string,
+	trustedHeader *types.SignedHeader,
+	previousEntropy types.ThresholdSignature,
+	aeon *types.DKGOutput,
+	dryRuns []*types.DKGMessage,
+	aeonVals *types.ValidatorSet,
+	verifier types.GroupSignatureVerifier) error {
+
+	if types.IsEmptyBlockEntropy(&trustedHeader.Entropy) {
+		return nil
+	}
+
+	if err := aeon.ValidateBasic(); err != nil {
+		return ErrInvalidEntropy{errors.Wrap(err, "invalid aeon")}
+	}
+
+	if !bytes.Equal(trustedHeader.AeonHash, aeon.Hash()) {
+		return ErrInvalidEntropy{errors.Errorf("expected aeon hash %X, got %X", trustedHeader.AeonHash, aeon.Hash())}
+	}
+
+	if err := aeonVals.VerifyAeonDryRuns(chainID, trustedHeader.Entropy.DKGID, aeon, dryRuns); err != nil {
+		return ErrInvalidEntropy{err}
+	}
+
+	if err := types.VerifyBlockEntropy(verifier, aeon, trustedHeader.Height, &trustedHeader.Entropy,
+		previousEntropy); err != nil {
+		return ErrInvalidEntropy{err}
+	}
+
+	return nil
+}
+
 // ValidateTrustLevel checks that trustLevel is within the allowed range [1/3,
 // 1]. If not, it returns an error. 1/3 is the minimum amount of trust needed
 // which does not break the security model.
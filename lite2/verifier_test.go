@@ -330,3 +330,74 @@ func TestValidateTrustLevel(t *testing.T) {
 		}
 	}
 }
+
+func TestVerifyEntropy(t *testing.T) {
+	const (
+		chainID = "TestVerifyEntropy"
+		dkgID   = 1
+		height  = 12
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		aeon     = genAeon(vals, dkgID, 10, 19)
+		dryRuns  = keys.signDryRuns(chainID, dkgID, aeon, 0, len(keys))
+		previous = []byte("previous_entropy")
+		header   = keys.GenSignedHeaderWithEntropy(chainID, height, bTime, vals, vals,
+			mockEntropy(previous, 2, 9, dkgID), aeon, types.BlockID{}, 0, len(keys))
+		otherAeon = genAeon(vals, dkgID, 10, 20)
+	)
+
+	testCases := []struct {
+		header          *types.SignedHeader
+		previousEntropy types.ThresholdSignature
+		dryRuns         []*types.DKGMessage
+		aeonVals        *types.ValidatorSet
+		expErr          bool
+	}{
+		// valid entropy -> no error
+		0: {header, previous, dryRuns, vals, false},
+		// empty entropy -> no error
+		1: {keys.GenSignedHeader(chainID, height, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			previous, nil, vals, false},
+		// wrong previous entropy -> error
+		2: {header, []byte("other_entropy"), dryRuns, vals, true},
+		// not enough dry runs -> error
+		3: {header, previous, dryRuns[2:], vals, true},
+		// dry runs agreeing to a different aeon -> error
+		4: {header, previous, keys.signDryRuns(chainID, dkgID, otherAeon, 0, len(keys)), vals, true},
+		// dry runs for a different dkg -> error
+		5: {header, previous, keys.signDryRuns(chainID, dkgID+1, aeon, 0, len(keys)), vals, true},
+		// dry runs not from the aeon validators -> error
+		6: {header, previous, dryRuns, genPrivKeys(4).ToValidators(20, 10), true},
+		// wrong round -> error
+		7: {keys.GenSignedHeaderWithEntropy(chainID, height, bTime, vals, vals,
+			mockEntropy(previous, 3, 9, dkgID), aeon, types.BlockID{}, 0, len(keys)),
+			previous, dryRuns, vals, true},
+		// height outside aeon -> error
+		8: {keys.GenSignedHeaderWithEntropy(chainID, 20, bTime, vals, vals,
+			mockEntropy(previous, 10, 9, dkgID), aeon, types.BlockID{}, 0, len(keys)),
+			previous, dryRuns, vals, true},
+		// header commits to a different aeon -> error
+		9: {keys.GenSignedHeaderWithEntropy(chainID, height, bTime, vals, vals,
+			mockEntropy(previous, 2, 9, dkgID), otherAeon, types.BlockID{}, 0, len(keys)),
+			previous, dryRuns, vals, true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			err := VerifyEntropy(chainID, tc.header, tc.previousEntropy, aeon, tc.dryRuns, tc.aeonVals,
+				mockEntropyVerifier{})
+			if tc.expErr {
+				assert.Error(t, err)
+				assert.IsType(t, ErrInvalidEntropy{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
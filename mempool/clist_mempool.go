@@ -50,6 +50,7 @@ type CListMempool struct {
 	txs          *clist.CList // concurrent linked-list of good txs
 	preCheck     PreCheckFunc
 	postCheck    PostCheckFunc
+	specialTxs   *tx_extensions.Registry // determines which txs are priority
 
 	// Map of peerID to location in the linked list they have broadcast to
 	peerPointers map[uint16]peerPointer
@@ -108,6 +109,7 @@ func NewCListMempool(
 		recheckEnd:    nil,
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
+		specialTxs:    tx_extensions.NewDefaultRegistry(nil),
 	}
 	if config.CacheSize > 0 {
 		mempool.cache = newMapTxCache(config.CacheSize)
@@ -147,6 +149,12 @@ func WithPostCheck(f PostCheckFunc) CListMempoolOption {
 	return func(mem *CListMempool) { mem.postCheck = f }
 }
 
+// WithSpecialTxRegistry sets the special tx types, which determine the priority
+// txs. Defaults to the DKG.
+func WithSpecialTxRegistry(specialTxs *tx_extensions.Registry) CListMempoolOption {
+	return func(mem *CListMempool) { mem.specialTxs = specialTxs }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
@@ -350,14 +358,14 @@ func (mem *CListMempool) reqResCb(
 	}
 }
 
-func isPriority(tx types.Tx) bool {
-	return tx_extensions.IsDKGRelated(tx)
+func (mem *CListMempool) isPriority(tx types.Tx) bool {
+	return mem.specialTxs.IsPriority(tx)
 }
 
 // Called from:
 //  - resCbFirstTime (lock not held) if tx is valid
 func (mem *CListMempool) addTx(memTx *mempoolTx) {
-	if isPriority(memTx.tx) {
+	if mem.isPriority(memTx.tx) {
 		e := mem.txs.PushFront(memTx)
 		mem.txsMap.Store(txKey(memTx.tx), e)
 	} else {
@@ -600,7 +608,7 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64, fallbackMode
 
 		// Since we know all priority txs should be at the front, we can
 		// stop reaping once we find one that is not, when in fallback mode
-		if fallbackMode && !isPriority(memTx.tx) {
+		if fallbackMode && !mem.isPriority(memTx.tx) {
 			return txs
 		}
 
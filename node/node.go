@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/beacon"
+	"github.com/tendermint/tendermint/beacon/verifier"
+	"github.com/tendermint/tendermint/behaviour"
 	"github.com/tendermint/tendermint/tx_extensions"
 
 	"github.com/pkg/errors"
@@ -27,6 +29,7 @@ import (
 	"github.com/tendermint/tendermint/consensus"
 	cs "github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	"github.com/tendermint/tendermint/evidence"
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
@@ -34,6 +37,7 @@ import (
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -49,7 +53,6 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 	"github.com/tendermint/tendermint/version"
 	dbm "github.com/tendermint/tm-db"
-	tmnoise "github.com/tendermint/tendermint/noise"
 	tmos "github.com/tendermint/tendermint/libs/os"
 )
 
@@ -206,9 +209,11 @@ type Node struct {
 	txIndexer          txindex.TxIndexer
 	indexerService     *txindex.IndexerService
 	prometheusSrv      *http.Server
-	beaconReactor      *beacon.Reactor // reactor for signature shares
+	beaconReactor      *beacon.Reactor    // reactor for signature shares
+	trustMetricStore   *trust.MetricStore // trust of peers in the beacon
 	dkgRunner          *beacon.DKGRunner
 	entropyGenerator   *beacon.EntropyGenerator
+	entropyStore       *beacon.EntropyStore
 	specialTxHandler   *tx_extensions.SpecialTxHandler
 	nativeLogCollector *beacon.NativeLoggingCollector
 }
@@ -229,9 +234,10 @@ func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.Block
 	return
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, specialTxHandler *tx_extensions.SpecialTxHandler) (proxy.AppConns, error) {
+func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger,
+	specialTxs *tx_extensions.Registry) (proxy.AppConns, error) {
 	proxyApp := proxy.NewAppConns(clientCreator)
-	proxyApp.SetSpecialTxHandler(specialTxHandler)
+	proxyApp.SetSpecialTxRegistry(specialTxs)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -284,12 +290,16 @@ func doHandshake(
 	blockStore sm.BlockStore,
 	genDoc *types.GenesisDoc,
 	eventBus types.BlockEventPublisher,
+	entropyStore *beacon.EntropyStore,
 	proxyApp proxy.AppConns,
 	consensusLogger log.Logger) error {
 
 	handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
+	if entropyStore != nil {
+		handshaker.SetEntropyStore(entropyStore)
+	}
 	if err := handshaker.Handshake(proxyApp); err != nil {
 		return fmt.Errorf("error during handshake: %v", err)
 	}
@@ -330,7 +340,8 @@ func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
 }
 
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, mempl.Mempool) {
+	state sm.State, specialTxs *tx_extensions.Registry, memplMetrics *mempl.Metrics,
+	logger log.Logger) (*mempl.Reactor, mempl.Mempool) {
 
 	mempool := mempl.NewCListMempool(
 		config.Mempool,
@@ -339,6 +350,7 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 		mempl.WithMetrics(memplMetrics),
 		mempl.WithPreCheck(sm.TxPreCheck(state)),
 		mempl.WithPostCheck(sm.TxPostCheck(state)),
+		mempl.WithSpecialTxRegistry(specialTxs),
 	)
 
 	mempoolLogger := logger.With("module", "mempool")
@@ -368,6 +380,18 @@ func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
 	return evidenceReactor, evidencePool, nil
 }
 
+func createTrustMetricStore(config *cfg.Config, dbProvider DBProvider,
+	logger log.Logger) (*trust.MetricStore, error) {
+
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(logger.With("module", "trust"))
+	return trustMetricStore, nil
+}
+
 func createBlockchainReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
@@ -395,6 +419,7 @@ func createConsensusReactor(config *cfg.Config,
 	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
+	specialTxs *tx_extensions.Registry,
 	csMetrics *cs.Metrics,
 	fastSync bool,
 	eventBus *types.EventBus,
@@ -409,6 +434,7 @@ func createConsensusReactor(config *cfg.Config,
 		evidencePool,
 		cs.StateMetrics(csMetrics),
 		cs.StrictTxFiltering(config.Beacon.StrictTxFiltering),
+		cs.SpecialTxRegistry(specialTxs),
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
@@ -575,11 +601,9 @@ func createBeaconReactor(
 	state sm.State,
 	privValidator types.PrivValidator,
 	beaconLogger log.Logger, fastSync bool,
-	blockStore sm.BlockStore,
 	dkgRunner *beacon.DKGRunner,
 	db dbm.DB) (chan types.ChannelEntropy, *beacon.EntropyGenerator, *beacon.Reactor, error) {
 
-	beacon.InitialiseMcl()
 	entropyGenerator := beacon.NewEntropyGenerator(&config.BaseConfig, config.Beacon, state.LastBlockHeight)
 	entropyChannel := make(chan types.ChannelEntropy, config.Beacon.EntropyChannelCapacity)
 	entropyGenerator.SetLogger(beaconLogger)
@@ -587,6 +611,14 @@ func createBeaconReactor(
 	if dkgRunner != nil {
 		dkgRunner.SetDKGCompletionCallback(entropyGenerator.SetNextAeonDetails)
 	}
+	keyEncryptionKey, err := keyfile.LoadKeyEncryptionKey(config.KeyEncryptionKeyFile())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	entropyGenerator.SetKeyEncryptionKey(keyEncryptionKey)
+	if config.Beacon.SignatureVerifier == "go" {
+		entropyGenerator.SetSignatureVerifier(verifier.NewAeonVerifier())
+	}
 
 	// There are three files for old entropy/keys, current entropy, and next entropy from the previous state.
 	// Load in the old entropy to generate forward from to avoid loading in a file that is higher than
@@ -599,7 +631,7 @@ func createBeaconReactor(
 	for _, fileToLoad := range keyFiles {
 		if tmos.FileExists(fileToLoad) {
 			// Load the aeon(s) from file
-			if aeonFiles, err := beacon.LoadAeonDetailsFiles(fileToLoad); err == nil {
+			if aeonFiles, err := beacon.LoadAeonDetailsFiles(fileToLoad, keyEncryptionKey); err == nil {
 				for _, aeonFile := range aeonFiles {
 
 					// If the aeon has keys in it, load the validators (don't otherwise as
@@ -629,11 +661,14 @@ func createBeaconReactor(
 	}
 
 	if len(state.LastComputedEntropy) != 0 {
-		entropyGenerator.SetLastComputedEntropy(state.LastBlockHeight, state.LastComputedEntropy)
+		entropyGenerator.SetLastComputedEntropy(state.LastComputedEntropyHeight, state.LastComputedEntropy)
 	}
 
-	reactor := beacon.NewReactor(entropyGenerator, fastSync, blockStore)
+	reactor := beacon.NewReactor(entropyGenerator, fastSync)
 	reactor.SetLogger(beaconLogger)
+	if dkgRunner != nil {
+		reactor.SetDKGRunner(dkgRunner)
+	}
 
 	return entropyChannel, entropyGenerator, reactor, nil
 }
@@ -646,12 +681,13 @@ func createDKGRunner(
 	db dbm.DB,
 	handler tx_extensions.MessageHandler) (*beacon.DKGRunner, error) {
 
-	noiseKeys, err := tmnoise.LoadOrGenNoiseKeys(config)
+	keyEncryptionKey, err := keyfile.LoadKeyEncryptionKey(config.KeyEncryptionKeyFile())
 	if err != nil {
 		return nil, err
 	}
-	dkgRunner := beacon.NewDKGRunner(config.Beacon, config.ChainID(), db, privValidator, noiseKeys, state.LastBlockHeight)
+	dkgRunner := beacon.NewDKGRunner(config.Beacon, config.ChainID(), db, privValidator, state.LastBlockHeight)
 	dkgRunner.SetLogger(logger.With("module", "dkgRunner"))
+	dkgRunner.SetDKGCheckpointFile(config.DKGCheckpointFile(), keyEncryptionKey)
 	dkgRunner.AttachMessageHandler(handler)
 	return dkgRunner, nil
 }
@@ -677,10 +713,12 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	// Special txs are checked and delivered by the node instead of the app
 	specialTxHandler := tx_extensions.NewSpecialTxHandler(logger)
+	specialTxs := tx_extensions.NewDefaultRegistry(specialTxHandler)
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, specialTxHandler)
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, specialTxs)
 	if err != nil {
 		return nil, err
 	}
@@ -700,10 +738,22 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	// The entropy of committed blocks is saved in the entropy store when running the beacon, for
+	// recovery after a restart, rpc queries and catching up peers
+	var entropyStore *beacon.EntropyStore
+	if config.Beacon.RunDKG {
+		entropyDB, err := dbProvider(&DBContext{"entropy", config})
+		if err != nil {
+			return nil, err
+		}
+		entropyStore = beacon.NewEntropyStore(entropyDB, config.Beacon.EntropyRetainBlocks)
+	}
+
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync tendermint with the app.
 	consensusLogger := logger.With("module", "consensus")
-	if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger); err != nil {
+	if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, entropyStore, proxyApp,
+		consensusLogger); err != nil {
 		return nil, err
 	}
 
@@ -737,7 +787,8 @@ func NewNode(config *cfg.Config,
 	csMetrics, p2pMetrics, memplMetrics, smMetrics, drbMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, specialTxs, memplMetrics,
+		logger)
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, logger)
@@ -745,14 +796,33 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	// Verify block entropy and beacon evidence against the aeons committed on chain. All full nodes
+	// verify, whether or not they run the dkg
+	beacon.InitialiseMcl()
+	entropyVerifier := beacon.NewEntropyVerifier()
+	var signatureVerifier types.AeonSignatureVerifier = entropyVerifier
+	if config.Beacon.SignatureVerifier == "go" {
+		signatureVerifier = verifier.NewAeonVerifier()
+	}
+	evidencePool.SetBeaconEvidenceVerifier(entropyVerifier)
+
 	// make block executor for consensus and blockchain reactors to execute blocks
+	blockExecOptions := []sm.BlockExecutorOption{
+		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithEntropyVerifier(signatureVerifier),
+		sm.BlockExecutorWithEvidenceVerifier(entropyVerifier),
+		sm.BlockExecutorWithSpecialTxRegistry(specialTxs),
+	}
+	if entropyStore != nil {
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithEntropyStore(entropyStore))
+	}
 	blockExec := sm.NewBlockExecutor(
 		stateDB,
 		logger.With("module", "state"),
 		proxyApp.Consensus(),
 		mempool,
 		evidencePool,
-		sm.BlockExecutorWithMetrics(smMetrics),
+		blockExecOptions...,
 	)
 
 	// Make BlockchainReactor
@@ -764,7 +834,7 @@ func NewNode(config *cfg.Config,
 	// Make ConsensusReactor
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, fastSync, eventBus, consensusLogger,
+		privValidator, specialTxs, csMetrics, fastSync, eventBus, consensusLogger,
 	)
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
@@ -775,6 +845,27 @@ func NewNode(config *cfg.Config,
 	// Setup Transport.
 	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
 
+	var trustMetricStore *trust.MetricStore
+	if config.Beacon.RunDKG {
+		trustMetricStore, err = createTrustMetricStore(config, dbProvider, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create trust metric store")
+		}
+
+		// Refuse peers which misbehaved in the beacon until their trust recovers
+		peerFilters = append(
+			peerFilters,
+			func(_ p2p.IPeerSet, p p2p.Peer) error {
+				score := trustMetricStore.GetPeerTrustMetric(string(p.ID())).TrustScore()
+				if score < config.Beacon.PeerTrustThreshold {
+					return fmt.Errorf("peer trust score %v below threshold %v", score,
+						config.Beacon.PeerTrustThreshold)
+				}
+				return nil
+			},
+		)
+	}
+
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
@@ -797,18 +888,45 @@ func NewNode(config *cfg.Config,
 
 		// Make BeaconReactor
 		beaconLogger := logger.With("module", "beacon")
-		entropyChannel, entropyGenerator, beaconReactor, err := createBeaconReactor(config, state, privValidator,
-			beaconLogger, fastSync, blockStore, dkgRunner, stateDB)
+		var entropyChannel chan types.ChannelEntropy
+		entropyChannel, entropyGenerator, beaconReactor, err = createBeaconReactor(config, state, privValidator,
+			beaconLogger, fastSync, dkgRunner, stateDB)
 
 		if err != nil {
 			return nil, errors.Wrap(err, "could not load aeon keys from file")
 		}
 
+		// Report misbehaving aeon members, once their shares are checked against the chain
+		entropyGenerator.SetEvidencePool(evidencePool)
+		entropyGenerator.SetStateDB(stateDB)
+
+		// Recover entropy from, and save entropy shares in, the entropy store
+		entropyGenerator.SetEntropyStore(entropyStore)
+
+		// Score peers on the entropy they send
+		beaconReactor.SetReporter(behaviour.NewSwitchReporterWithTrustMetrics(sw, trustMetricStore,
+			config.Beacon.PeerTrustThreshold, config.Beacon.PeerBanDuration))
+
 		// Attach metrics
 		entropyGenerator.AttachMetrics(drbMetrics)
 		dkgRunner.AttachMetrics(drbMetrics)
 
+		// Run dkgs, and sign aeon shares, with the key shares held by the remote signer
+		if config.Beacon.RemoteAeonSigner {
+			dkgSigner, ok := privValidator.(types.DKGSigner)
+			if !ok {
+				return nil, errors.New("remote_aeon_signer requires priv_validator_laddr to be set")
+			}
+			entropyGenerator.SetAeonSigner(dkgSigner)
+			dkgRunner.SetAeonSigner(dkgSigner)
+		}
+
+		// Publish dkg progress and new entropy for subscribers
+		entropyGenerator.SetEventBus(eventBus)
+		dkgRunner.SetEventBus(eventBus)
+
 		consensusState.SetEntropyChannel(entropyChannel)
+		consensusState.SetEntropyTimeoutCallback(entropyGenerator.SkipEntropy)
 		sw.AddReactor("BEACON", beaconReactor)
 
 		// Catch up dkg on messages it has missed for the current aeon
@@ -884,7 +1002,9 @@ func NewNode(config *cfg.Config,
 		eventBus:           eventBus,
 		specialTxHandler:   specialTxHandler,
 		entropyGenerator:   entropyGenerator,
+		entropyStore:       entropyStore,
 		beaconReactor:      beaconReactor,
+		trustMetricStore:   trustMetricStore,
 		nativeLogCollector: nativeLogger,
 		dkgRunner:          dkgRunner,
 	}
@@ -939,6 +1059,13 @@ func (n *Node) OnStart() error {
 		n.mempool.InitWAL() // no need to have the mempool wal during tests
 	}
 
+	if n.trustMetricStore != nil {
+		err = n.trustMetricStore.Start()
+		if err != nil {
+			return err
+		}
+	}
+
 	// Start the switch (the P2P server).
 	err = n.sw.Start()
 	if err != nil {
@@ -976,6 +1103,10 @@ func (n *Node) OnStop() {
 	// now stop the reactors
 	n.sw.Stop()
 
+	if n.trustMetricStore != nil {
+		n.trustMetricStore.Stop()
+	}
+
 	// stop mempool WAL
 	if n.config.Mempool.WalEnabled() {
 		n.mempool.CloseWAL()
@@ -1029,6 +1160,15 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetTxIndexer(n.txIndexer)
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
+	if n.entropyGenerator != nil {
+		rpccore.SetEntropyGenerator(n.entropyGenerator)
+	}
+	if n.entropyStore != nil {
+		rpccore.SetEntropyStore(n.entropyStore)
+	}
+	if n.dkgRunner != nil {
+		rpccore.SetDKGRunner(n.dkgRunner)
+	}
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
 	rpccore.SetConfig(*n.config.RPC)
 }
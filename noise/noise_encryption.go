@@ -2,20 +2,21 @@ package noise
 
 import (
 	"fmt"
-	"io/ioutil"
 
 	"github.com/flynn/noise"
 	"github.com/pkg/errors"
 	cfg "github.com/tendermint/tendermint/config"
-	tempfile "github.com/tendermint/tendermint/libs/tempfile"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	tmos "github.com/tendermint/tendermint/libs/os"
 )
 
-func newHandshake(staticKeyPair noise.DHKey, peerStaticPublic []byte, initiator bool) *noise.HandshakeState {
+func newHandshake(staticKeyPair noise.DHKey, peerStaticPublic []byte, prologue []byte,
+	initiator bool) *noise.HandshakeState {
 	noiseConfig := noise.Config{
 		CipherSuite:   noise.NewCipherSuite(noise.DH25519, noise.CipherAESGCM, noise.HashSHA256),
 		Pattern:       noise.HandshakeK,
 		Initiator:     initiator,
+		Prologue:      prologue,
 		StaticKeypair: staticKeyPair,
 		PeerStatic:    peerStaticPublic,
 	}
@@ -27,9 +28,10 @@ func newHandshake(staticKeyPair noise.DHKey, peerStaticPublic []byte, initiator
 	return handshake
 }
 
-// EncryptMsg encrypts message with noise one-way handshake with known peer public key
-func EncryptMsg(staticKeyPair noise.DHKey, peerStaticPublic []byte, payload string) (string, error) {
-	handshake := newHandshake(staticKeyPair, peerStaticPublic, true)
+// EncryptMsg encrypts message with noise one-way handshake with known peer public key. The message
+// can only be decrypted with the same prologue, which binds it to the context it was sent in
+func EncryptMsg(staticKeyPair noise.DHKey, peerStaticPublic []byte, prologue []byte, payload string) (string, error) {
+	handshake := newHandshake(staticKeyPair, peerStaticPublic, prologue, true)
 	handshakeMsg, _, _, err := handshake.WriteMessage(make([]byte, 0), []byte(payload))
 	if err != nil {
 		return "", err
@@ -38,8 +40,9 @@ func EncryptMsg(staticKeyPair noise.DHKey, peerStaticPublic []byte, payload stri
 }
 
 // DecryptMsg decrypts message encrypted with noise one-way handshake with known peer public key
-func DecryptMsg(staticKeyPair noise.DHKey, peerStaticPublic []byte, msg string) (string, error) {
-	handshake := newHandshake(staticKeyPair, peerStaticPublic, false)
+// and prologue
+func DecryptMsg(staticKeyPair noise.DHKey, peerStaticPublic []byte, prologue []byte, msg string) (string, error) {
+	handshake := newHandshake(staticKeyPair, peerStaticPublic, prologue, false)
 	payload, _, _, err := handshake.ReadMessage(make([]byte, 0), []byte(msg))
 	if err != nil {
 		return "", err
@@ -57,28 +60,38 @@ func NewEncryptionKey() noise.DHKey {
 }
 
 // LoadOrGenNoiseKeys either loads keys from file, or creates a new set of keys and saves them
-// to file
+// to file. The key file is encrypted with the key-encryption key in config, if set
 func LoadOrGenNoiseKeys(config *cfg.Config) (noise.DHKey, error) {
-	noiseKeys := noise.DHKey{}
+	keyEncryptionKey, err := keyfile.LoadKeyEncryptionKey(config.KeyEncryptionKeyFile())
+	if err != nil {
+		return noise.DHKey{}, err
+	}
 	if tmos.FileExists(config.NoiseKeyFile()) {
-		jsonBytes, err := ioutil.ReadFile(config.NoiseKeyFile())
-		if err != nil {
-			return noiseKeys, errors.Wrap(err, "error reading noise key file")
-		}
-		err = cdc.UnmarshalJSON(jsonBytes, &noiseKeys)
-		if err != nil {
-			return noiseKeys, errors.Wrap(err, "error unmarshalling noise keys")
-		}
-	} else {
-		noiseKeys = NewEncryptionKey()
-		keyBytes, err := cdc.MarshalJSONIndent(noiseKeys, "", "  ")
-		if err != nil {
-			return noiseKeys, errors.Wrap(err, "error marshalling noise key pair")
-		}
-		err = tempfile.WriteFileAtomic(config.NoiseKeyFile(), keyBytes, 0600)
-		if err != nil {
-			return noiseKeys, errors.Wrap(err, "error writing noise key pair")
-		}
+		return LoadNoiseKeys(config.NoiseKeyFile(), keyEncryptionKey)
+	}
+	noiseKeys := NewEncryptionKey()
+	keyBytes, err := cdc.MarshalJSONIndent(noiseKeys, "", "  ")
+	if err != nil {
+		return noiseKeys, errors.Wrap(err, "error marshalling noise key pair")
+	}
+	err = keyfile.WriteFile(config.NoiseKeyFile(), keyBytes, keyEncryptionKey)
+	if err != nil {
+		return noiseKeys, errors.Wrap(err, "error writing noise key pair")
+	}
+	return noiseKeys, nil
+}
+
+// LoadNoiseKeys loads a key pair saved to file by LoadOrGenNoiseKeys, decrypting the file with
+// keyEncryptionKey if it is encrypted
+func LoadNoiseKeys(filePath string, keyEncryptionKey []byte) (noise.DHKey, error) {
+	noiseKeys := noise.DHKey{}
+	jsonBytes, err := keyfile.ReadFile(filePath, keyEncryptionKey)
+	if err != nil {
+		return noiseKeys, errors.Wrap(err, "error reading noise key file")
+	}
+	err = cdc.UnmarshalJSON(jsonBytes, &noiseKeys)
+	if err != nil {
+		return noiseKeys, errors.Wrap(err, "error unmarshalling noise keys")
 	}
 	return noiseKeys, nil
 }
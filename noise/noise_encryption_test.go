@@ -2,11 +2,15 @@ package noise
 
 import (
 	"bytes"
+	"io/ioutil"
 	"testing"
 
 	"github.com/flynn/noise"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 )
 
 func TestNoiseNewHandshake(t *testing.T) {
@@ -27,7 +31,7 @@ func TestNoiseNewHandshake(t *testing.T) {
 
 			var handshake *noise.HandshakeState
 			assert.NotPanics(t, func() {
-				handshake = newHandshake(staticKey, peerStaticKey, tc.initiator)
+				handshake = newHandshake(staticKey, peerStaticKey, []byte("prologue"), tc.initiator)
 			})
 			assert.NotEqual(t, nil, handshake)
 		})
@@ -39,15 +43,17 @@ func TestNoiseEncryption(t *testing.T) {
 		testName            string
 		mutateDecryptionKey func(noise.DHKey)
 		mutateEncryptedMsg  func(string)
+		decryptionPrologue  string
 		err                 bool
 	}{
-		{"Correct key", func(noise.DHKey) {}, func(string) {}, false},
+		{"Correct key", func(noise.DHKey) {}, func(string) {}, "prologue", false},
 		{"Incorrect key", func(peerKey noise.DHKey) {
 			peerKey = NewEncryptionKey()
-		}, func(string) {}, false},
+		}, func(string) {}, "prologue", false},
 		{"Incorrect msg", func(noise.DHKey) {}, func(msg string) {
 			msg = "mutated msg"
-		}, false},
+		}, "prologue", false},
+		{"Incorrect prologue", func(noise.DHKey) {}, func(string) {}, "other prologue", true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
@@ -55,10 +61,10 @@ func TestNoiseEncryption(t *testing.T) {
 			peerStaticKey := NewEncryptionKey()
 
 			message := "Hello"
-			encryptedMsg, err := EncryptMsg(staticKey, peerStaticKey.Public, message)
+			encryptedMsg, err := EncryptMsg(staticKey, peerStaticKey.Public, []byte("prologue"), message)
 			assert.True(t, err == nil)
 			tc.mutateEncryptedMsg(encryptedMsg)
-			decryptedMsg, err := DecryptMsg(peerStaticKey, staticKey.Public, encryptedMsg)
+			decryptedMsg, err := DecryptMsg(peerStaticKey, staticKey.Public, []byte(tc.decryptionPrologue), encryptedMsg)
 			assert.Equal(t, tc.err, err != nil)
 			assert.Equal(t, tc.err, decryptedMsg != message)
 		})
@@ -78,3 +84,23 @@ func TestLoadOrGenerateKeys(t *testing.T) {
 	assert.True(t, bytes.Equal(noiseKeys.Public, noiseKeysFromFile.Public))
 	assert.True(t, bytes.Equal(noiseKeys.Private, noiseKeysFromFile.Private))
 }
+
+func TestLoadOrGenerateEncryptedKeys(t *testing.T) {
+	config := cfg.ResetTestRoot("encrypted_noise_test")
+	config.KeyEncryptionKey = "config/kek.txt"
+	require.NoError(t, ioutil.WriteFile(config.KeyEncryptionKeyFile(), []byte("key-encryption-key\n"), 0600))
+
+	noiseKeys, err := LoadOrGenNoiseKeys(config)
+	require.NoError(t, err)
+	fileBytes, err := ioutil.ReadFile(config.NoiseKeyFile())
+	require.NoError(t, err)
+	assert.True(t, keyfile.IsEncrypted(fileBytes))
+
+	noiseKeysFromFile, err := LoadOrGenNoiseKeys(config)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(noiseKeys.Private, noiseKeysFromFile.Private))
+
+	// Can not be loaded without the key-encryption key
+	_, err = LoadNoiseKeys(config.NoiseKeyFile(), nil)
+	assert.Equal(t, keyfile.ErrNoKeyEncryptionKey, errors.Cause(err))
+}
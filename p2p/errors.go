@@ -116,6 +116,16 @@ func (e ErrSwitchDuplicatePeerIP) Error() string {
 	return fmt.Sprintf("duplicate peer IP %v", e.IP.String())
 }
 
+// ErrSwitchBannedPeer to be raised when connecting to or from a peer which is
+// banned.
+type ErrSwitchBannedPeer struct {
+	ID ID
+}
+
+func (e ErrSwitchBannedPeer) Error() string {
+	return fmt.Sprintf("peer %v is banned", e.ID)
+}
+
 // ErrSwitchConnectToSelf to be raised when trying to connect to itself.
 type ErrSwitchConnectToSelf struct {
 	Addr *NetAddress
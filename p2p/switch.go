@@ -82,6 +82,8 @@ type Switch struct {
 	// peers addresses with whom we'll maintain constant connection
 	persistentPeersAddrs []*NetAddress
 	unconditionalPeerIDs map[ID]struct{}
+	// expiry time of the ban of each banned peer ID
+	bannedPeers *cmap.CMap
 
 	transport Transport
 
@@ -121,6 +123,7 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		bannedPeers:          cmap.NewCMap(),
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -341,6 +344,28 @@ func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	}
 }
 
+// BanPeerForError disconnects from a peer due to misbehaviour and refuses
+// connections to and from it until banTime has passed. Banned peers are not
+// reconnected to, even if they are persistent.
+func (sw *Switch) BanPeerForError(peer Peer, reason interface{}, banTime time.Duration) {
+	sw.Logger.Error("Banning peer for error", "peer", peer, "err", reason, "banTime", banTime)
+	sw.bannedPeers.Set(string(peer.ID()), time.Now().Add(banTime))
+	sw.stopAndRemovePeer(peer, reason)
+}
+
+// IsPeerBanned returns true if the peer with the given ID is banned.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	until, ok := sw.bannedPeers.Get(string(id)).(time.Time)
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		sw.bannedPeers.Delete(string(id))
+		return false
+	}
+	return true
+}
+
 // StopPeerGracefully disconnects from a peer gracefully.
 // TODO: handle graceful disconnects.
 func (sw *Switch) StopPeerGracefully(peer Peer) {
@@ -392,6 +417,8 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrSwitchBannedPeer); ok {
+			return
 		}
 
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
@@ -416,6 +443,8 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrSwitchBannedPeer); ok {
+			return
 		}
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
 	}
@@ -525,11 +554,15 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 // DialPeerWithAddress dials the given peer and runs sw.addPeer if it connects
 // and authenticates successfully.
 // If we're currently dialing this address or it belongs to an existing peer,
-// ErrCurrentlyDialingOrExistingAddress is returned.
+// ErrCurrentlyDialingOrExistingAddress is returned. If the peer is banned,
+// ErrSwitchBannedPeer is returned.
 func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if sw.IsPeerBanned(addr.ID) {
+		return ErrSwitchBannedPeer{ID: addr.ID}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -743,6 +776,11 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	// Refuse banned peers, which may dial us or be dialed by address
+	if sw.IsPeerBanned(p.ID()) {
+		return ErrRejected{id: p.ID(), err: ErrSwitchBannedPeer{ID: p.ID()}, isFiltered: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
@@ -413,6 +413,44 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchBanPeerForError(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.AddPersistentPeers([]string{rp.Addr().String()})
+	require.NoError(t, err)
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+
+	// Banned persistent peer is not reconnected to
+	banTime := 500 * time.Millisecond
+	sw.BanPeerForError(sw.Peers().Get(rp.ID()), fmt.Errorf("some err"), banTime)
+	assert.True(t, sw.IsPeerBanned(rp.ID()))
+	assert.Equal(t, 0, sw.Peers().Size())
+	err = sw.DialPeerWithAddress(rp.Addr())
+	assert.Equal(t, ErrSwitchBannedPeer{ID: rp.ID()}, err)
+
+	// Banned peer can not connect to us
+	conn, err := rp.Dial(sw.NetAddress())
+	require.NoError(t, err)
+	defer conn.Close()
+	time.Sleep(50 * time.Millisecond)
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+
+	// Peer can be connected to once the ban expires
+	time.Sleep(banTime)
+	assert.False(t, sw.IsPeerBanned(rp.ID()))
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+	assert.NotNil(t, sw.Peers().Get(rp.ID()))
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
 	err := sw.Start()
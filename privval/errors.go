@@ -19,6 +19,9 @@ var (
 
 	ErrReadTimeout  = fmt.Errorf("endpoint read timed out")
 	ErrWriteTimeout = fmt.Errorf("endpoint write timed out")
+
+	ErrNoAeonSigner = fmt.Errorf("signer does not hold aeon keys")
+	ErrNoDKGSigner  = fmt.Errorf("signer does not run dkgs")
 )
 
 // RemoteSignerError allows (remote) validators to include meaningful error descriptions in their reply.
@@ -21,6 +21,10 @@ func RegisterRemoteSignerMsg(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&SignedEntropyResponse{}, "tendermint/remotesigner/SignedEntropyResponse", nil)
 	cdc.RegisterConcrete(&SignDKGRequest{}, "tendermint/remotesigner/SignDKGRequest", nil)
 	cdc.RegisterConcrete(&SignedDKGResponse{}, "tendermint/remotesigner/SignedDKGResponse", nil)
+	cdc.RegisterConcrete(&SignAeonShareRequest{}, "tendermint/remotesigner/SignAeonShareRequest", nil)
+	cdc.RegisterConcrete(&SignedAeonShareResponse{}, "tendermint/remotesigner/SignedAeonShareResponse", nil)
+	cdc.RegisterConcrete(&DKGStepRequest{}, "tendermint/remotesigner/DKGStepRequest", nil)
+	cdc.RegisterConcrete(&DKGStepResponse{}, "tendermint/remotesigner/DKGStepResponse", nil)
 
 	cdc.RegisterConcrete(&PingRequest{}, "tendermint/remotesigner/PingRequest", nil)
 	cdc.RegisterConcrete(&PingResponse{}, "tendermint/remotesigner/PingResponse", nil)
@@ -81,6 +85,31 @@ type SignedDKGResponse struct {
 	Error      *RemoteSignerError
 }
 
+// SignAeonShareRequest is a request to compute a signature share with the key
+// share of an aeon
+type SignAeonShareRequest struct {
+	DKGID   int64
+	Message string
+}
+
+// SignedAeonShareResponse is response containing a signature share or an error
+type SignedAeonShareResponse struct {
+	SignatureShare string
+	Error          *RemoteSignerError
+}
+
+// DKGStepRequest is a request to run a step of a dkg with the secret state held
+// by the signer
+type DKGStepRequest struct {
+	Step *types.DKGStep
+}
+
+// DKGStepResponse is response containing the result of a dkg step or an error
+type DKGStepResponse struct {
+	Result *types.DKGStepResult
+	Error  *RemoteSignerError
+}
+
 // PingRequest is a request to confirm that the connection is alive.
 type PingRequest struct {
 }
@@ -16,6 +16,7 @@ type SignerClient struct {
 }
 
 var _ types.PrivValidator = (*SignerClient)(nil)
+var _ types.DKGSigner = (*SignerClient)(nil)
 
 // NewSignerClient returns an instance of SignerClient.
 // it will start the endpoint (if not already started)
@@ -171,3 +172,54 @@ func (sc *SignerClient) SignDKGMessage(chainID string, msg *types.DKGMessage) er
 
 	return nil
 }
+
+//--------------------------------------------------------
+// Implement AeonSigner
+
+// SignAeonShare requests a remote signer to compute the signature share of message
+// with the key share of an aeon
+func (sc *SignerClient) SignAeonShare(dkgID int64, message string) (string, error) {
+	response, err := sc.endpoint.SendRequest(&SignAeonShareRequest{DKGID: dkgID, Message: message})
+	if err != nil {
+		sc.endpoint.Logger.Error("SignerClient::SignAeonShare", "err", err)
+		return "", err
+	}
+
+	resp, ok := response.(*SignedAeonShareResponse)
+	if !ok {
+		sc.endpoint.Logger.Error("SignerClient::SignAeonShare", "err", "response != SignedAeonShareResponse")
+		return "", ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+
+	return resp.SignatureShare, nil
+}
+
+//--------------------------------------------------------
+// Implement DKGSigner
+
+// DKGStep requests a remote signer to run a step of a dkg with the secret state
+// it holds for this node
+func (sc *SignerClient) DKGStep(chainID string, step *types.DKGStep) (*types.DKGStepResult, error) {
+	response, err := sc.endpoint.SendRequest(&DKGStepRequest{Step: step})
+	if err != nil {
+		sc.endpoint.Logger.Error("SignerClient::DKGStep", "err", err)
+		return nil, err
+	}
+
+	resp, ok := response.(*DKGStepResponse)
+	if !ok {
+		sc.endpoint.Logger.Error("SignerClient::DKGStep", "err", "response != DKGStepResponse")
+		return nil, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	if resp.Result == nil {
+		return &types.DKGStepResult{}, nil
+	}
+
+	return resp.Result, nil
+}
@@ -150,6 +150,73 @@ func TestSignerDKGMessage(t *testing.T) {
 	}
 }
 
+type mockAeonSigner struct{}
+
+func (mockAeonSigner) SignAeonShare(dkgID int64, message string) (string, error) {
+	if dkgID != 1 {
+		return "", fmt.Errorf("no aeon for dkg %v", dkgID)
+	}
+	return "share:" + message, nil
+}
+
+func TestSignerAeonShare(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		defer tc.signerServer.Stop()
+		defer tc.signerClient.Close()
+
+		// Signer without aeon keys
+		_, err := tc.signerClient.SignAeonShare(1, "message")
+		assert.Error(t, err)
+
+		tc.signerServer.handlerMtx.Lock()
+		tc.signerServer.privVal = NewAeonSigningPV(tc.mockPV, mockAeonSigner{})
+		tc.signerServer.handlerMtx.Unlock()
+		share, err := tc.signerClient.SignAeonShare(1, "message")
+		require.NoError(t, err)
+		assert.Equal(t, "share:message", share)
+
+		_, err = tc.signerClient.SignAeonShare(2, "message")
+		assert.Error(t, err)
+	}
+}
+
+type mockDKGSigner struct {
+	mockAeonSigner
+}
+
+func (mockDKGSigner) DKGStep(chainID string, step *types.DKGStep) (*types.DKGStepResult, error) {
+	if step.Type != types.DKGStepGetMessage {
+		return nil, fmt.Errorf("unexpected step %v", step.Type)
+	}
+	return &types.DKGStepResult{Data: fmt.Sprintf("%v:%v:%v", chainID, step.DKGID, step.MessageType)}, nil
+}
+
+func TestSignerDKGStep(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		defer tc.signerServer.Stop()
+		defer tc.signerClient.Close()
+
+		step := &types.DKGStep{Type: types.DKGStepGetMessage, DKGID: 1, MessageType: types.DKGComplaint}
+
+		// Aeon signer which does not run dkgs
+		tc.signerServer.handlerMtx.Lock()
+		tc.signerServer.privVal = NewAeonSigningPV(tc.mockPV, mockAeonSigner{})
+		tc.signerServer.handlerMtx.Unlock()
+		_, err := tc.signerClient.DKGStep(tc.chainID, step)
+		assert.Error(t, err)
+
+		tc.signerServer.handlerMtx.Lock()
+		tc.signerServer.privVal = NewAeonSigningPV(tc.mockPV, mockDKGSigner{})
+		tc.signerServer.handlerMtx.Unlock()
+		result, err := tc.signerClient.DKGStep(tc.chainID, step)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%v:1:%v", tc.chainID, types.DKGComplaint), result.Data)
+
+		_, err = tc.signerClient.DKGStep(tc.chainID, &types.DKGStep{Type: types.DKGStepStart, DKGID: 1})
+		assert.Error(t, err)
+	}
+}
+
 func TestSignerVoteResetDeadline(t *testing.T) {
 	for _, tc := range getSignerTestCases(t) {
 		ts := time.Now()
@@ -53,6 +53,34 @@ func DefaultValidationRequestHandler(
 			res = &SignedDKGResponse{r.DKGMessage, nil}
 		}
 
+	case *SignAeonShareRequest:
+		aeonSigner, ok := privVal.(types.AeonSigner)
+		if !ok {
+			res = &SignedAeonShareResponse{"", &RemoteSignerError{0, ErrNoAeonSigner.Error()}}
+			break
+		}
+		var share string
+		share, err = aeonSigner.SignAeonShare(r.DKGID, r.Message)
+		if err != nil {
+			res = &SignedAeonShareResponse{"", &RemoteSignerError{0, err.Error()}}
+		} else {
+			res = &SignedAeonShareResponse{share, nil}
+		}
+
+	case *DKGStepRequest:
+		dkgSigner, ok := privVal.(types.DKGSigner)
+		if !ok {
+			res = &DKGStepResponse{nil, &RemoteSignerError{0, ErrNoDKGSigner.Error()}}
+			break
+		}
+		var result *types.DKGStepResult
+		result, err = dkgSigner.DKGStep(chainID, r.Step)
+		if err != nil {
+			res = &DKGStepResponse{nil, &RemoteSignerError{0, err.Error()}}
+		} else {
+			res = &DKGStepResponse{result, nil}
+		}
+
 	case *PingRequest:
 		err, res = nil, &PingResponse{}
 
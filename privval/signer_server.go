@@ -14,6 +14,28 @@ type ValidationRequestHandlerFunc func(
 	requestMessage SignerMessage,
 	chainID string) (SignerMessage, error)
 
+// AeonSigningPV is a PrivValidator which also holds aeon key shares. Serving it
+// with a SignerServer allows the node to request aeon signature shares remotely,
+// and to run the steps of dkgs remotely if the AeonSigner is also a DKGSigner.
+type AeonSigningPV struct {
+	types.PrivValidator
+	types.AeonSigner
+}
+
+// NewAeonSigningPV returns a PrivValidator which signs aeon shares with aeonSigner
+func NewAeonSigningPV(privVal types.PrivValidator, aeonSigner types.AeonSigner) *AeonSigningPV {
+	return &AeonSigningPV{PrivValidator: privVal, AeonSigner: aeonSigner}
+}
+
+// DKGStep runs step with the AeonSigner, if it runs dkgs
+func (pv *AeonSigningPV) DKGStep(chainID string, step *types.DKGStep) (*types.DKGStepResult, error) {
+	dkgSigner, ok := pv.AeonSigner.(types.DKGSigner)
+	if !ok {
+		return nil, ErrNoDKGSigner
+	}
+	return dkgSigner.DKGStep(chainID, step)
+}
+
 type SignerServer struct {
 	service.BaseService
 
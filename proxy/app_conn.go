@@ -6,6 +6,10 @@ import (
 	"github.com/tendermint/tendermint/tx_extensions"
 )
 
+// CodeTypeInvalidSpecialTx is returned by CheckTx for special txs which fail the validation of
+// their registered type, in the codespace of the type name
+const CodeTypeInvalidSpecialTx uint32 = 1
+
 //----------------------------------------------------------------------------------------
 // Enforce which abci msgs can be sent on a connection at the type level
 
@@ -45,14 +49,19 @@ type AppConnQuery interface {
 // Implements AppConnConsensus (subset of abcicli.Client)
 
 type appConnConsensus struct {
-	appConn          abcicli.Client
-	specialTxHandler *tx_extensions.SpecialTxHandler
+	appConn    abcicli.Client
+	specialTxs *tx_extensions.Registry
 }
 
-func NewAppConnConsensus(appConn abcicli.Client, handler *tx_extensions.SpecialTxHandler) *appConnConsensus {
+// NewAppConnConsensus returns a consensus connection which delivers the special txs registered in
+// specialTxs to their handlers instead of the app. Uses the default registry if specialTxs is nil.
+func NewAppConnConsensus(appConn abcicli.Client, specialTxs *tx_extensions.Registry) *appConnConsensus {
+	if specialTxs == nil {
+		specialTxs = tx_extensions.NewDefaultRegistry(nil)
+	}
 	return &appConnConsensus{
-		appConn:          appConn,
-		specialTxHandler: handler,
+		appConn:    appConn,
+		specialTxs: specialTxs,
 	}
 }
 
@@ -69,21 +78,19 @@ func (app *appConnConsensus) InitChainSync(req types.RequestInitChain) (*types.R
 }
 
 func (app *appConnConsensus) BeginBlockSync(req types.RequestBeginBlock) (*types.ResponseBeginBlock, error) {
-	if app.specialTxHandler != nil {
-		app.specialTxHandler.BeginBlock(req.Header.Entropy.GroupSignature)
-	}
+	app.specialTxs.BeginBlock(req.Header.Entropy.GroupSignature)
 
 	return app.appConn.BeginBlockSync(req)
 }
 
 func (app *appConnConsensus) DeliverTxAsync(req types.RequestDeliverTx) *abcicli.ReqRes {
 
-	// Special case for DKG TXs
-	if tx_extensions.IsDKGRelated(req.Tx) {
+	// Special case for special TXs
+	if app.specialTxs.DeliverTx(req.Tx) {
 
 		no_events := []types.Event{}
 
-		// If the TX is a DKG tx make a 'fake' abci call to pretend the TX was delivered
+		// If the TX is a special tx make a 'fake' abci call to pretend the TX was delivered
 		fakeRes := types.ResponseDeliverTx{Code: types.CodeTypeOK, Events: no_events}
 
 		reqRes := abcicli.NewReqRes(types.ToRequestDeliverTx(req))
@@ -91,9 +98,6 @@ func (app *appConnConsensus) DeliverTxAsync(req types.RequestDeliverTx) *abcicli
 		reqRes.SetDone()
 
 		app.appConn.TriggerResponseCallback(types.ToRequestDeliverTx(req), reqRes.Response)
-		if app.specialTxHandler != nil {
-			app.specialTxHandler.SpecialTxSeen(req.Tx)
-		}
 
 		return reqRes
 	}
@@ -103,9 +107,7 @@ func (app *appConnConsensus) DeliverTxAsync(req types.RequestDeliverTx) *abcicli
 
 func (app *appConnConsensus) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
 
-	if app.specialTxHandler != nil {
-		app.specialTxHandler.EndBlock(req.Height)
-	}
+	app.specialTxs.EndBlock(req.Height)
 
 	return app.appConn.EndBlockSync(req)
 }
@@ -118,12 +120,19 @@ func (app *appConnConsensus) CommitSync() (*types.ResponseCommit, error) {
 // Implements AppConnMempool (subset of abcicli.Client)
 
 type appConnMempool struct {
-	appConn abcicli.Client
+	appConn    abcicli.Client
+	specialTxs *tx_extensions.Registry
 }
 
-func NewAppConnMempool(appConn abcicli.Client) AppConnMempool {
+// NewAppConnMempool returns a mempool connection which checks the special txs registered in
+// specialTxs instead of the app. Uses the default registry if specialTxs is nil.
+func NewAppConnMempool(appConn abcicli.Client, specialTxs *tx_extensions.Registry) AppConnMempool {
+	if specialTxs == nil {
+		specialTxs = tx_extensions.NewDefaultRegistry(nil)
+	}
 	return &appConnMempool{
-		appConn: appConn,
+		appConn:    appConn,
+		specialTxs: specialTxs,
 	}
 }
 
@@ -145,10 +154,16 @@ func (app *appConnMempool) FlushSync() error {
 
 func (app *appConnMempool) CheckTxAsync(req types.RequestCheckTx) *abcicli.ReqRes {
 
-	// Special case for DKG TXs
-	if tx_extensions.IsDKGRelated(req.Tx) {
-		// If the TX is a DKG tx make a 'fake' abci call to determine the TX is ok.
+	// Special case for special TXs
+	if reg := app.specialTxs.Lookup(req.Tx); reg != nil {
+		// If the TX is a special tx make a 'fake' abci call to determine whether the TX is ok.
 		fakeRes := types.ResponseCheckTx{Code: types.CodeTypeOK, GasWanted: 1}
+		if reg.Validate != nil {
+			if err := reg.Validate(req.Tx); err != nil {
+				fakeRes = types.ResponseCheckTx{Code: CodeTypeInvalidSpecialTx, Log: err.Error(),
+					Codespace: reg.Name}
+			}
+		}
 
 		reqRes := abcicli.NewReqRes(types.ToRequestCheckTx(req))
 		reqRes.Response = types.ToResponseCheckTx(fakeRes)
@@ -5,12 +5,17 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"github.com/tendermint/tendermint/abci/server"
 	"github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/tx_extensions"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 //----------------------------------------
@@ -151,3 +156,44 @@ func TestInfo(t *testing.T) {
 		t.Error("Expected ResponseInfo with one element '{\"size\":0}' but got something else")
 	}
 }
+
+func TestSpecialTxs(t *testing.T) {
+	app := &deliverCountingApp{Application: kvstore.NewApplication()}
+	cli := abcicli.NewLocalClient(nil, app)
+	cli.SetResponseCallback(func(*types.Request, *types.Response) {})
+	specialTxs := tx_extensions.NewDefaultRegistry(nil)
+	mempoolConn := NewAppConnMempool(cli, specialTxs)
+	consensusConn := NewAppConnConsensus(cli, specialTxs)
+
+	privVal := tmtypes.NewMockPV()
+	msg := &tmtypes.DKGMessage{
+		Type:        tmtypes.DKGShare,
+		FromAddress: privVal.GetPubKey().Address(),
+		Data:        "share",
+	}
+	require.NoError(t, privVal.SignDKGMessage("test-chain", msg))
+	validTx := tx_extensions.AsBytes(msg)
+	msg.Data = ""
+	invalidTx := tx_extensions.AsBytes(msg)
+
+	res := mempoolConn.CheckTxAsync(types.RequestCheckTx{Tx: validTx}).Response.GetCheckTx()
+	assert.Equal(t, types.CodeTypeOK, res.Code)
+	res = mempoolConn.CheckTxAsync(types.RequestCheckTx{Tx: invalidTx}).Response.GetCheckTx()
+	assert.Equal(t, CodeTypeInvalidSpecialTx, res.Code)
+	assert.Equal(t, "dkg", res.Codespace)
+
+	consensusConn.DeliverTxAsync(types.RequestDeliverTx{Tx: validTx})
+	assert.Equal(t, 0, app.delivered, "special tx delivered to app")
+	consensusConn.DeliverTxAsync(types.RequestDeliverTx{Tx: []byte("key=value")})
+	assert.Equal(t, 1, app.delivered)
+}
+
+type deliverCountingApp struct {
+	types.Application
+	delivered int
+}
+
+func (app *deliverCountingApp) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+	app.delivered++
+	return app.Application.DeliverTx(req)
+}
@@ -16,7 +16,7 @@ type AppConns interface {
 	Mempool() AppConnMempool
 	Consensus() AppConnConsensus
 	Query() AppConnQuery
-	SetSpecialTxHandler(*tx_extensions.SpecialTxHandler)
+	SetSpecialTxRegistry(*tx_extensions.Registry)
 }
 
 func NewAppConns(clientCreator ClientCreator) AppConns {
@@ -35,7 +35,7 @@ type multiAppConn struct {
 	mempoolConn   AppConnMempool
 	consensusConn AppConnConsensus
 	queryConn     AppConnQuery
-	specialTxHandler *tx_extensions.SpecialTxHandler
+	specialTxs    *tx_extensions.Registry
 
 	clientCreator ClientCreator
 }
@@ -59,8 +59,10 @@ func (app *multiAppConn) Consensus() AppConnConsensus {
 	return app.consensusConn
 }
 
-func (app *multiAppConn) SetSpecialTxHandler(specialTxHandler *tx_extensions.SpecialTxHandler) {
-	app.specialTxHandler = specialTxHandler
+// SetSpecialTxRegistry sets the special txs handled by the node instead of the app. Must be called
+// before starting.
+func (app *multiAppConn) SetSpecialTxRegistry(specialTxs *tx_extensions.Registry) {
+	app.specialTxs = specialTxs
 }
 
 // Returns the query Connection
@@ -89,7 +91,7 @@ func (app *multiAppConn) OnStart() error {
 	if err := memcli.Start(); err != nil {
 		return errors.Wrap(err, "Error starting ABCI client (mempool connection)")
 	}
-	app.mempoolConn = NewAppConnMempool(memcli)
+	app.mempoolConn = NewAppConnMempool(memcli, app.specialTxs)
 
 	// consensus connection
 	concli, err := app.clientCreator.NewABCIClient()
@@ -100,7 +102,7 @@ func (app *multiAppConn) OnStart() error {
 	if err := concli.Start(); err != nil {
 		return errors.Wrap(err, "Error starting ABCI client (consensus connection)")
 	}
-	app.consensusConn = NewAppConnConsensus(concli, app.specialTxHandler)
+	app.consensusConn = NewAppConnConsensus(concli, app.specialTxs)
 
 	return nil
 }
@@ -295,6 +295,42 @@ func (c *baseRPCClient) ConsensusParams(height *int64) (*ctypes.ResultConsensusP
 	return result, nil
 }
 
+func (c *baseRPCClient) Entropy(height *int64) (*ctypes.ResultEntropy, error) {
+	result := new(ctypes.ResultEntropy)
+	_, err := c.caller.Call("entropy", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "Entropy")
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) EntropyShares(height *int64) (*ctypes.ResultEntropyShares, error) {
+	result := new(ctypes.ResultEntropyShares)
+	_, err := c.caller.Call("entropy_shares", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "EntropyShares")
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Aeon(height *int64) (*ctypes.ResultAeon, error) {
+	result := new(ctypes.ResultAeon)
+	_, err := c.caller.Call("aeon", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "Aeon")
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) DKGStatus() (*ctypes.ResultDKGStatus, error) {
+	result := new(ctypes.ResultDKGStatus)
+	_, err := c.caller.Call("dkg_status", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "DKGStatus")
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health() (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call("health", map[string]interface{}{}, result)
@@ -41,6 +41,7 @@ type Client interface {
 	StatusClient
 	EvidenceClient
 	MempoolClient
+	BeaconClient
 }
 
 // ABCIClient groups together the functionality that principally affects the
@@ -93,6 +94,15 @@ type NetworkClient interface {
 	Health() (*ctypes.ResultHealth, error)
 }
 
+// BeaconClient provides access to the entropy generated by the randomness
+// beacon and the dkgs which produce the beacon's keys.
+type BeaconClient interface {
+	Entropy(height *int64) (*ctypes.ResultEntropy, error)
+	EntropyShares(height *int64) (*ctypes.ResultEntropyShares, error)
+	Aeon(height *int64) (*ctypes.ResultAeon, error)
+	DKGStatus() (*ctypes.ResultDKGStatus, error)
+}
+
 // EventsClient is reactive, you can subscribe to any message, given the proper
 // string. see tendermint/types/events.go
 type EventsClient interface {
@@ -120,6 +120,22 @@ func (c *Local) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, e
 	return core.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) Entropy(height *int64) (*ctypes.ResultEntropy, error) {
+	return core.Entropy(c.ctx, height)
+}
+
+func (c *Local) EntropyShares(height *int64) (*ctypes.ResultEntropyShares, error) {
+	return core.EntropyShares(c.ctx, height)
+}
+
+func (c *Local) Aeon(height *int64) (*ctypes.ResultAeon, error) {
+	return core.Aeon(c.ctx, height)
+}
+
+func (c *Local) DKGStatus() (*ctypes.ResultDKGStatus, error) {
+	return core.DKGStatus(c.ctx)
+}
+
 func (c *Local) Health() (*ctypes.ResultHealth, error) {
 	return core.Health(c.ctx)
 }
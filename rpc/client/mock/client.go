@@ -126,6 +126,22 @@ func (c Client) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, e
 	return core.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) Entropy(height *int64) (*ctypes.ResultEntropy, error) {
+	return core.Entropy(&rpctypes.Context{}, height)
+}
+
+func (c Client) EntropyShares(height *int64) (*ctypes.ResultEntropyShares, error) {
+	return core.EntropyShares(&rpctypes.Context{}, height)
+}
+
+func (c Client) Aeon(height *int64) (*ctypes.ResultAeon, error) {
+	return core.Aeon(&rpctypes.Context{}, height)
+}
+
+func (c Client) DKGStatus() (*ctypes.ResultDKGStatus, error) {
+	return core.DKGStatus(&rpctypes.Context{})
+}
+
 func (c Client) Health() (*ctypes.ResultHealth, error) {
 	return core.Health(&rpctypes.Context{})
 }
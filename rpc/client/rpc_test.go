@@ -160,6 +160,33 @@ func TestHealth(t *testing.T) {
 	}
 }
 
+func TestBeacon(t *testing.T) {
+	for i, c := range GetClients() {
+		bc, ok := c.(client.BeaconClient)
+		require.True(t, ok, "%d", i)
+		entropy, err := bc.Entropy(nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.True(t, entropy.BlockHeight > 0)
+		assert.True(t, entropy.Trivial, "%d", i)
+
+		// entropy query of applications is answered by the node
+		req, err := (&abci.RequestEntropy{Height: entropy.BlockHeight}).Marshal()
+		require.Nil(t, err)
+		qres, err := c.ABCIQuery(abci.EntropyQueryPath, req)
+		require.Nil(t, err, "%d: %+v", i, err)
+		var abciEntropy abci.ResponseEntropy
+		require.Nil(t, abciEntropy.Unmarshal(qres.Response.Value), "%d", i)
+		assert.Equal(t, entropy.BlockHeight, abciEntropy.Height, "%d", i)
+		assert.True(t, abciEntropy.TrivialEntropy, "%d", i)
+
+		// the test node does not run the dkg so has no aeons
+		_, err = bc.Aeon(nil)
+		assert.Error(t, err, "%d", i)
+		_, err = bc.DKGStatus()
+		assert.Error(t, err, "%d", i)
+	}
+}
+
 func TestGenesisAndValidators(t *testing.T) {
 	for i, c := range GetClients() {
 
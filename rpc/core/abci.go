@@ -1,14 +1,19 @@
 package core
 
 import (
+	"fmt"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/proxy"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	sm "github.com/tendermint/tendermint/state"
 )
 
 // ABCIQuery queries the application for some information.
+// Queries with path abci.EntropyQueryPath are answered by the node with the
+// verified entropy of a past height.
 // More: https://docs.tendermint.com/master/rpc/#/ABCI/abci_query
 func ABCIQuery(
 	ctx *rpctypes.Context,
@@ -17,6 +22,10 @@ func ABCIQuery(
 	height int64,
 	prove bool,
 ) (*ctypes.ResultABCIQuery, error) {
+	if path == abci.EntropyQueryPath {
+		return abciQueryEntropy(data)
+	}
+
 	resQuery, err := proxyAppQuery.QuerySync(abci.RequestQuery{
 		Path:   path,
 		Data:   data,
@@ -39,3 +48,29 @@ func ABCIInfo(ctx *rpctypes.Context) (*ctypes.ResultABCIInfo, error) {
 	}
 	return &ctypes.ResultABCIInfo{Response: *resInfo}, nil
 }
+
+// abciQueryEntropy answers the entropy query of applications consuming the
+// entropy of past heights, with the same verified entropy as the Entropy route.
+func abciQueryEntropy(data bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	var req abci.RequestEntropy
+	if err := req.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("error decoding entropy query: %v", err)
+	}
+	if req.Height == 0 {
+		req.Height = blockStore.Height()
+	}
+	res, err := sm.QueryEntropy(stateDB, blockStore, req)
+	if err != nil {
+		return nil, err
+	}
+	value, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Code:   abci.CodeTypeOK,
+		Key:    data,
+		Value:  value,
+		Height: res.Height,
+	}}, nil
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"fmt"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Entropy gets the entropy in the block header at a given height, which has
+// been verified against the aeon generating it, whether it is trivial because
+// no aeon with keys covers the height, and the
+// height of the previous entropy it chains from.
+// Applications can use this to consume entropy of past heights, which is also
+// passed to them in RequestBeginBlock.
+// If no height is provided, it will fetch the entropy of the latest block.
+func Entropy(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultEntropy, error) {
+	height, err := getHeight(blockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("no block meta at height %d", height)
+	}
+	previousEntropyHeight, err := sm.LoadPreviousEntropyHeight(stateDB, height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultEntropy{
+		BlockHeight:           height,
+		Entropy:               blockMeta.Header.Entropy,
+		Trivial:               sm.IsTrivialEntropy(stateDB, height),
+		PreviousEntropyHeight: previousEntropyHeight}, nil
+}
+
+// EntropyShares gets the signature shares of aeon members from which the
+// entropy at a given height was computed by the node. Shares are only available
+// if the node saves them in its entropy store and has not pruned them.
+// If no height is provided, it will fetch the shares of the latest block.
+func EntropyShares(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultEntropyShares, error) {
+	if beaconEntropyStore == nil {
+		return nil, fmt.Errorf("node does not store entropy")
+	}
+	height, err := getHeight(blockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := beaconEntropyStore.LoadEntropyShares(height)
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("no entropy shares stored at height %d", height)
+	}
+	return &ctypes.ResultEntropyShares{BlockHeight: height, Shares: shares}, nil
+}
+
+// Aeon gets the public information of the aeon which generated entropy at a
+// given height. Aeons without keys, for which only trivial entropy is generated,
+// are only returned while known to the node's entropy generator.
+// If no height is provided, it will fetch the aeon of the latest block.
+func Aeon(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultAeon, error) {
+	height, err := getHeight(blockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("no block meta at height %d", height)
+	}
+	if !types.IsEmptyBlockEntropy(&blockMeta.Header.Entropy) {
+		aeon, err := sm.LoadAeonPublicInfo(stateDB, blockMeta.Header.Entropy.DKGID)
+		if err != nil {
+			return nil, err
+		}
+		dryRuns, err := sm.LoadAeonDryRuns(stateDB, blockMeta.Header.Entropy.DKGID)
+		if err != nil {
+			return nil, err
+		}
+		return &ctypes.ResultAeon{BlockHeight: height, Aeon: *aeon, DryRuns: dryRuns}, nil
+	}
+
+	if beaconEntropyGenerator != nil {
+		if aeon := beaconEntropyGenerator.GetAeon(height); aeon != nil {
+			return &ctypes.ResultAeon{BlockHeight: height, Aeon: *aeon}, nil
+		}
+	}
+	return nil, fmt.Errorf("no aeon found for height %d", height)
+}
+
+// DKGStatus returns the progress of the dkg currently being run by the node.
+// UNSTABLE
+func DKGStatus(ctx *rpctypes.Context) (*ctypes.ResultDKGStatus, error) {
+	if beaconDKGRunner == nil {
+		return nil, fmt.Errorf("node is not running the dkg")
+	}
+
+	status := beaconDKGRunner.DKGStatus()
+	if status == nil {
+		return nil, fmt.Errorf("no active dkg")
+	}
+	return &ctypes.ResultDKGStatus{DKGStatus: *status}, nil
+}
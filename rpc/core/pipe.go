@@ -44,6 +44,18 @@ type transport interface {
 	NodeInfo() p2p.NodeInfo
 }
 
+type entropyGenerator interface {
+	GetAeon(height int64) *types.DKGOutput
+}
+
+type entropyStore interface {
+	LoadEntropyShares(height int64) []types.EntropyShare
+}
+
+type dkgRunner interface {
+	DKGStatus() *types.DKGStatus
+}
+
 type peers interface {
 	AddPersistentPeers([]string) error
 	DialPeersAsync([]string) error
@@ -66,6 +78,11 @@ var (
 	p2pPeers       peers
 	p2pTransport   transport
 
+	// nil if the node does not run the dkg
+	beaconEntropyGenerator entropyGenerator
+	beaconEntropyStore     entropyStore
+	beaconDKGRunner        dkgRunner
+
 	// objects
 	pubKey           crypto.PubKey
 	genDoc           *types.GenesisDoc // cache the genesis structure
@@ -107,6 +124,18 @@ func SetP2PTransport(t transport) {
 	p2pTransport = t
 }
 
+func SetEntropyGenerator(eg entropyGenerator) {
+	beaconEntropyGenerator = eg
+}
+
+func SetEntropyStore(es entropyStore) {
+	beaconEntropyStore = es
+}
+
+func SetDKGRunner(dr dkgRunner) {
+	beaconDKGRunner = dr
+}
+
 func SetPubKey(pk crypto.PubKey) {
 	pubKey = pk
 }
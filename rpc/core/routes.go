@@ -32,6 +32,12 @@ var Routes = map[string]*rpc.RPCFunc{
 	"unconfirmed_txs":      rpc.NewRPCFunc(UnconfirmedTxs, "limit"),
 	"num_unconfirmed_txs":  rpc.NewRPCFunc(NumUnconfirmedTxs, ""),
 
+	// beacon API
+	"entropy":        rpc.NewRPCFunc(Entropy, "height"),
+	"entropy_shares": rpc.NewRPCFunc(EntropyShares, "height"),
+	"aeon":           rpc.NewRPCFunc(Aeon, "height"),
+	"dkg_status":     rpc.NewRPCFunc(DKGStatus, ""),
+
 	// tx broadcast API
 	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx"),
 	"broadcast_tx_sync":   rpc.NewRPCFunc(BroadcastTxSync, "tx"),
@@ -128,6 +128,36 @@ type ResultConsensusParams struct {
 	ConsensusParams types.ConsensusParams `json:"consensus_params"`
 }
 
+// Entropy for given height and whether it is trivial because no aeon with
+// keys covers the height
+type ResultEntropy struct {
+	BlockHeight int64              `json:"block_height"`
+	Entropy     types.BlockEntropy `json:"entropy"`
+	Trivial     bool               `json:"trivial"`
+	// Height of the last block before BlockHeight with entropy, the group
+	// signature of which the entropy signs. 0 if there is none
+	PreviousEntropyHeight int64 `json:"previous_entropy_height"`
+}
+
+// Signature shares from which the entropy at given height was computed
+type ResultEntropyShares struct {
+	BlockHeight int64                `json:"block_height"`
+	Shares      []types.EntropyShare `json:"shares"`
+}
+
+// Public info of the aeon generating entropy at given height, together with
+// the signed dry run messages in which validators agreed to it (if known)
+type ResultAeon struct {
+	BlockHeight int64               `json:"block_height"`
+	Aeon        types.DKGOutput     `json:"aeon"`
+	DryRuns     []*types.DKGMessage `json:"dry_runs"`
+}
+
+// Info about the dkg currently run by the node
+type ResultDKGStatus struct {
+	DKGStatus types.DKGStatus `json:"dkg_status"`
+}
+
 // Info about the consensus state.
 // UNSTABLE
 type ResultDumpConsensusState struct {
@@ -43,6 +43,18 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrNoAeonForDKGID struct {
+		DKGID int64
+	}
+
+	ErrNoAeonForHeight struct {
+		Height int64
+	}
+
+	ErrNoPreviousEntropyForHeight struct {
+		Height int64
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -89,3 +101,15 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("Could not find results for height #%d", e.Height)
 }
+
+func (e ErrNoAeonForDKGID) Error() string {
+	return fmt.Sprintf("Could not find aeon for dkg id %d", e.DKGID)
+}
+
+func (e ErrNoAeonForHeight) Error() string {
+	return fmt.Sprintf("Could not find aeon for height #%d", e.Height)
+}
+
+func (e ErrNoPreviousEntropyForHeight) Error() string {
+	return fmt.Sprintf("Could not find previous entropy for height #%d", e.Height)
+}
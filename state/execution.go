@@ -5,10 +5,12 @@ import (
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/fail"
 	"github.com/tendermint/tendermint/libs/log"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/tx_extensions"
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -34,6 +36,18 @@ type BlockExecutor struct {
 	mempool mempl.Mempool
 	evpool  EvidencePool
 
+	// verify group signatures in block entropy. Entropy is not verified if nil
+	entropyVerifier types.GroupSignatureVerifier
+
+	// verify the threshold cryptography in beacon evidence. Beacon evidence is rejected if nil
+	evidenceVerifier types.BeaconEvidenceVerifier
+
+	// save the entropy of committed blocks. Entropy is not saved if nil
+	entropyStore EntropyStore
+
+	// identify the special txs in blocks, which are committed by the committer of their type
+	specialTxs *tx_extensions.Registry
+
 	logger log.Logger
 
 	metrics *Metrics
@@ -47,6 +61,38 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithEntropyVerifier sets the verifier used to check the group signature
+// in the block entropy against the aeon public info saved in the state db
+func BlockExecutorWithEntropyVerifier(verifier types.GroupSignatureVerifier) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.entropyVerifier = verifier
+	}
+}
+
+// BlockExecutorWithEvidenceVerifier sets the verifier used to check the threshold signature
+// shares and dkg complaint answers in beacon evidence
+func BlockExecutorWithEvidenceVerifier(verifier types.BeaconEvidenceVerifier) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.evidenceVerifier = verifier
+	}
+}
+
+// BlockExecutorWithEntropyStore sets the store in which the entropy of each committed block
+// is saved
+func BlockExecutorWithEntropyStore(store EntropyStore) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.entropyStore = store
+	}
+}
+
+// BlockExecutorWithSpecialTxRegistry sets the registry used to identify the special txs in
+// blocks. Only the DKG is registered if not set.
+func BlockExecutorWithSpecialTxRegistry(specialTxs *tx_extensions.Registry) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.specialTxs = specialTxs
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -58,13 +104,14 @@ func NewBlockExecutor(
 	options ...BlockExecutorOption,
 ) *BlockExecutor {
 	res := &BlockExecutor{
-		db:       db,
-		proxyApp: proxyApp,
-		eventBus: types.NopEventBus{},
-		mempool:  mempool,
-		evpool:   evpool,
-		logger:   logger,
-		metrics:  NopMetrics(),
+		db:         db,
+		proxyApp:   proxyApp,
+		eventBus:   types.NopEventBus{},
+		mempool:    mempool,
+		evpool:     evpool,
+		logger:     logger,
+		metrics:    NopMetrics(),
+		specialTxs: tx_extensions.NewDefaultRegistry(nil),
 	}
 
 	for _, option := range options {
@@ -87,12 +134,14 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
-// The rest is given to txs, up to the max gas.
+// The rest is given to txs, up to the max gas. An entropy timeout, if given,
+// is included in the block and its space taken from the txs.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
 	proposerAddr []byte,
 	fallbackMode bool,
+	entropyTimeout *types.EntropyTimeout,
 ) (*types.Block, *types.PartSet) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
@@ -104,9 +153,34 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
+	if entropyTimeout != nil {
+		maxDataBytes -= types.MaxEntropyTimeoutBytes(state.Validators.Size())
+		if maxDataBytes < 0 {
+			maxDataBytes = 0
+		}
+	}
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas, fallbackMode)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	block, parts := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	if entropyTimeout != nil {
+		block.EntropyTimeout = entropyTimeout
+		parts = block.MakePartSet(types.BlockPartSizeBytes)
+	}
+	return block, parts
+}
+
+// AeonHash returns the hash of the public info of the aeon which generated the entropy, as
+// committed to in the block header. Returns nil for empty entropy or if the aeon is unknown.
+func (blockExec *BlockExecutor) AeonHash(entropy *types.BlockEntropy) tmbytes.HexBytes {
+	if types.IsEmptyBlockEntropy(entropy) {
+		return nil
+	}
+	aeon, err := LoadAeonPublicInfo(blockExec.db, entropy.DKGID)
+	if err != nil {
+		blockExec.logger.Error("Failed to load aeon for block entropy", "dkgID", entropy.DKGID, "err", err)
+		return nil
+	}
+	return aeon.Hash()
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -114,7 +188,14 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
 func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) error {
-	return validateBlock(blockExec.evpool, blockExec.db, state, block)
+	return validateBlock(blockExec.evpool, blockExec.db, blockExec.entropyVerifier, blockExec.evidenceVerifier,
+		state, block)
+}
+
+// ValidateBlockEntropy checks the group signature in the block entropy chains from the last
+// computed entropy in state. Used by fast sync to reject blocks before they are saved.
+func (blockExec *BlockExecutor) ValidateBlockEntropy(state State, block *types.Block) error {
+	return validateBlockEntropy(blockExec.db, blockExec.entropyVerifier, state, block)
 }
 
 // ApplyBlock validates the block against the state, executes it against the app,
@@ -143,6 +224,11 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 
 	fail.Fail() // XXX
 
+	// Commit the output of any dkg which the dry runs in this block complete agreement for, and prune
+	// the dkg messages of dkgs which are too old for DKGComplaintEvidence
+	commitSpecialTxs(blockExec.logger, blockExec.db, blockExec.specialTxs, state.ChainID, block)
+	pruneDKGMessages(blockExec.db, block.Height-state.ConsensusParams.Evidence.MaxAgeNumBlocks-1)
+
 	// validate the validator updates and convert to tendermint types
 	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
 	err = validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
@@ -186,6 +272,11 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	// Update evpool with the block and state.
 	blockExec.evpool.Update(block, state)
 
+	// Save the entropy before the state, so it is saved again if the block is replayed
+	if blockExec.entropyStore != nil && len(block.Entropy.GroupSignature) != 0 {
+		blockExec.entropyStore.SaveEntropy(block.Height, &block.Entropy)
+	}
+
 	fail.Fail() // XXX
 
 	// Update the app hash and save the state.
@@ -304,6 +395,8 @@ func execBlockOnProxyApp(
 		Header:              types.TM2PB.Header(&block.Header),
 		LastCommitInfo:      commitInfo,
 		ByzantineValidators: byzVals,
+		Entropy:             types.TM2PB.BlockEntropy(block.Entropy),
+		TrivialEntropy:      IsTrivialEntropy(stateDB, block.Height),
 	})
 	if err != nil {
 		logger.Error("Error in proxyAppConn.BeginBlock", "err", err)
@@ -366,7 +459,7 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 		// We need the validator set. We already did this in validateBlock.
 		// TODO: Should we instead cache the valset in the evidence itself and add
 		// `SetValidatorSet()` and `ToABCI` methods ?
-		valset, err := LoadValidators(stateDB, ev.Height())
+		valset, err := LoadEvidenceValidators(stateDB, ev)
 		if err != nil {
 			panic(err)
 		}
@@ -379,6 +472,113 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 	}, byzVals
 }
 
+// specialTxCommitter saves the state the chain keeps for a special tx in a block being applied
+type specialTxCommitter func(logger log.Logger, stateDB dbm.DB, chainID string, height int64, tx []byte)
+
+// specialTxCommitters are the committers of the special tx types which keep state on chain. Txs of
+// other registered types are only delivered.
+var specialTxCommitters = map[tx_extensions.SpecialTxType]specialTxCommitter{
+	tx_extensions.SpecialTxType_DKG: commitDKGTx,
+}
+
+// commitSpecialTxs passes each tx in the block which specialTxs identifies as a special tx to the
+// committer of its registered type
+func commitSpecialTxs(logger log.Logger, stateDB dbm.DB, specialTxs *tx_extensions.Registry, chainID string,
+	block *types.Block) {
+	for _, tx := range block.Txs {
+		reg := specialTxs.Lookup(tx)
+		if reg == nil {
+			continue
+		}
+		if commit, ok := specialTxCommitters[reg.Type]; ok {
+			commit(logger, stateDB, chainID, block.Height, tx)
+		}
+	}
+}
+
+// commitDKGTx saves the public info of each aeon, and the dry run messages agreeing to it, once enough
+// of the dkg validators have signed dry runs for it on chain. This makes the chain the authoritative
+// source of aeon public info, which blocks with entropy from the aeon commit to by hash.
+// Coefficients and complaint answers are kept for verifying DKGComplaintEvidence until the evidence
+// against the dkg expires.
+func commitDKGTx(logger log.Logger, stateDB dbm.DB, chainID string, height int64, tx []byte) {
+	msg, err := tx_extensions.FromBytes(tx)
+	if err != nil {
+		return
+	}
+	switch msg.Type {
+	case types.DKGDryRun:
+		if err := commitAeonDryRun(stateDB, chainID, msg); err != nil {
+			logger.Debug("Ignoring dry run", "height", height, "from", msg.FromAddress, "err", err)
+		}
+	case types.DKGCoefficient, types.DKGComplaintAnswer:
+		if err := commitDKGMessage(stateDB, chainID, msg); err != nil {
+			logger.Debug("Ignoring dkg message", "height", height, "from", msg.FromAddress, "err", err)
+		}
+	}
+}
+
+// commitDKGMessage saves the message if it is from a member of the dkg and not older than the
+// one already saved. DKG ids are equal to the height of the validators running the dkg.
+func commitDKGMessage(stateDB dbm.DB, chainID string, msg *types.DKGMessage) error {
+	if saved, err := LoadDKGMessage(stateDB, msg.DKGID, msg.Type, msg.FromAddress); err == nil &&
+		saved.DKGIteration >= msg.DKGIteration {
+		return nil
+	}
+	vals, err := LoadValidators(stateDB, msg.DKGID)
+	if err != nil {
+		return err
+	}
+	if err := vals.VerifyDKGMessage(chainID, msg); err != nil {
+		return err
+	}
+	saveDKGMessage(stateDB, msg)
+	return nil
+}
+
+func commitAeonDryRun(stateDB dbm.DB, chainID string, msg *types.DKGMessage) error {
+	// The first aeon committed for a dkg id is final, as blocks commit to it by hash
+	if _, err := LoadAeonPublicInfo(stateDB, msg.DKGID); err == nil {
+		return fmt.Errorf("aeon for dkg id %v already committed", msg.DKGID)
+	}
+
+	dryRun := new(types.DryRunSignature)
+	if err := cdc.UnmarshalBinaryBare([]byte(msg.Data), dryRun); err != nil {
+		return err
+	}
+	vals, err := LoadValidators(stateDB, dryRun.PublicInfo.ValidatorHeight)
+	if err != nil {
+		return err
+	}
+	if _, err := vals.VerifyDryRun(chainID, msg.DKGID, msg); err != nil {
+		return err
+	}
+
+	pending := append(loadPendingAeonDryRuns(stateDB, msg.DKGID), msg)
+	agreed := make([]*types.DKGMessage, 0, len(pending))
+	signed := make(map[string]bool)
+	for _, pendingMsg := range pending {
+		pendingDryRun := new(types.DryRunSignature)
+		if err := cdc.UnmarshalBinaryBare([]byte(pendingMsg.Data), pendingDryRun); err != nil {
+			continue
+		}
+		if pendingMsg.DKGIteration == msg.DKGIteration && pendingDryRun.PublicInfo.Equal(&dryRun.PublicInfo) &&
+			!signed[string(pendingMsg.FromAddress)] {
+			agreed = append(agreed, pendingMsg)
+			signed[string(pendingMsg.FromAddress)] = true
+		}
+	}
+
+	if len(agreed) < types.DryRunPassSize(vals.Size()) {
+		savePendingAeonDryRuns(stateDB, msg.DKGID, pending)
+		return nil
+	}
+	saveAeonPublicInfo(stateDB, msg.DKGID, &dryRun.PublicInfo)
+	saveAeonDryRuns(stateDB, msg.DKGID, agreed)
+	deletePendingAeonDryRuns(stateDB, msg.DKGID)
+	return nil
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params types.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
@@ -454,6 +654,14 @@ func updateState(
 		lastHeightParamsChanged = header.Height + 1
 	}
 
+	// Only update the last computed entropy if the block contains non-trivial entropy
+	lastComputedEntropy := state.LastComputedEntropy
+	lastComputedEntropyHeight := state.LastComputedEntropyHeight
+	if !types.IsEmptyBlockEntropy(&header.Entropy) {
+		lastComputedEntropy = header.Entropy.GroupSignature
+		lastComputedEntropyHeight = header.Height
+	}
+
 	// TODO: allow app to upgrade version
 	nextVersion := state.Version
 
@@ -473,7 +681,8 @@ func updateState(
 		LastHeightConsensusParamsChanged: lastHeightParamsChanged,
 		LastResultsHash:                  abciResponses.ResultsHash(),
 		AppHash:                          nil,
-		LastComputedEntropy:              header.Entropy.GroupSignature,
+		LastComputedEntropy:              lastComputedEntropy,
+		LastComputedEntropyHeight:        lastComputedEntropyHeight,
 		DKGValidators:                    nDKGValSet,
 		LastHeightDKGValidatorsChanged:   lastHeightDKGValsChanged,
 	}, nil
@@ -541,3 +750,25 @@ func ExecCommitBlock(
 	// ResponseCommit has no error or log, just data
 	return res.Data, nil
 }
+
+//----------------------------------------------------------------------------------------------------
+// Entropy query
+
+// QueryEntropy answers the entropy query of an application with the entropy of the committed block at
+// the requested height, which was verified against its aeon when the block was validated.
+func QueryEntropy(stateDB dbm.DB, blockStore BlockStore, req abci.RequestEntropy) (abci.ResponseEntropy, error) {
+	blockMeta := blockStore.LoadBlockMeta(req.Height)
+	if blockMeta == nil {
+		return abci.ResponseEntropy{}, ErrUnknownBlock{req.Height}
+	}
+	previousEntropyHeight, err := LoadPreviousEntropyHeight(stateDB, req.Height)
+	if err != nil {
+		return abci.ResponseEntropy{}, err
+	}
+	return abci.ResponseEntropy{
+		Height:                req.Height,
+		Entropy:               types.TM2PB.BlockEntropy(blockMeta.Header.Entropy),
+		TrivialEntropy:        IsTrivialEntropy(stateDB, req.Height),
+		PreviousEntropyHeight: previousEntropyHeight,
+	}, nil
+}
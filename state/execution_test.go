@@ -15,6 +15,7 @@ import (
 	"github.com/tendermint/tendermint/mock"
 	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/tx_extensions"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
@@ -47,6 +48,197 @@ func TestApplyBlock(t *testing.T) {
 	// TODO check state and mempool
 }
 
+// TestApplyBlockSavesEntropy ensures the entropy of applied blocks is saved in the entropy store
+func TestApplyBlockSavesEntropy(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	for _, entropy := range []*types.BlockEntropy{
+		types.EmptyBlockEntropy(),
+		types.NewBlockEntropy([]byte("Signature"), 0, 8, 1),
+	} {
+		state, stateDB, _ := makeState(1, 1)
+		entropyStore := make(mapEntropyStore)
+		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+			mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithEntropyStore(entropyStore))
+
+		block := makeBlock(state, 1)
+		block.Entropy = *entropy
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+		_, err = blockExec.ApplyBlock(state, blockID, block)
+		require.Nil(t, err)
+
+		if len(entropy.GroupSignature) == 0 {
+			assert.Empty(t, entropyStore, "trivial entropy saved")
+		} else {
+			assert.Equal(t, entropy, entropyStore[1])
+		}
+	}
+}
+
+type mapEntropyStore map[int64]*types.BlockEntropy
+
+func (store mapEntropyStore) SaveEntropy(height int64, entropy *types.BlockEntropy) {
+	store[height] = entropy
+}
+
+// TestApplyBlockCommitsAeon ensures aeon public info is saved once enough dry runs are seen on chain
+func TestApplyBlockCommitsAeon(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(4, 1)
+
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	aeon := types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		PublicKeyShares: []string{"share0", "share1", "share2", "share3"},
+		Generator:       "generator",
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1, 2, 3},
+		Start:           10,
+		End:             19,
+	}
+	dryRunTx := func(val *types.Validator) types.Tx {
+		dryRun := types.DryRunSignature{PublicInfo: aeon, SignatureShare: "signature_share"}
+		msg := &types.DKGMessage{
+			Type:        types.DKGDryRun,
+			FromAddress: val.Address,
+			DKGID:       1,
+			Data:        string(types.GetCodec().MustMarshalBinaryBare(&dryRun)),
+		}
+		require.NoError(t, privVals[val.Address.String()].SignDKGMessage(chainID, msg))
+		return tx_extensions.AsBytes(msg)
+	}
+
+	dkgMessageTx := func(val *types.Validator, msgType types.DKGMessageType, data string) types.Tx {
+		msg := &types.DKGMessage{
+			Type:        msgType,
+			FromAddress: val.Address,
+			DKGID:       1,
+			Data:        data,
+		}
+		require.NoError(t, privVals[val.Address.String()].SignDKGMessage(chainID, msg))
+		return tx_extensions.AsBytes(msg)
+	}
+
+	// Dry runs from 2 of 4 validators are not enough to commit the aeon
+	vals := state.Validators.Validators
+	txs := []types.Tx{dryRunTx(vals[0]), dryRunTx(vals[1]), dryRunTx(vals[1]),
+		dkgMessageTx(vals[3], types.DKGCoefficient, "coefficients"),
+		dkgMessageTx(vals[3], types.DKGComplaintAnswer, "invalid_answers")}
+	block, _ := state.MakeBlock(1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	state, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Nil(t, err)
+	_, err = sm.LoadAeonPublicInfo(stateDB, 1)
+	assert.Error(t, err)
+
+	// Complaint answers on chain can be checked until the evidence against the dkg expires
+	complaint := types.NewDKGComplaintEvidence(vals[3].PubKey, vals[0].Address, 1, 0, tmtime.Now())
+	verifier := mockBeaconEvidenceVerifier{validAnswers: "valid_answers"}
+	assert.NoError(t, sm.VerifyEvidence(stateDB, state, complaint, verifier))
+	assert.Error(t, sm.VerifyEvidence(stateDB, state, complaint, nil))
+	assert.Error(t, sm.VerifyEvidence(stateDB, state,
+		types.NewDKGComplaintEvidence(vals[3].PubKey, vals[0].Address, 1, 1, tmtime.Now()), verifier))
+	assert.Error(t, sm.VerifyEvidence(stateDB, state,
+		types.NewDKGComplaintEvidence(vals[2].PubKey, vals[0].Address, 1, 0, tmtime.Now()), verifier))
+
+	// Third validator completes agreement
+	lastCommit, err := makeValidCommit(1, blockID, state.LastValidators, privVals)
+	require.NoError(t, err)
+	block, _ = state.MakeBlock(2, []types.Tx{dryRunTx(vals[2])}, lastCommit, nil,
+		state.Validators.GetProposer().Address)
+	blockID = types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	state, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Nil(t, err)
+
+	committedAeon, err := sm.LoadAeonPublicInfo(stateDB, 1)
+	require.NoError(t, err)
+	assert.True(t, committedAeon.Equal(&aeon))
+	dryRuns, err := sm.LoadAeonDryRuns(stateDB, 1)
+	require.NoError(t, err)
+	assert.Len(t, dryRuns, 3)
+	assert.NoError(t, state.Validators.VerifyAeonDryRuns(chainID, 1, &aeon, dryRuns))
+	assert.NoError(t, sm.VerifyEvidence(stateDB, state, complaint, verifier))
+
+	// Dry runs of a later dkg iteration do not replace the committed aeon
+	laterAeon := aeon
+	laterAeon.GroupPublicKey = "later_group_public_key"
+	laterDryRuns := make([]types.Tx, 0, len(vals))
+	for _, val := range vals {
+		dryRun := types.DryRunSignature{PublicInfo: laterAeon, SignatureShare: "signature_share"}
+		msg := &types.DKGMessage{
+			Type:         types.DKGDryRun,
+			FromAddress:  val.Address,
+			DKGID:        1,
+			DKGIteration: 1,
+			Data:         string(types.GetCodec().MustMarshalBinaryBare(&dryRun)),
+		}
+		require.NoError(t, privVals[val.Address.String()].SignDKGMessage(chainID, msg))
+		laterDryRuns = append(laterDryRuns, tx_extensions.AsBytes(msg))
+	}
+
+	// Dkg messages are pruned once evidence against the dkg is too old, which it is after block 3
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 1
+	lastCommit, err = makeValidCommit(2, blockID, state.LastValidators, privVals)
+	require.NoError(t, err)
+	block, _ = state.MakeBlock(3, laterDryRuns, lastCommit, nil, state.Validators.GetProposer().Address)
+	blockID = types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Nil(t, err)
+	committedAeon, err = sm.LoadAeonPublicInfo(stateDB, 1)
+	require.NoError(t, err)
+	assert.True(t, committedAeon.Equal(&aeon))
+	_, err = sm.LoadDKGMessage(stateDB, 1, types.DKGCoefficient, vals[3].Address)
+	assert.Error(t, err)
+}
+
+// TestApplyBlockCommitsRegisteredSpecialTxs ensures only the special txs identified by the registry
+// of the block executor are committed
+func TestApplyBlockCommitsRegisteredSpecialTxs(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(4, 1)
+
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithSpecialTxRegistry(tx_extensions.NewRegistry()))
+
+	vals := state.Validators.Validators
+	txs := make([]types.Tx, 0, len(vals))
+	for _, val := range vals {
+		msg := &types.DKGMessage{
+			Type:        types.DKGCoefficient,
+			FromAddress: val.Address,
+			DKGID:       1,
+			Data:        "coefficients",
+		}
+		require.NoError(t, privVals[val.Address.String()].SignDKGMessage(chainID, msg))
+		txs = append(txs, tx_extensions.AsBytes(msg))
+	}
+	block, _ := state.MakeBlock(1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Nil(t, err)
+
+	for _, val := range vals {
+		_, err = sm.LoadDKGMessage(stateDB, 1, types.DKGCoefficient, val.Address)
+		assert.Error(t, err)
+	}
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
@@ -109,6 +301,56 @@ func TestBeginBlockValidators(t *testing.T) {
 	}
 }
 
+// TestBeginBlockEntropy ensures we send the block entropy and whether it is trivial.
+func TestBeginBlockEntropy(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(2, 2)
+
+	prevBlockID := types.BlockID{Hash: state.LastBlockID.Hash, PartsHeader: types.PartSetHeader{}}
+	lastCommit, err := makeValidCommit(1, prevBlockID, state.LastValidators, privVals)
+	require.NoError(t, err)
+
+	aeon := &types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		PublicKeyShares: []string{"share0", "share1"},
+		Generator:       "generator",
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1},
+		Start:           2,
+		End:             10,
+	}
+	testCases := []struct {
+		desc    string
+		aeon    *types.DKGOutput
+		entropy *types.BlockEntropy
+		trivial bool
+	}{
+		{"trivial entropy", nil, types.EmptyBlockEntropy(), true},
+		{"aeon entropy", aeon, types.NewBlockEntropy([]byte("Signature"), 0, 8, 1), false},
+		{"beacon timed out", aeon, types.EmptyBlockEntropy(), false},
+	}
+
+	for _, tc := range testCases {
+		if tc.aeon != nil {
+			sm.SaveAeonPublicInfo(stateDB, tc.aeon.ValidatorHeight, tc.aeon)
+		}
+		block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address)
+		block.Entropy = *tc.entropy
+
+		_, err = sm.ExecCommitBlock(proxyApp.Consensus(), block, log.TestingLogger(), stateDB)
+		require.Nil(t, err, tc.desc)
+
+		assert.Equal(t, types.TM2PB.BlockEntropy(*tc.entropy), app.Entropy, tc.desc)
+		assert.Equal(t, tc.trivial, app.TrivialEntropy, tc.desc)
+	}
+}
+
 // TestBeginBlockByzantineValidators ensures we send byzantine validators list.
 func TestBeginBlockByzantineValidators(t *testing.T) {
 	app := &testApp{}
@@ -48,3 +48,9 @@ func SaveConsensusParamsInfo(db dbm.DB, nextHeight, changeHeight int64, params t
 func SaveValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *types.ValidatorSet) {
 	saveValidatorsInfo(db, height, lastHeightChanged, valSet)
 }
+
+// SaveAeonPublicInfo is an alias for the private saveAeonPublicInfo method in
+// store.go, exported exclusively and explicitly for testing.
+func SaveAeonPublicInfo(db dbm.DB, dkgID int64, aeon *types.DKGOutput) {
+	saveAeonPublicInfo(db, dkgID, aeon)
+}
@@ -252,6 +252,8 @@ type testApp struct {
 	CommitVotes         []abci.VoteInfo
 	ByzantineValidators []abci.Evidence
 	ValidatorUpdates    []abci.ValidatorUpdate
+	Entropy             abci.BlockEntropy
+	TrivialEntropy      bool
 }
 
 var _ abci.Application = (*testApp)(nil)
@@ -263,6 +265,8 @@ func (app *testApp) Info(req abci.RequestInfo) (resInfo abci.ResponseInfo) {
 func (app *testApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	app.CommitVotes = req.LastCommitInfo.Votes
 	app.ByzantineValidators = req.ByzantineValidators
+	app.Entropy = req.Entropy
+	app.TrivialEntropy = req.TrivialEntropy
 	return abci.ResponseBeginBlock{}
 }
 
@@ -28,6 +28,15 @@ type BlockStore interface {
 	LoadSeenCommit(height int64) *types.Commit
 }
 
+//-----------------------------------------------------------------------------
+// entropy store
+
+// EntropyStore defines the interface used by the BlockExecutor to save the entropy of
+// committed blocks.
+type EntropyStore interface {
+	SaveEntropy(height int64, entropy *types.BlockEntropy)
+}
+
 //-----------------------------------------------------------------------------
 // evidence pool
 
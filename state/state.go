@@ -81,7 +81,10 @@ type State struct {
 	// the latest AppHash we've received from calling abci.Commit()
 	AppHash []byte
 
+	// Most recent non-empty entropy and the height of the block it was included in. New
+	// entropy is a group signature on the hash of this entropy.
 	LastComputedEntropy            types.ThresholdSignature
+	LastComputedEntropyHeight      int64
 	DKGValidators                  *types.ValidatorSet
 	LastHeightDKGValidatorsChanged int64
 }
@@ -109,6 +112,7 @@ func (state State) Copy() State {
 		LastResultsHash: state.LastResultsHash,
 
 		LastComputedEntropy:            state.LastComputedEntropy,
+		LastComputedEntropyHeight:      state.LastComputedEntropyHeight,
 		DKGValidators:                  state.DKGValidators.Copy(),
 		LastHeightDKGValidatorsChanged: state.LastHeightDKGValidatorsChanged,
 	}
@@ -256,6 +260,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 
 		AppHash:                        genDoc.AppHash,
 		LastComputedEntropy:            []byte(genDoc.Entropy),
+		LastComputedEntropyHeight:      types.GenesisHeight,
 		DKGValidators:                  validatorSet,
 		LastHeightDKGValidatorsChanged: 1,
 	}, nil
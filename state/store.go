@@ -2,8 +2,10 @@ package state
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/types"
@@ -36,6 +38,33 @@ func calcABCIResponsesKey(height int64) []byte {
 	return []byte(fmt.Sprintf("abciResponsesKey:%v", height))
 }
 
+func calcAeonKey(dkgID int64) []byte {
+	return []byte(fmt.Sprintf("aeonKey:%v", dkgID))
+}
+
+// calcAeonStartKey is zero padded so aeons are iterated in order of start height
+func calcAeonStartKey(start int64) []byte {
+	return []byte(fmt.Sprintf("aeonStart:%020d", start))
+}
+
+func calcAeonDryRunsKey(dkgID int64) []byte {
+	return []byte(fmt.Sprintf("aeonDryRunsKey:%v", dkgID))
+}
+
+func calcPendingAeonDryRunsKey(dkgID int64) []byte {
+	return []byte(fmt.Sprintf("pendingAeonDryRunsKey:%v", dkgID))
+}
+
+func calcPreviousEntropyKey(height int64) []byte {
+	return []byte(fmt.Sprintf("previousEntropyKey:%v", height))
+}
+
+func calcDKGMessageKey(dkgID int64, msgType types.DKGMessageType, from crypto.Address) []byte {
+	return []byte(fmt.Sprintf("dkgMessageKey:%v:%v:%X", dkgID, msgType, from))
+}
+
+var dkgMessageIDsKey = []byte("dkgMessageIDsKey")
+
 // LoadStateFromDBOrGenesisFile loads the most recent state from the database,
 // or creates a new one from the given genesisFilePath and persists the result
 // to the database.
@@ -117,6 +146,9 @@ func saveState(db dbm.DB, state State, key []byte) {
 	saveConsensusParamsInfo(db, nextHeight, state.LastHeightConsensusParamsChanged, state.ConsensusParams)
 	// Save next DKG validators
 	saveDKGValidatorsInfo(db, nextHeight, state.LastHeightDKGValidatorsChanged, state.DKGValidators)
+	// Save entropy signed over by entropy shares for next block
+	savePreviousEntropy(db, nextHeight, state.LastComputedEntropyHeight, state.LastComputedEntropy,
+		state.LastBlockTime)
 	db.SetSync(key, state.Bytes())
 }
 
@@ -408,3 +440,294 @@ func saveDKGValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *t
 	}
 	db.Set(calcDKGValidatorsKey(height), valInfo.Bytes())
 }
+
+//-----------------------------------------------------------------------------
+
+// LoadAeonPublicInfo loads the public output of the DKG with the given id.
+// Returns ErrNoAeonForDKGID if the dkg has not completed or is unknown.
+func LoadAeonPublicInfo(db dbm.DB, dkgID int64) (*types.DKGOutput, error) {
+	buf, err := db.Get(calcAeonKey(dkgID))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil, ErrNoAeonForDKGID{dkgID}
+	}
+
+	aeon := new(types.DKGOutput)
+	err = cdc.UnmarshalBinaryBare(buf, aeon)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadAeonPublicInfo: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return aeon, nil
+}
+
+// saveAeonPublicInfo persists the public output of a successful DKG so that entropy
+// generated by the aeon can be verified by nodes which do not hold its private key shares.
+// Only called once the output is agreed in dry runs on chain, so that all nodes store the same aeons,
+// and never replaced. Aeons are also indexed by start height.
+func saveAeonPublicInfo(db dbm.DB, dkgID int64, aeon *types.DKGOutput) {
+	db.Set(calcAeonStartKey(aeon.Start), cdc.MustMarshalBinaryBare(dkgID))
+	db.SetSync(calcAeonKey(dkgID), cdc.MustMarshalBinaryBare(aeon))
+}
+
+// LoadAeonForHeight loads the public info of the aeon committed on chain which covers height.
+// Returns ErrNoAeonForHeight if there is none, in which case the height has trivial entropy.
+func LoadAeonForHeight(db dbm.DB, height int64) (*types.DKGOutput, error) {
+	itr, err := db.ReverseIterator(calcAeonStartKey(0), calcAeonStartKey(height+1))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil, ErrNoAeonForHeight{height}
+	}
+	var dkgID int64
+	if err := cdc.UnmarshalBinaryBare(itr.Value(), &dkgID); err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadAeonForHeight: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	aeon, err := LoadAeonPublicInfo(db, dkgID)
+	if err != nil {
+		return nil, err
+	}
+	if height > aeon.End {
+		return nil, ErrNoAeonForHeight{height}
+	}
+	return aeon, nil
+}
+
+// IsTrivialEntropy returns true if no aeon with keys committed on chain covers height, so that blocks
+// at the height have empty entropy. Blocks covered by such an aeon only have empty entropy if the
+// beacon timed out.
+func IsTrivialEntropy(db dbm.DB, height int64) bool {
+	aeon, err := LoadAeonForHeight(db, height)
+	return err != nil || aeon.IsKeyless()
+}
+
+// aeonDryRuns wraps the dry run messages of a dkg for storage
+type aeonDryRuns struct {
+	DryRuns []*types.DKGMessage
+}
+
+// LoadAeonDryRuns loads the signed dry run messages in which validators agreed to the output
+// of the DKG with the given id. Returns ErrNoAeonForDKGID if none are stored.
+func LoadAeonDryRuns(db dbm.DB, dkgID int64) ([]*types.DKGMessage, error) {
+	buf, err := db.Get(calcAeonDryRunsKey(dkgID))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil, ErrNoAeonForDKGID{dkgID}
+	}
+
+	dryRuns := new(aeonDryRuns)
+	err = cdc.UnmarshalBinaryBare(buf, dryRuns)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadAeonDryRuns: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return dryRuns.DryRuns, nil
+}
+
+// saveAeonDryRuns persists the signed dry run messages agreeing to the output of a successful DKG.
+func saveAeonDryRuns(db dbm.DB, dkgID int64, dryRuns []*types.DKGMessage) {
+	db.SetSync(calcAeonDryRunsKey(dkgID), cdc.MustMarshalBinaryBare(&aeonDryRuns{DryRuns: dryRuns}))
+}
+
+// loadPendingAeonDryRuns loads the dry run messages seen on chain for a DKG whose output
+// has not yet been committed.
+func loadPendingAeonDryRuns(db dbm.DB, dkgID int64) []*types.DKGMessage {
+	buf, err := db.Get(calcPendingAeonDryRunsKey(dkgID))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+
+	dryRuns := new(aeonDryRuns)
+	err = cdc.UnmarshalBinaryBare(buf, dryRuns)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadPendingAeonDryRuns: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return dryRuns.DryRuns
+}
+
+func savePendingAeonDryRuns(db dbm.DB, dkgID int64, dryRuns []*types.DKGMessage) {
+	db.Set(calcPendingAeonDryRunsKey(dkgID), cdc.MustMarshalBinaryBare(&aeonDryRuns{DryRuns: dryRuns}))
+}
+
+func deletePendingAeonDryRuns(db dbm.DB, dkgID int64) {
+	db.Delete(calcPendingAeonDryRunsKey(dkgID))
+}
+
+//-----------------------------------------------------------------------------
+
+// previousEntropyInfo wraps the entropy chained from at a height, and the height of the block
+// containing it, for storage. Also records the time of the last block before the height.
+type previousEntropyInfo struct {
+	Entropy       types.ThresholdSignature
+	Height        int64
+	LastBlockTime time.Time
+}
+
+// LoadPreviousEntropy loads the last entropy computed before height, the hash of which
+// entropy shares for the height sign. Empty if no entropy had been computed.
+func LoadPreviousEntropy(db dbm.DB, height int64) (types.ThresholdSignature, error) {
+	info, err := loadPreviousEntropyInfo(db, height)
+	if err != nil {
+		return nil, err
+	}
+	return info.Entropy, nil
+}
+
+// LoadPreviousEntropyHeight loads the height of the block containing the last entropy
+// computed before height. GenesisHeight if no entropy had been computed.
+func LoadPreviousEntropyHeight(db dbm.DB, height int64) (int64, error) {
+	info, err := loadPreviousEntropyInfo(db, height)
+	if err != nil {
+		return 0, err
+	}
+	return info.Height, nil
+}
+
+// LoadBlockTime loads the time of the committed block at height, which timestamps evidence against
+// entropy shares for the height.
+func LoadBlockTime(db dbm.DB, height int64) (time.Time, error) {
+	info, err := loadPreviousEntropyInfo(db, height+1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.LastBlockTime, nil
+}
+
+func loadPreviousEntropyInfo(db dbm.DB, height int64) (*previousEntropyInfo, error) {
+	buf, err := db.Get(calcPreviousEntropyKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil, ErrNoPreviousEntropyForHeight{height}
+	}
+
+	info := new(previousEntropyInfo)
+	err = cdc.UnmarshalBinaryLengthPrefixed(buf, info)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadPreviousEntropy: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return info, nil
+}
+
+// savePreviousEntropy is length prefixed so empty entropy can be told apart from a missing height
+func savePreviousEntropy(db dbm.DB, height int64, entropyHeight int64, entropy types.ThresholdSignature,
+	lastBlockTime time.Time) {
+	db.Set(calcPreviousEntropyKey(height), cdc.MustMarshalBinaryLengthPrefixed(&previousEntropyInfo{
+		Entropy:       entropy,
+		Height:        entropyHeight,
+		LastBlockTime: lastBlockTime,
+	}))
+}
+
+//-----------------------------------------------------------------------------
+
+// dkgMessageIDs lists the dkgs with messages stored for verifying evidence
+type dkgMessageIDs struct {
+	DKGIDs []int64
+}
+
+// LoadDKGMessage loads the latest dkg message of msgType committed on chain by from, in the
+// dkg with the given id. Only coefficients and complaint answers, which are needed to
+// verify DKGComplaintEvidence, are stored.
+func LoadDKGMessage(db dbm.DB, dkgID int64, msgType types.DKGMessageType,
+	from crypto.Address) (*types.DKGMessage, error) {
+	buf, err := db.Get(calcDKGMessageKey(dkgID, msgType, from))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil, fmt.Errorf("could not find dkg message of type %v from %X for dkg id %d", msgType, from, dkgID)
+	}
+
+	msg := new(types.DKGMessage)
+	err = cdc.UnmarshalBinaryBare(buf, msg)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadDKGMessage: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return msg, nil
+}
+
+func saveDKGMessage(db dbm.DB, msg *types.DKGMessage) {
+	ids := loadDKGMessageIDs(db)
+	found := false
+	for _, id := range ids {
+		if id == msg.DKGID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		db.Set(dkgMessageIDsKey, cdc.MustMarshalBinaryBare(&dkgMessageIDs{DKGIDs: append(ids, msg.DKGID)}))
+	}
+	db.Set(calcDKGMessageKey(msg.DKGID, msg.Type, msg.FromAddress), cdc.MustMarshalBinaryBare(msg))
+}
+
+func loadDKGMessageIDs(db dbm.DB) []int64 {
+	buf, err := db.Get(dkgMessageIDsKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+
+	ids := new(dkgMessageIDs)
+	err = cdc.UnmarshalBinaryBare(buf, ids)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`loadDKGMessageIDs: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+	return ids.DKGIDs
+}
+
+// pruneDKGMessages deletes the stored messages of all dkgs with id up to and including dkgID. As
+// DKGComplaintEvidence has the height of its dkg id, it expires with the last block height more
+// than the max evidence age in blocks past the dkg id.
+func pruneDKGMessages(db dbm.DB, dkgID int64) {
+	ids := loadDKGMessageIDs(db)
+	if len(ids) == 0 || dkgID < 0 {
+		return
+	}
+	remaining := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id > dkgID {
+			remaining = append(remaining, id)
+			continue
+		}
+		vals, err := LoadValidators(db, id)
+		if err != nil {
+			continue
+		}
+		for _, val := range vals.Validators {
+			db.Delete(calcDKGMessageKey(id, types.DKGCoefficient, val.Address))
+			db.Delete(calcDKGMessageKey(id, types.DKGComplaintAnswer, val.Address))
+		}
+	}
+	if len(remaining) == 0 {
+		db.Delete(dkgMessageIDsKey)
+		return
+	}
+	db.Set(dkgMessageIDsKey, cdc.MustMarshalBinaryBare(&dkgMessageIDs{DKGIDs: remaining}))
+}
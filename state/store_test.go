@@ -35,6 +35,40 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreLoadAeonForHeight(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	makeAeon := func(start, end int64) *types.DKGOutput {
+		return &types.DKGOutput{GroupPublicKey: "group_public_key", Start: start, End: end}
+	}
+	sm.SaveAeonPublicInfo(stateDB, 1, makeAeon(10, 19))
+	// Heights between aeons have trivial entropy
+	sm.SaveAeonPublicInfo(stateDB, 11, makeAeon(25, 34))
+
+	testCases := []struct {
+		height int64
+		start  int64
+	}{
+		{9, -1},
+		{10, 10},
+		{19, 10},
+		{20, -1},
+		{25, 25},
+		{34, 25},
+		{35, -1},
+	}
+	for _, tc := range testCases {
+		aeon, err := sm.LoadAeonForHeight(stateDB, tc.height)
+		if tc.start < 0 {
+			assert.Error(t, err, "height %v", tc.height)
+			assert.True(t, sm.IsTrivialEntropy(stateDB, tc.height), "height %v", tc.height)
+			continue
+		}
+		require.NoError(t, err, "height %v", tc.height)
+		assert.Equal(t, tc.start, aeon.Start, "height %v", tc.height)
+		assert.False(t, sm.IsTrivialEntropy(stateDB, tc.height), "height %v", tc.height)
+	}
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -13,7 +13,8 @@ import (
 //-----------------------------------------------------
 // Validate block
 
-func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block *types.Block) error {
+func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, entropyVerifier types.GroupSignatureVerifier,
+	evidenceVerifier types.BeaconEvidenceVerifier, state State, block *types.Block) error {
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -79,6 +80,11 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 		)
 	}
 
+	// Validate block entropy
+	if err := validateBlockEntropy(stateDB, entropyVerifier, state, block); err != nil {
+		return err
+	}
+
 	// Validate block LastCommit.
 	if block.Height == 1 {
 		if len(block.LastCommit.Signatures) != 0 {
@@ -131,7 +137,7 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 
 	// Validate all evidence.
 	for _, ev := range block.Evidence.Evidence {
-		if err := VerifyEvidence(stateDB, state, ev); err != nil {
+		if err := VerifyEvidence(stateDB, state, ev, evidenceVerifier); err != nil {
 			return types.NewErrEvidenceInvalid(ev, err)
 		}
 		if evidencePool != nil && evidencePool.IsCommitted(ev) {
@@ -152,12 +158,60 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 	return nil
 }
 
+// validateBlockEntropy checks that non-empty block entropy was generated by the aeon with the
+// dkg id given in the entropy and is a group signature on the hash of the last computed entropy.
+// If there is no previous entropy then the first aeon signs the hash of its group public key.
+// The block must also commit to the public info of the aeon by hash.
+// Skipped if no entropy verifier is provided.
+//
+// Empty entropy is only valid at heights not covered by an aeon with keys committed on chain,
+// or in a block which falls back to empty entropy after the beacon timed out. Such a block must
+// carry prevotes, flagged as timed out, from validators with more than two thirds of the voting
+// power. The entropy timeout is not hashed into the header, as the header already commits to
+// the empty entropy and the timeout only justifies it. The timeout is committed to by the part
+// set header of the block id which the commit for the block signs, so it can not be changed
+// once the block is committed.
+func validateBlockEntropy(stateDB dbm.DB, entropyVerifier types.GroupSignatureVerifier,
+	state State, block *types.Block) error {
+	if block.EntropyTimeout != nil {
+		err := state.Validators.VerifyEntropyTimeout(state.ChainID, block.Height, block.EntropyTimeout)
+		if err != nil {
+			return fmt.Errorf("wrong Block.EntropyTimeout: %v", err)
+		}
+	}
+	if types.IsEmptyBlockEntropy(&block.Entropy) {
+		if block.EntropyTimeout == nil && !IsTrivialEntropy(stateDB, block.Height) {
+			return errors.New("empty Block.Header.Entropy during an aeon without Block.EntropyTimeout")
+		}
+		return nil
+	}
+	if entropyVerifier == nil {
+		return nil
+	}
+
+	aeon, err := LoadAeonPublicInfo(stateDB, block.Entropy.DKGID)
+	if err != nil {
+		return fmt.Errorf("wrong Block.Header.Entropy: %v", err)
+	}
+	if !bytes.Equal(block.AeonHash, aeon.Hash()) {
+		return fmt.Errorf("wrong Block.Header.AeonHash. Expected %X, got %v", aeon.Hash(), block.AeonHash)
+	}
+	err = types.VerifyBlockEntropy(entropyVerifier, aeon, block.Height, &block.Entropy, state.LastComputedEntropy)
+	if err != nil {
+		return fmt.Errorf("wrong Block.Header.Entropy: %v", err)
+	}
+	return nil
+}
+
 // VerifyEvidence verifies the evidence fully by checking:
 // - it is sufficiently recent (MaxAge)
 // - it is from a key who was a validator at the given height
 // - it is internally consistent
 // - it was properly signed by the alleged equivocator
-func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
+// Beacon evidence is from a member of the validator set which ran the dkg, and its threshold
+// cryptography is checked by the verifier. Beacon evidence is rejected if the verifier is nil.
+func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence,
+	verifier types.BeaconEvidenceVerifier) error {
 	var (
 		height         = state.LastBlockHeight
 		evidenceParams = state.ConsensusParams.Evidence
@@ -175,7 +229,7 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 			evidence.Time(), state.LastBlockTime.Add(evidenceParams.MaxAgeDuration))
 	}
 
-	valset, err := LoadValidators(stateDB, evidence.Height())
+	valset, err := LoadEvidenceValidators(stateDB, evidence)
 	if err != nil {
 		// TODO: if err is just that we cant find it cuz we pruned, ignore.
 		// TODO: if its actually bad evidence, punish peer
@@ -198,5 +252,130 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 		return err
 	}
 
+	return verifyBeaconEvidence(stateDB, state.ChainID, valset, evidence, verifier)
+}
+
+// LoadEvidenceValidators loads the validator set the accused belonged to. For entropy share
+// evidence this is the validator set of the aeon, and for dkg evidence it is the validator set
+// running the dkg, which has height equal to the dkg id.
+func LoadEvidenceValidators(stateDB dbm.DB, evidence types.Evidence) (*types.ValidatorSet, error) {
+	switch ev := evidence.(type) {
+	case *types.InvalidEntropyShareEvidence:
+		return loadAeonValidators(stateDB, ev.DKGID, ev.Height())
+	case *types.DKGComplaintEvidence:
+		return LoadValidators(stateDB, ev.DKGID)
+	default:
+		return LoadValidators(stateDB, evidence.Height())
+	}
+}
+
+func loadAeonValidators(stateDB dbm.DB, dkgID int64, height int64) (*types.ValidatorSet, error) {
+	aeon, err := LoadAeonPublicInfo(stateDB, dkgID)
+	if err != nil {
+		return nil, err
+	}
+	if height < aeon.Start || height > aeon.End {
+		return nil, fmt.Errorf("height %v outside aeon [%v, %v]", height, aeon.Start, aeon.End)
+	}
+	return LoadValidators(stateDB, aeon.ValidatorHeight)
+}
+
+// verifyBeaconEvidence checks the threshold cryptography of beacon evidence against the aeon and
+// dkg messages committed on chain, and the time of entropy share evidence. Other evidence is ignored.
+func verifyBeaconEvidence(stateDB dbm.DB, chainID string, valset *types.ValidatorSet, evidence types.Evidence,
+	verifier types.BeaconEvidenceVerifier) error {
+	switch ev := evidence.(type) {
+	case *types.InvalidEntropyShareEvidence:
+		if err := verifyEntropyShareEvidenceTime(stateDB, ev); err != nil {
+			return err
+		}
+		if verifier == nil {
+			return errors.New("no verifier for invalid entropy share evidence")
+		}
+		aeon, err := LoadAeonPublicInfo(stateDB, ev.DKGID)
+		if err != nil {
+			return err
+		}
+		if aeon.IsKeyless() {
+			return fmt.Errorf("aeon for dkg id %v has no keys", ev.DKGID)
+		}
+		index, _ := valset.GetByAddress(ev.Address())
+		inQual := false
+		for _, member := range aeon.Qual {
+			if member == uint(index) {
+				inQual = true
+				break
+			}
+		}
+		if !inQual {
+			return fmt.Errorf("validator %X not in qual of aeon for dkg id %v", ev.Address(), ev.DKGID)
+		}
+		previousEntropy, err := LoadPreviousEntropy(stateDB, ev.Height())
+		if err != nil {
+			return err
+		}
+		if len(previousEntropy) == 0 {
+			previousEntropy = types.InitialEntropy(aeon.GroupPublicKey)
+		}
+		if verifier.VerifySignatureShare(aeon, types.EntropyMessage(previousEntropy), ev.Share.SignatureShare,
+			uint(index)) {
+			return errors.New("entropy share is valid")
+		}
+	case *types.DKGComplaintEvidence:
+		if verifier == nil {
+			return errors.New("no verifier for dkg complaint evidence")
+		}
+		complainerIndex, _ := valset.GetByAddress(ev.Complainer)
+		if complainerIndex < 0 {
+			return fmt.Errorf("complainer %X was not a member of dkg %v", ev.Complainer, ev.DKGID)
+		}
+		coefficients, err := loadComplaintEvidenceMessage(stateDB, chainID, valset, ev, types.DKGCoefficient)
+		if err != nil {
+			return err
+		}
+		answers, err := loadComplaintEvidenceMessage(stateDB, chainID, valset, ev, types.DKGComplaintAnswer)
+		if err != nil {
+			return err
+		}
+		// Dkgs use the key type in the entropy params at their validator height, which is the dkg id
+		params, err := LoadConsensusParams(stateDB, ev.DKGID)
+		if err != nil {
+			return err
+		}
+		if verifier.VerifyComplaintAnswer(coefficients.Data, answers.Data, uint(complainerIndex),
+			types.DKGThreshold(valset.Size()), params.Entropy.KeyType) {
+			return errors.New("complaint answer is valid")
+		}
+	}
+	return nil
+}
+
+// verifyEntropyShareEvidenceTime checks entropy share evidence has the time of the committed block at
+// its height, so that its age does not depend on when the reporting node received the shares
+func verifyEntropyShareEvidenceTime(stateDB dbm.DB, evidence types.Evidence) error {
+	blockTime, err := LoadBlockTime(stateDB, evidence.Height())
+	if err != nil {
+		return err
+	}
+	if !evidence.Time().Equal(blockTime) {
+		return fmt.Errorf("evidence time %v does not match time %v of block at height %v", evidence.Time(),
+			blockTime, evidence.Height())
+	}
 	return nil
 }
+
+func loadComplaintEvidenceMessage(stateDB dbm.DB, chainID string, valset *types.ValidatorSet,
+	ev *types.DKGComplaintEvidence, msgType types.DKGMessageType) (*types.DKGMessage, error) {
+	msg, err := LoadDKGMessage(stateDB, ev.DKGID, msgType, ev.Address())
+	if err != nil {
+		return nil, err
+	}
+	if msg.DKGIteration != ev.DKGIteration {
+		return nil, fmt.Errorf("dkg message of type %v from %X is for iteration %v, expected %v", msgType,
+			ev.Address(), msg.DKGIteration, ev.DKGIteration)
+	}
+	if err := valset.VerifyDKGMessage(chainID, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -269,3 +270,193 @@ func TestValidateFailBlockOnCommittedEvidence(t *testing.T) {
 	require.Error(t, err)
 	require.IsType(t, err, &types.ErrEvidenceInvalid{})
 }
+
+type mockEntropyVerifier struct {
+	message   string
+	signature types.ThresholdSignature
+}
+
+func (v mockEntropyVerifier) VerifyGroupSignature(aeon *types.DKGOutput, message string,
+	signature types.ThresholdSignature) bool {
+	return message == v.message && bytes.Equal(signature, v.signature)
+}
+
+func TestValidateBlockEntropy(t *testing.T) {
+	var height int64 = 1
+	state, stateDB, _ := makeState(1, int(height))
+
+	aeon := &types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		PublicKeyShares: []string{"public_key_share"},
+		Generator:       "generator",
+		ValidatorHeight: 1,
+		Qual:            []uint{0},
+		Start:           1,
+		End:             10,
+	}
+	signature := tmhash.Sum([]byte("group_signature"))
+	verifier := mockEntropyVerifier{
+		message:   types.EntropyMessage(types.InitialEntropy(aeon.GroupPublicKey)),
+		signature: signature,
+	}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, sm.MockEvidencePool{},
+		sm.BlockExecutorWithEntropyVerifier(verifier))
+
+	// Empty entropy is accepted without an aeon
+	block := makeBlock(state, height)
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// Entropy from an unknown aeon is rejected
+	block.Entropy = *types.NewBlockEntropy(signature, 0, 9, 1)
+	require.Error(t, blockExec.ValidateBlock(state, block))
+
+	sm.SaveAeonPublicInfo(stateDB, 1, aeon)
+	block.AeonHash = aeon.Hash()
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// Empty entropy during the aeon is rejected without an entropy timeout
+	emptyBlock := makeBlock(state, height)
+	require.Error(t, blockExec.ValidateBlock(state, emptyBlock))
+
+	testCases := []struct {
+		name    string
+		entropy *types.BlockEntropy
+	}{
+		{"bad signature", types.NewBlockEntropy(tmhash.Sum([]byte("bad")), 0, 9, 1)},
+		{"wrong round", types.NewBlockEntropy(signature, 1, 9, 1)},
+		{"wrong aeon length", types.NewBlockEntropy(signature, 0, 10, 1)},
+		{"wrong dkg id", types.NewBlockEntropy(signature, 0, 9, 2)},
+	}
+	for _, tc := range testCases {
+		block.Entropy = *tc.entropy
+		require.Error(t, blockExec.ValidateBlock(state, block), tc.name)
+	}
+
+	// Block must commit to the public info of the aeon
+	block.Entropy = *types.NewBlockEntropy(signature, 0, 9, 1)
+	block.AeonHash = tmhash.Sum([]byte("other_aeon"))
+	require.Error(t, blockExec.ValidateBlock(state, block))
+	block.AeonHash = aeon.Hash()
+
+	// Entropy is chained from the last computed entropy when present
+	state.LastComputedEntropy = tmhash.Sum([]byte("previous_entropy"))
+	block.Entropy = *types.NewBlockEntropy(signature, 0, 9, 1)
+	require.Error(t, blockExec.ValidateBlock(state, block))
+	verifier.message = types.EntropyMessage(state.LastComputedEntropy)
+	blockExec = sm.NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, sm.MockEvidencePool{},
+		sm.BlockExecutorWithEntropyVerifier(verifier))
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+}
+
+func TestValidateBlockEntropyTimeout(t *testing.T) {
+	var height int64 = 1
+	state, stateDB, privVals := makeState(3, int(height))
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, sm.MockEvidencePool{})
+	sm.SaveAeonPublicInfo(stateDB, 1, &types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		PublicKeyShares: []string{"share0", "share1", "share2"},
+		Generator:       "generator",
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1, 2},
+		Start:           1,
+		End:             10,
+	})
+
+	var votes []*types.Vote
+	for idx, val := range state.Validators.Validators {
+		vote := &types.Vote{
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   idx,
+			Height:           height,
+			Round:            0,
+			Timestamp:        tmtime.Now(),
+			Type:             types.PrevoteType,
+			EntropyTimedOut:  true,
+		}
+		require.NoError(t, privVals[val.Address.String()].SignVote(state.ChainID, vote))
+		votes = append(votes, vote)
+	}
+
+	// Falling back to empty entropy during an aeon needs more than two thirds of the voting power
+	block := makeBlock(state, height)
+	require.Error(t, blockExec.ValidateBlock(state, block))
+	block.EntropyTimeout = &types.EntropyTimeout{Votes: votes[:2]}
+	require.Error(t, blockExec.ValidateBlock(state, block))
+
+	block.EntropyTimeout = &types.EntropyTimeout{Votes: votes}
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// Votes must be signed by the validators
+	badVote := votes[2].Copy()
+	badVote.Signature = tmhash.Sum([]byte("bad_signature"))
+	block.EntropyTimeout = &types.EntropyTimeout{Votes: append(votes[:2:2], badVote)}
+	require.Error(t, blockExec.ValidateBlock(state, block))
+}
+
+type mockBeaconEvidenceVerifier struct {
+	validShare   string
+	validAnswers string
+}
+
+func (v mockBeaconEvidenceVerifier) VerifySignatureShare(aeon *types.DKGOutput, message string, share string,
+	index uint) bool {
+	return share == v.validShare
+}
+
+func (v mockBeaconEvidenceVerifier) VerifyComplaintAnswer(coefficients string, answers string, complainer uint,
+	threshold uint, keyType string) bool {
+	return answers == v.validAnswers
+}
+
+func TestVerifyEntropyShareEvidence(t *testing.T) {
+	state, stateDB, privVals := makeState(2, 2)
+	_, val := state.Validators.GetByIndex(0)
+	privVal := privVals[val.Address.String()]
+
+	// Commit block 2, whose time entropy share evidence at height 2 must have
+	state.LastBlockHeight++
+	state.LastBlockTime = tmtime.Now()
+	sm.SaveState(stateDB, state)
+	blockTime := state.LastBlockTime
+
+	makeShare := func(height int64, signatureShare string) *types.EntropyShare {
+		share := &types.EntropyShare{Height: height, SignerAddress: val.Address, SignatureShare: signatureShare}
+		require.NoError(t, privVal.SignEntropy(chainID, share))
+		return share
+	}
+	verifier := mockBeaconEvidenceVerifier{validShare: "valid_share"}
+	invalidShare := types.NewInvalidEntropyShareEvidence(val.PubKey, 1, makeShare(2, "invalid_share"), blockTime)
+
+	// Evidence from an unknown aeon is rejected
+	require.Error(t, sm.VerifyEvidence(stateDB, state, invalidShare, verifier))
+
+	sm.SaveAeonPublicInfo(stateDB, 1, &types.DKGOutput{
+		GroupPublicKey:  "group_public_key",
+		PublicKeyShares: []string{"share0", "share1"},
+		Generator:       "generator",
+		ValidatorHeight: 1,
+		Qual:            []uint{0, 1},
+		Start:           1,
+		End:             10,
+	})
+	require.NoError(t, sm.VerifyEvidence(stateDB, state, invalidShare, verifier))
+
+	testCases := []struct {
+		name     string
+		evidence types.Evidence
+		verifier types.BeaconEvidenceVerifier
+	}{
+		{"no verifier", invalidShare, nil},
+		{"valid share", types.NewInvalidEntropyShareEvidence(val.PubKey, 1, makeShare(2, "valid_share"),
+			blockTime), verifier},
+		{"uncommitted height", types.NewInvalidEntropyShareEvidence(val.PubKey, 1, makeShare(3, "invalid_share"),
+			blockTime), verifier},
+		{"invalid share wrong time", types.NewInvalidEntropyShareEvidence(val.PubKey, 1,
+			makeShare(2, "invalid_share"), blockTime.Add(time.Second)), verifier},
+		{"height outside aeon", types.NewInvalidEntropyShareEvidence(val.PubKey, 1, makeShare(11, "invalid_share"),
+			blockTime), verifier},
+	}
+	for _, tc := range testCases {
+		require.Error(t, sm.VerifyEvidence(stateDB, state, tc.evidence, tc.verifier), tc.name)
+	}
+}
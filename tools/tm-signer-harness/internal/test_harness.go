@@ -9,7 +9,9 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
+	"github.com/tendermint/tendermint/beacon"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/keyfile"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/state"
 
@@ -32,8 +34,26 @@ const (
 	ErrTestPublicKeyFailed                // 8
 	ErrTestSignProposalFailed             // 9
 	ErrTestSignVoteFailed                 // 10
+	ErrFailedToLoadAeonKeys               // 11
+	ErrTestSignAeonShareFailed            // 12
+	ErrTestDKGStepsFailed                 // 13
 )
 
+// testDKGID is the id of the dkg run with the remote signer. It is older than
+// any dkg run by a node, so is replaced by the first dkg the signer runs.
+const testDKGID = 0
+
+// testDKGRounds are the messages exchanged in each round of the test dkg, in
+// the order they are sent by nodes
+var testDKGRounds = [][]types.DKGMessageType{
+	{types.DKGShare, types.DKGCoefficient},
+	{types.DKGComplaint},
+	{types.DKGComplaintAnswer},
+	{types.DKGQualCoefficient},
+	{types.DKGQualComplaint},
+	{types.DKGReconstructionShare},
+}
+
 var voteTypes = []types.SignedMsgType{types.PrevoteType, types.PrecommitType}
 
 // TestHarnessError allows us to keep track of which exit code should be used
@@ -57,6 +77,11 @@ type TestHarness struct {
 	logger           log.Logger
 	exitWhenComplete bool
 	exitCode         int
+
+	// Aeon keys expected to be held by the remote signer, if testing aeon signing
+	aeonSigner   *beacon.AeonKeySigner
+	aeonDKGIDs   []int64
+	testAeonKeys bool
 }
 
 // TestHarnessConfig provides configuration to set up a remote signer test
@@ -68,6 +93,12 @@ type TestHarnessConfig struct {
 	StateFile   string
 	GenesisFile string
 
+	// Aeon key file held by the remote signer. If set, signing of aeon shares is
+	// tested. The key file is decrypted with the key in KeyEncryptionKeyFile, if
+	// set
+	AeonKeyFile          string
+	KeyEncryptionKeyFile string
+
 	AcceptDeadline time.Duration
 	ConnDeadline   time.Duration
 	AcceptRetries  int
@@ -112,7 +143,7 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
 	}
 
-	return &TestHarness{
+	th := &TestHarness{
 		addr:             cfg.BindAddr,
 		signerClient:     signerClient,
 		fpv:              fpv,
@@ -121,7 +152,41 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 		logger:           logger,
 		exitWhenComplete: cfg.ExitWhenComplete,
 		exitCode:         0,
-	}, nil
+	}
+	if len(cfg.AeonKeyFile) != 0 {
+		if err := th.loadAeonKeys(ExpandPath(cfg.AeonKeyFile), ExpandPath(cfg.KeyEncryptionKeyFile)); err != nil {
+			return nil, newTestHarnessError(ErrFailedToLoadAeonKeys, err, cfg.AeonKeyFile)
+		}
+	}
+	return th, nil
+}
+
+// loadAeonKeys loads the aeon key shares which the remote signer is expected to
+// hold.
+func (th *TestHarness) loadAeonKeys(aeonKeyFile, keyEncryptionKeyFile string) error {
+	th.logger.Info("Loading aeon keys", "aeonKeyFile", aeonKeyFile)
+	keyEncryptionKey, err := keyfile.LoadKeyEncryptionKey(keyEncryptionKeyFile)
+	if err != nil {
+		return err
+	}
+	aeonFiles, err := beacon.LoadAeonDetailsFiles(aeonKeyFile, keyEncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	beacon.InitialiseMcl()
+	th.aeonSigner = beacon.NewAeonKeySigner()
+	for _, aeonFile := range aeonFiles {
+		if len(aeonFile.PrivateKey) == 0 {
+			continue
+		}
+		if err := th.aeonSigner.AddAeon(aeonFile); err != nil {
+			return err
+		}
+		th.aeonDKGIDs = append(th.aeonDKGIDs, aeonFile.DKGID())
+	}
+	th.testAeonKeys = true
+	return nil
 }
 
 // Run will execute the tests associated with this test harness. The intention
@@ -181,6 +246,16 @@ func (th *TestHarness) Run() {
 		th.Shutdown(err)
 		return
 	}
+	if th.testAeonKeys {
+		if err := th.TestSignAeonShare(); err != nil {
+			th.Shutdown(err)
+			return
+		}
+		if err := th.TestDKGSteps(); err != nil {
+			th.Shutdown(err)
+			return
+		}
+	}
 	th.logger.Info("SUCCESS! All tests passed.")
 	th.Shutdown(nil)
 }
@@ -285,6 +360,165 @@ func (th *TestHarness) TestSignVote() error {
 	return nil
 }
 
+// TestSignAeonShare makes sure the remote signer computes the same signature
+// shares as the aeon key shares in the local aeon key file.
+func (th *TestHarness) TestSignAeonShare() error {
+	th.logger.Info("TEST: Signing of aeon shares")
+	if len(th.aeonDKGIDs) == 0 {
+		th.logger.Error("FAILED: No aeon key shares in aeon key file")
+		return newTestHarnessError(ErrTestSignAeonShareFailed, nil, "no aeon key shares")
+	}
+	message := string(tmhash.Sum([]byte("entropy")))
+	for _, dkgID := range th.aeonDKGIDs {
+		want, err := th.aeonSigner.SignAeonShare(dkgID, message)
+		if err != nil {
+			return newTestHarnessError(ErrOther, err, "")
+		}
+		have, err := th.signerClient.SignAeonShare(dkgID, message)
+		if err != nil {
+			th.logger.Error("FAILED: Signing of aeon share", "dkgID", dkgID, "err", err)
+			return newTestHarnessError(ErrTestSignAeonShareFailed, err, fmt.Sprintf("dkgID=%d", dkgID))
+		}
+		if have != want {
+			th.logger.Error("FAILED: Aeon share does not match local key share", "dkgID", dkgID)
+			return newTestHarnessError(ErrTestSignAeonShareFailed, nil, fmt.Sprintf("dkgID=%d", dkgID))
+		}
+		th.logger.Info("Successfully validated aeon share", "dkgID", dkgID)
+	}
+	return nil
+}
+
+// TestDKGSteps makes sure the remote signer runs a dkg with a local signer,
+// decrypting the shares sent to it with its ephemeral key and encrypting the
+// shares it deals. The dkg stops before computing keys, so that the remote
+// signer does not save an aeon for it.
+func (th *TestHarness) TestDKGSteps() error {
+	th.logger.Info("TEST: Running of dkg steps")
+	signers := []types.DKGSigner{th.signerClient, beacon.NewAeonKeySigner()}
+	cabinetSize := uint(len(signers))
+	encryptionKeys := make([][]byte, cabinetSize)
+	for index := range signers {
+		result, err := th.runDKGStep(signers, uint(index), &types.DKGStep{
+			Type:        types.DKGStepStart,
+			CabinetSize: cabinetSize,
+			Threshold:   cabinetSize,
+			Index:       uint(index),
+			KeyType:     beacon.GetAeonType(),
+		})
+		if err != nil {
+			return err
+		}
+		encryptionKeys[index] = result.EncryptionKey
+	}
+
+	for round, msgTypes := range testDKGRounds {
+		for _, msgType := range msgTypes {
+			if err := th.exchangeDKGMessages(signers, msgType, encryptionKeys); err != nil {
+				return err
+			}
+		}
+		for index := range signers {
+			result, err := th.runDKGStep(signers, uint(index), &types.DKGStep{Type: types.DKGStepReceivedAll,
+				MessageType: msgTypes[0]})
+			if err != nil {
+				return err
+			}
+			if !result.Success {
+				return th.dkgStepsFailed(uint(index), fmt.Sprintf("messages of round %d not all received", round))
+			}
+		}
+
+		var check *types.DKGStep
+		switch msgTypes[0] {
+		case types.DKGComplaintAnswer:
+			check = &types.DKGStep{Type: types.DKGStepBuildQual}
+		case types.DKGQualComplaint:
+			check = &types.DKGStep{Type: types.DKGStepCheckQualComplaints}
+		case types.DKGReconstructionShare:
+			check = &types.DKGStep{Type: types.DKGStepRunReconstruction}
+		default:
+			continue
+		}
+		for index := range signers {
+			result, err := th.runDKGStep(signers, uint(index), check)
+			if err != nil {
+				return err
+			}
+			if (check.Type == types.DKGStepBuildQual && result.QualSize != cabinetSize) ||
+				(check.Type != types.DKGStepBuildQual && !result.Success) {
+				return th.dkgStepsFailed(uint(index), fmt.Sprintf("dkg step %v failed", check.Type))
+			}
+		}
+	}
+	th.logger.Info("Successfully validated dkg steps")
+	return nil
+}
+
+// exchangeDKGMessages gets the messages of msgType from all signers before
+// passing them to the other signers, as nodes do. Shares are encrypted for each
+// recipient
+func (th *TestHarness) exchangeDKGMessages(signers []types.DKGSigner, msgType types.DKGMessageType,
+	encryptionKeys [][]byte) error {
+	msgs := make([][]string, len(signers))
+	for from := range signers {
+		msgs[from] = make([]string, len(signers))
+		if msgType != types.DKGShare {
+			result, err := th.runDKGStep(signers, uint(from), &types.DKGStep{Type: types.DKGStepGetMessage,
+				MessageType: msgType})
+			if err != nil {
+				return err
+			}
+			for to := range signers {
+				msgs[from][to] = result.Data
+			}
+			continue
+		}
+		for to := range signers {
+			if to == from {
+				continue
+			}
+			result, err := th.runDKGStep(signers, uint(from), &types.DKGStep{Type: types.DKGStepGetShare,
+				To: uint(to), EncryptionKey: encryptionKeys[to]})
+			if err != nil {
+				return err
+			}
+			msgs[from][to] = result.Data
+		}
+	}
+	for from := range signers {
+		for to := range signers {
+			if to == from {
+				continue
+			}
+			_, err := th.runDKGStep(signers, uint(to), &types.DKGStep{Type: types.DKGStepOnMessage,
+				MessageType: msgType, From: uint(from), Data: msgs[from][to], EncryptionKey: encryptionKeys[from]})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runDKGStep runs step of the test dkg with the signer with index. The remote
+// signer has index 0
+func (th *TestHarness) runDKGStep(signers []types.DKGSigner, index uint, step *types.DKGStep) (
+	*types.DKGStepResult, error) {
+	step.DKGID = testDKGID
+	result, err := signers[index].DKGStep(th.chainID, step)
+	if err != nil {
+		th.logger.Error("FAILED: Running of dkg step", "signer", index, "step", step.Type, "msgType",
+			step.MessageType, "err", err)
+		return nil, newTestHarnessError(ErrTestDKGStepsFailed, err, fmt.Sprintf("signer=%d step=%v", index, step.Type))
+	}
+	return result, nil
+}
+
+func (th *TestHarness) dkgStepsFailed(index uint, info string) error {
+	th.logger.Error("FAILED: "+info, "signer", index)
+	return newTestHarnessError(ErrTestDKGStepsFailed, nil, fmt.Sprintf("signer=%d %s", index, info))
+}
+
 // Shutdown will kill the test harness and attempt to close all open sockets
 // gracefully. If the supplied error is nil, it is assumed that the exit code
 // should be 0. If err is not nil, it will exit with an exit code related to the
@@ -386,6 +620,12 @@ func (e *TestHarnessError) Error() string {
 		msg = "Proposal signing validation test failed"
 	case ErrTestSignVoteFailed:
 		msg = "Vote signing validation test failed"
+	case ErrFailedToLoadAeonKeys:
+		msg = "Failed to load aeon keys"
+	case ErrTestSignAeonShareFailed:
+		msg = "Aeon share signing validation test failed"
+	case ErrTestDKGStepsFailed:
+		msg = "DKG step validation test failed"
 	default:
 		msg = "Unknown error"
 	}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
 
+	"github.com/tendermint/tendermint/beacon"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
@@ -55,7 +57,14 @@ const (
 			]
 		},
 		"entropy": {
-		  "aeon_length": "100"
+		  "aeon_length": "100",
+		  "dkg_state_duration": "5",
+		  "dkg_iteration_duration_increase": "50",
+		  "dkg_max_state_duration": "400",
+		  "dkg_reset_delay": "2",
+		  "dkg_reshare": false,
+		  "key_type": "GlowAeon",
+		  "proposer_sampling": "Shuffle"
 		}
 	},
 	"validators": [
@@ -125,6 +134,40 @@ func TestRemoteSignerVoteSigningFailed(t *testing.T) {
 	)
 }
 
+func TestRemoteSignerAeonKeysSuccessfulRun(t *testing.T) {
+	harnessTestWithConfig(
+		t,
+		makeAeonConfig(t),
+		func(th *TestHarness) *privval.SignerServer {
+			return newMockAeonSignerServer(t, th, th.aeonSigner)
+		},
+		NoError,
+	)
+}
+
+func TestRemoteSignerAeonShareSigningFailed(t *testing.T) {
+	harnessTestWithConfig(
+		t,
+		makeAeonConfig(t),
+		func(th *TestHarness) *privval.SignerServer {
+			return newMockSignerServer(t, th, th.fpv.Key.PrivKey, false, false)
+		},
+		ErrTestSignAeonShareFailed,
+	)
+}
+
+func TestRemoteSignerDKGStepsFailed(t *testing.T) {
+	harnessTestWithConfig(
+		t,
+		makeAeonConfig(t),
+		func(th *TestHarness) *privval.SignerServer {
+			// Only signs aeon shares, without running dkgs
+			return newMockAeonSignerServer(t, th, struct{ types.AeonSigner }{th.aeonSigner})
+		},
+		ErrTestDKGStepsFailed,
+	)
+}
+
 func newMockSignerServer(
 	t *testing.T,
 	th *TestHarness,
@@ -146,9 +189,32 @@ func newMockSignerServer(
 	return privval.NewSignerServer(dialerEndpoint, th.chainID, mockPV)
 }
 
+func newMockAeonSignerServer(t *testing.T, th *TestHarness, aeonSigner types.AeonSigner) *privval.SignerServer {
+	mockPV := types.NewMockPVWithParams(th.fpv.Key.PrivKey, false, false)
+
+	dialerEndpoint := privval.NewSignerDialerEndpoint(
+		th.logger,
+		privval.DialTCPFn(
+			th.addr,
+			time.Duration(defaultConnDeadline)*time.Millisecond,
+			ed25519.GenPrivKey(),
+		),
+	)
+
+	return privval.NewSignerServer(dialerEndpoint, th.chainID, privval.NewAeonSigningPV(mockPV, aeonSigner))
+}
+
 // For running relatively standard tests.
 func harnessTest(t *testing.T, signerServerMaker func(th *TestHarness) *privval.SignerServer, expectedExitCode int) {
-	cfg := makeConfig(t, 100, 3)
+	harnessTestWithConfig(t, makeConfig(t, 100, 3), signerServerMaker, expectedExitCode)
+}
+
+func harnessTestWithConfig(
+	t *testing.T,
+	cfg TestHarnessConfig,
+	signerServerMaker func(th *TestHarness) *privval.SignerServer,
+	expectedExitCode int,
+) {
 	defer cleanup(cfg)
 
 	th, err := NewTestHarness(log.TestingLogger(), cfg)
@@ -182,10 +248,43 @@ func makeConfig(t *testing.T, acceptDeadline, acceptRetries int) TestHarnessConf
 	}
 }
 
+// makeAeonConfig makes a config for also testing aeon share signing, with an
+// aeon key file holding the key share of the first of 4 validators.
+func makeAeonConfig(t *testing.T) TestHarnessConfig {
+	cfg := makeConfig(t, 100, 3)
+
+	beacon.InitialiseMcl()
+	aeonExecUnit := beacon.NewBlsAeon("../../../beacon/test_keys/validator_0_of_4.txt")
+	output := types.DKGOutput{
+		KeyType:         aeonExecUnit.Name(),
+		GroupPublicKey:  aeonExecUnit.GroupPublicKey(),
+		Generator:       aeonExecUnit.Generator(),
+		ValidatorHeight: 1,
+		Start:           1,
+		End:             10,
+	}
+	publicKeyShares := aeonExecUnit.PublicKeyShares()
+	for i := 0; i < int(publicKeyShares.Size()); i++ {
+		output.PublicKeyShares = append(output.PublicKeyShares, publicKeyShares.Get(i))
+	}
+	qual := aeonExecUnit.Qual()
+	for i := 0; i < int(qual.Size()); i++ {
+		output.Qual = append(output.Qual, qual.Get(i))
+	}
+
+	cdc := amino.NewCodec()
+	aeonFiles := []*beacon.AeonDetailsFile{{PublicInfo: output, PrivateKey: aeonExecUnit.PrivateKey()}}
+	cfg.AeonKeyFile = makeTempFile("tm-testharness-aeonkeyfile", string(cdc.MustMarshalJSON(aeonFiles)))
+	return cfg
+}
+
 func cleanup(cfg TestHarnessConfig) {
 	os.Remove(cfg.KeyFile)
 	os.Remove(cfg.StateFile)
 	os.Remove(cfg.GenesisFile)
+	if len(cfg.AeonKeyFile) != 0 {
+		os.Remove(cfg.AeonKeyFile)
+	}
 }
 
 func makeTempFile(name, content string) string {
@@ -32,6 +32,8 @@ var (
 	flagBindAddr      string
 	flagTMHome        string
 	flagKeyOutputPath string
+	flagAeonKeys      bool
+	flagKEKFile       string
 )
 
 // Command line commands
@@ -67,6 +69,14 @@ Use "tm-signer-harness help <command>" for more information about that command.`
 		"The number of attempts to listen for incoming connections")
 	runCmd.StringVar(&flagBindAddr, "addr", defaultBindAddr, "Bind to this address for the testing")
 	runCmd.StringVar(&flagTMHome, "tmhome", defaultTMHome, "Path to the Tendermint home directory")
+	runCmd.BoolVar(&flagAeonKeys,
+		"aeon-keys",
+		false,
+		"Also test signing of aeon shares with the entropy keys in the Tendermint home directory, and running of dkgs")
+	runCmd.StringVar(&flagKEKFile,
+		"key-encryption-key-file",
+		"",
+		"Path to the key-encryption key with which the entropy keys are encrypted, if they are encrypted")
 	runCmd.Usage = func() {
 		fmt.Println(`Runs the remote signer test harness for Tendermint.
 
@@ -107,7 +117,7 @@ Usage:
 	}
 }
 
-func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
+func runTestHarness(acceptRetries int, bindAddr, tmhome string, aeonKeys bool, kekFile string) {
 	tmhome = internal.ExpandPath(tmhome)
 	cfg := internal.TestHarnessConfig{
 		BindAddr:         bindAddr,
@@ -120,6 +130,10 @@ func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
 		SecretConnKey:    ed25519.GenPrivKey(),
 		ExitWhenComplete: true,
 	}
+	if aeonKeys {
+		cfg.AeonKeyFile = filepath.Join(tmhome, "data", "entropy_key.json")
+		cfg.KeyEncryptionKeyFile = kekFile
+	}
 	harness, err := internal.NewTestHarness(logger, cfg)
 	if err != nil {
 		logger.Error(err.Error())
@@ -167,7 +181,7 @@ func main() {
 		}
 	case "run":
 		runCmd.Parse(os.Args[2:])
-		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome)
+		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome, flagAeonKeys, flagKEKFile)
 	case "extract_key":
 		extractKeyCmd.Parse(os.Args[2:])
 		extractKey(flagTMHome, flagKeyOutputPath)
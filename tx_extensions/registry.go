@@ -0,0 +1,200 @@
+package tx_extensions
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// DeliverHandler is notified of the special txs of a registered type as blocks are delivered to
+// the app
+type DeliverHandler interface {
+	BeginBlock(entropy types.ThresholdSignature) // Called before the txs of a block are delivered
+	SpecialTxSeen(tx []byte)                     // Called for each delivered special tx of the type
+	EndBlock(blockHeight int64)                  // Called after the txs of a block are delivered
+}
+
+// SpecialTxRegistration describes how the node handles a type of special tx. Special txs are
+// checked and delivered by the node rather than the app.
+type SpecialTxRegistration struct {
+	// Name of the type, for logging
+	Name string
+	// Type of the special tx envelope
+	Type SpecialTxType
+	// LegacyPrefix optionally identifies txs of the type which are not in an envelope
+	LegacyPrefix string
+	// Validate returns an error if the tx should not be included in the mempool. Txs only
+	// need to be decodable for the envelope check to pass if nil.
+	Validate func(tx []byte) error
+	// Priority txs are gossiped and reaped from the mempool ahead of other txs
+	Priority bool
+	// Handler is optional and notified of txs of the type delivered in blocks
+	Handler DeliverHandler
+}
+
+// Registry maps special txs to the registration of their type. It is safe for concurrent use.
+type Registry struct {
+	mtx           sync.RWMutex
+	registrations map[SpecialTxType]*SpecialTxRegistration
+	legacy        []*SpecialTxRegistration
+}
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		registrations: make(map[SpecialTxType]*SpecialTxRegistration),
+	}
+}
+
+// NewDefaultRegistry returns a Registry with the DKG registered and delivered to handler, which
+// may be nil
+func NewDefaultRegistry(handler DeliverHandler) *Registry {
+	registry := NewRegistry()
+	if err := registry.Register(DKGRegistration(handler)); err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+// DKGRegistration returns the registration of DKG special txs, which are priority txs and must
+// contain a valid DKG message
+func DKGRegistration(handler DeliverHandler) SpecialTxRegistration {
+	return SpecialTxRegistration{
+		Name:         "dkg",
+		Type:         SpecialTxType_DKG,
+		LegacyPrefix: Prefix,
+		Validate: func(tx []byte) error {
+			msg, err := FromBytes(tx)
+			if err != nil {
+				return err
+			}
+			return msg.ValidateBasic()
+		},
+		Priority: true,
+		Handler:  handler,
+	}
+}
+
+// Register adds a special tx type. Returns an error if the type or legacy prefix is already
+// registered, or the type is unknown to the envelope decoding.
+func (registry *Registry) Register(registration SpecialTxRegistration) error {
+	if _, ok := SpecialTxType_name[int32(registration.Type)]; !ok ||
+		registration.Type == SpecialTxType_UNKNOWN {
+		return fmt.Errorf("unknown special tx type %v", registration.Type)
+	}
+
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+
+	if _, ok := registry.registrations[registration.Type]; ok {
+		return fmt.Errorf("special tx type %v already registered", registration.Type)
+	}
+	if len(registration.LegacyPrefix) != 0 {
+		for _, other := range registry.legacy {
+			if bytes.HasPrefix([]byte(registration.LegacyPrefix), []byte(other.LegacyPrefix)) ||
+				bytes.HasPrefix([]byte(other.LegacyPrefix), []byte(registration.LegacyPrefix)) {
+				return fmt.Errorf("legacy prefix %v conflicts with special tx type %v",
+					registration.LegacyPrefix, other.Type)
+			}
+		}
+	}
+
+	reg := registration
+	registry.registrations[reg.Type] = &reg
+	if len(reg.LegacyPrefix) != 0 {
+		registry.legacy = append(registry.legacy, &reg)
+	}
+	return nil
+}
+
+// Lookup returns the registration of the type of tx, or nil if tx is not a special tx of a
+// registered type
+func (registry *Registry) Lookup(tx []byte) *SpecialTxRegistration {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+
+	for _, reg := range registry.legacy {
+		if bytes.HasPrefix(tx, []byte(reg.LegacyPrefix)) {
+			return reg
+		}
+	}
+	envelope, err := DecodeSpecialTx(tx)
+	if err != nil {
+		return nil
+	}
+	return registry.registrations[envelope.Type]
+}
+
+// IsSpecialTx returns true if tx is a special tx of a registered type
+func (registry *Registry) IsSpecialTx(tx []byte) bool {
+	return registry.Lookup(tx) != nil
+}
+
+// IsPriority returns true if tx is a special tx of a registered priority type
+func (registry *Registry) IsPriority(tx []byte) bool {
+	reg := registry.Lookup(tx)
+	return reg != nil && reg.Priority
+}
+
+// Validate returns ErrNotSpecialTx if tx is not a special tx of a registered type, or the
+// result of validating it for its type
+func (registry *Registry) Validate(tx []byte) error {
+	reg := registry.Lookup(tx)
+	if reg == nil {
+		return ErrNotSpecialTx
+	}
+	if reg.Validate == nil {
+		return nil
+	}
+	return reg.Validate(tx)
+}
+
+// BeginBlock notifies the handlers of all registered types of the start of a block
+func (registry *Registry) BeginBlock(entropy types.ThresholdSignature) {
+	for _, handler := range registry.handlers() {
+		handler.BeginBlock(entropy)
+	}
+}
+
+// DeliverTx passes tx to the handler of its type, if any. Returns false if tx is not a special
+// tx of a registered type.
+func (registry *Registry) DeliverTx(tx []byte) bool {
+	reg := registry.Lookup(tx)
+	if reg == nil {
+		return false
+	}
+	if reg.Handler != nil {
+		reg.Handler.SpecialTxSeen(tx)
+	}
+	return true
+}
+
+// EndBlock notifies the handlers of all registered types of the end of a block
+func (registry *Registry) EndBlock(blockHeight int64) {
+	for _, handler := range registry.handlers() {
+		handler.EndBlock(blockHeight)
+	}
+}
+
+// handlers returns the deliver handlers ordered by type, so they are notified deterministically
+func (registry *Registry) handlers() []DeliverHandler {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+
+	txTypes := make([]SpecialTxType, 0, len(registry.registrations))
+	for txType := range registry.registrations {
+		txTypes = append(txTypes, txType)
+	}
+	sort.Slice(txTypes, func(i, j int) bool { return txTypes[i] < txTypes[j] })
+
+	handlers := make([]DeliverHandler, 0, len(txTypes))
+	for _, txType := range txTypes {
+		if handler := registry.registrations[txType].Handler; handler != nil {
+			handlers = append(handlers, handler)
+		}
+	}
+	return handlers
+}
@@ -0,0 +1,88 @@
+package tx_extensions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+type recordingHandler struct {
+	events []string
+	txs    [][]byte
+}
+
+func (handler *recordingHandler) BeginBlock(types.ThresholdSignature) {
+	handler.events = append(handler.events, "begin")
+}
+
+func (handler *recordingHandler) SpecialTxSeen(tx []byte) {
+	handler.txs = append(handler.txs, tx)
+}
+
+func (handler *recordingHandler) EndBlock(int64) {
+	handler.events = append(handler.events, "end")
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewDefaultRegistry(nil)
+
+	assert.Error(t, registry.Register(SpecialTxRegistration{Name: "unknown", Type: SpecialTxType_UNKNOWN}))
+	assert.Error(t, registry.Register(SpecialTxRegistration{Name: "undefined", Type: 100}))
+	assert.Error(t, registry.Register(DKGRegistration(nil)), "duplicate type")
+
+	registry = NewRegistry()
+	require.NoError(t, registry.Register(SpecialTxRegistration{Name: "legacy", Type: SpecialTxType_DKG,
+		LegacyPrefix: "SP:"}))
+	assert.Nil(t, registry.Lookup([]byte("tx")))
+	assert.NotNil(t, registry.Lookup([]byte("SP:tx")))
+}
+
+func TestRegistryDKG(t *testing.T) {
+	handler := &recordingHandler{}
+	registry := NewDefaultRegistry(handler)
+
+	msg := testDKGMessage()
+	validTx := AsBytes(msg)
+	legacyTx := PrependBytes(cdc.MustMarshalBinaryBare(*msg))
+	msg.Data = ""
+	invalidTx := AsBytes(msg)
+
+	for _, tx := range [][]byte{validTx, legacyTx, invalidTx} {
+		assert.True(t, registry.IsSpecialTx(tx))
+		assert.True(t, registry.IsPriority(tx))
+	}
+	assert.NoError(t, registry.Validate(validTx))
+	assert.NoError(t, registry.Validate(legacyTx))
+	assert.Error(t, registry.Validate(invalidTx))
+
+	normalTx := []byte("key=value")
+	assert.False(t, registry.IsSpecialTx(normalTx))
+	assert.False(t, registry.IsPriority(normalTx))
+	assert.Equal(t, ErrNotSpecialTx, registry.Validate(normalTx))
+
+	registry.BeginBlock(nil)
+	assert.True(t, registry.DeliverTx(validTx))
+	assert.False(t, registry.DeliverTx(normalTx))
+	registry.EndBlock(1)
+	assert.Equal(t, []string{"begin", "end"}, handler.events)
+	assert.Equal(t, [][]byte{validTx}, handler.txs)
+}
+
+func TestRegistryCustomValidation(t *testing.T) {
+	registry := NewRegistry()
+	errInvalid := errors.New("invalid")
+	require.NoError(t, registry.Register(SpecialTxRegistration{
+		Name:     "dkg",
+		Type:     SpecialTxType_DKG,
+		Validate: func([]byte) error { return errInvalid },
+	}))
+
+	tx := AsBytes(testDKGMessage())
+	assert.False(t, registry.IsPriority(tx))
+	assert.Equal(t, errInvalid, registry.Validate(tx))
+	assert.True(t, registry.DeliverTx(tx), "delivered without handler")
+}
@@ -0,0 +1,162 @@
+package tx_extensions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// Prefix of special txs in the legacy format, an amino encoded DKG message. Still decoded
+	// so chains containing them can be replayed.
+	Prefix    = "SP:DKG"
+	PrefixLen = len(Prefix)
+
+	// SpecialTxPrefix precedes the protobuf encoded SpecialTx envelope
+	SpecialTxPrefix    = "SP:TX"
+	SpecialTxPrefixLen = len(SpecialTxPrefix)
+
+	// SpecialTxVersion is the version of the envelope and payload encodings
+	SpecialTxVersion uint32 = 1
+)
+
+var (
+	// ErrNotSpecialTx is returned when decoding a tx without a special tx prefix
+	ErrNotSpecialTx = errors.New("not a special tx")
+	// ErrNonCanonicalEncoding is returned for encodings which do not re-encode to the same
+	// bytes, such as ones with unknown, duplicate or default valued fields
+	ErrNonCanonicalEncoding = errors.New("non-canonical encoding")
+)
+
+// protoMessage is implemented by the gogoproto generated messages
+type protoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+	XXX_DiscardUnknown()
+}
+
+// AsBytes returns the DKG message as a special tx
+func AsBytes(msg *types.DKGMessage) []byte {
+	payload, err := dkgMessageToProto(msg).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	envelope := &SpecialTx{
+		Type:    SpecialTxType_DKG,
+		Version: SpecialTxVersion,
+		Payload: payload,
+	}
+	bz, err := envelope.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return append([]byte(SpecialTxPrefix), bz...)
+}
+
+// DecodeSpecialTx decodes the envelope of a special tx, returning an error if the tx does not
+// have the special tx prefix, the envelope is not canonically encoded, or its version or type
+// is unknown. The payload is not decoded.
+func DecodeSpecialTx(tx []byte) (*SpecialTx, error) {
+	if !bytes.HasPrefix(tx, []byte(SpecialTxPrefix)) {
+		return nil, ErrNotSpecialTx
+	}
+	envelope := &SpecialTx{}
+	if err := unmarshalStrict(tx[SpecialTxPrefixLen:], envelope); err != nil {
+		return nil, fmt.Errorf("invalid special tx envelope: %v", err)
+	}
+	if envelope.Version != SpecialTxVersion {
+		return nil, fmt.Errorf("unsupported special tx version %v", envelope.Version)
+	}
+	if _, ok := SpecialTxType_name[int32(envelope.Type)]; !ok || envelope.Type == SpecialTxType_UNKNOWN {
+		return nil, fmt.Errorf("unknown special tx type %v", envelope.Type)
+	}
+	return envelope, nil
+}
+
+// FromBytes decodes the DKG message in a special tx, in either the envelope or legacy format
+func FromBytes(tx []byte) (*types.DKGMessage, error) {
+	if bytes.HasPrefix(tx, []byte(Prefix)) {
+		return fromLegacyBytes(tx)
+	}
+
+	envelope, err := DecodeSpecialTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if envelope.Type != SpecialTxType_DKG {
+		return nil, fmt.Errorf("expected special tx of type %v, got %v", SpecialTxType_DKG, envelope.Type)
+	}
+	payload := &DKGMessage{}
+	if err := unmarshalStrict(envelope.Payload, payload); err != nil {
+		return nil, fmt.Errorf("invalid dkg message: %v", err)
+	}
+	return dkgMessageFromProto(payload)
+}
+
+// IsDKGRelated informs as to whether this TX (bytes) is an on chain DKG transaction, which is
+// either a special tx envelope of type DKG or a tx in the legacy format with prefix 'SP:DKG'
+func IsDKGRelated(tx []byte) bool {
+	if bytes.HasPrefix(tx, []byte(Prefix)) {
+		return true
+	}
+	envelope, err := DecodeSpecialTx(tx)
+	return err == nil && envelope.Type == SpecialTxType_DKG
+}
+
+func fromLegacyBytes(tx []byte) (*types.DKGMessage, error) {
+	msg := &types.DKGMessage{}
+	if err := cdc.UnmarshalBinaryBare(tx[PrefixLen:], msg); err != nil {
+		return nil, fmt.Errorf("invalid legacy dkg message: %v", err)
+	}
+	return msg, nil
+}
+
+// unmarshalStrict decodes bz into msg and rejects encodings which do not round trip, so each
+// message has exactly one valid encoding
+func unmarshalStrict(bz []byte, msg protoMessage) error {
+	if err := msg.Unmarshal(bz); err != nil {
+		return err
+	}
+	// Unknown fields are kept by Unmarshal and would otherwise be re-encoded
+	msg.XXX_DiscardUnknown()
+	canonical, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(canonical, bz) {
+		return ErrNonCanonicalEncoding
+	}
+	return nil
+}
+
+func dkgMessageToProto(msg *types.DKGMessage) *DKGMessage {
+	return &DKGMessage{
+		Type:         uint32(msg.Type),
+		FromAddress:  msg.FromAddress,
+		DKGID:        msg.DKGID,
+		DKGIteration: msg.DKGIteration,
+		Data:         []byte(msg.Data),
+		ToAddress:    msg.ToAddress,
+		Signature:    msg.Signature,
+		PayloadHash:  msg.PayloadHash,
+	}
+}
+
+func dkgMessageFromProto(pb *DKGMessage) (*types.DKGMessage, error) {
+	if pb.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid dkg message type %v", pb.Type)
+	}
+	return &types.DKGMessage{
+		Type:         types.DKGMessageType(pb.Type),
+		FromAddress:  pb.FromAddress,
+		DKGID:        pb.DKGID,
+		DKGIteration: pb.DKGIteration,
+		Data:         string(pb.Data),
+		ToAddress:    pb.ToAddress,
+		Signature:    pb.Signature,
+		PayloadHash:  pb.PayloadHash,
+	}, nil
+}
@@ -0,0 +1,29 @@
+// +build gofuzz
+
+package tx_extensions
+
+import (
+	"bytes"
+)
+
+func Fuzz(data []byte) int {
+	msg, err := FromBytes(data)
+	if err != nil {
+		if msg != nil {
+			panic("msg != nil on error")
+		}
+		return 0
+	}
+	bz := AsBytes(msg)
+	if bytes.HasPrefix(data, []byte(SpecialTxPrefix)) && !bytes.Equal(bz, data) {
+		panic("special tx does not round trip")
+	}
+	msg2, err := FromBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	if !bytes.Equal(AsBytes(msg2), bz) {
+		panic("dkg message changed on re-encoding")
+	}
+	return 1
+}
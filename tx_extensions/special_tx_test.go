@@ -0,0 +1,159 @@
+package tx_extensions
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/types"
+)
+
+func testDKGMessage() *types.DKGMessage {
+	return &types.DKGMessage{
+		Type:         types.DKGComplaint,
+		FromAddress:  crypto.CRandBytes(crypto.AddressSize),
+		DKGID:        10,
+		DKGIteration: 2,
+		Data:         "\x00\xffdata",
+		ToAddress:    crypto.CRandBytes(crypto.AddressSize),
+		Signature:    []byte("signature"),
+	}
+}
+
+func envelopeBytes(t *testing.T, envelope *SpecialTx) []byte {
+	bz, err := envelope.Marshal()
+	require.NoError(t, err)
+	return append([]byte(SpecialTxPrefix), bz...)
+}
+
+func TestSpecialTxRoundTrip(t *testing.T) {
+	msg := testDKGMessage()
+	tx := AsBytes(msg)
+	assert.True(t, IsDKGRelated(tx))
+
+	decoded, err := FromBytes(tx)
+	require.NoError(t, err)
+	assert.Equal(t, msg, decoded)
+	assert.Equal(t, msg.SignBytes("chain"), decoded.SignBytes("chain"))
+
+	// Messages committing to a payload gossiped off chain
+	msg.Data = ""
+	msg.PayloadHash = types.DKGPayloadHash("data")
+	decoded, err = FromBytes(AsBytes(msg))
+	require.NoError(t, err)
+	assert.Equal(t, msg, decoded)
+	assert.Equal(t, msg.SignBytes("chain"), decoded.SignBytes("chain"))
+}
+
+func TestSpecialTxLegacyFormat(t *testing.T) {
+	msg := testDKGMessage()
+	tx := PrependBytes(cdc.MustMarshalBinaryBare(*msg))
+	assert.True(t, IsDKGRelated(tx))
+
+	decoded, err := FromBytes(tx)
+	require.NoError(t, err)
+	assert.Equal(t, msg, decoded)
+	assert.Equal(t, AsBytes(msg), AsBytes(decoded))
+}
+
+func TestFilterCustomHeader(t *testing.T) {
+	msg := testDKGMessage()
+
+	// Legacy txs have the prefix removed
+	legacyMsg := cdc.MustMarshalBinaryBare(*msg)
+	assert.Equal(t, legacyMsg, FilterCustomHeader(PrependBytes(legacyMsg)))
+
+	// Special txs have the envelope removed
+	payload, err := dkgMessageToProto(msg).Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, payload, FilterCustomHeader(AsBytes(msg)))
+
+	// Other txs are unchanged
+	tx := []byte("key=value")
+	assert.Equal(t, tx, FilterCustomHeader(tx))
+}
+
+func TestSpecialTxDecodingErrors(t *testing.T) {
+	payload, err := dkgMessageToProto(testDKGMessage()).Marshal()
+	require.NoError(t, err)
+	validTx := AsBytes(testDKGMessage())
+
+	badType := dkgMessageToProto(testDKGMessage())
+	badType.Type = math.MaxUint16 + 1
+	badTypePayload, err := badType.Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName string
+		tx       []byte
+	}{
+		{"Empty", []byte{}},
+		{"Short prefix", []byte("SP:D")},
+		{"Not special", []byte("key=value")},
+		{"Empty envelope", []byte(SpecialTxPrefix)},
+		{"Unsupported version", envelopeBytes(t, &SpecialTx{Type: SpecialTxType_DKG, Version: 2, Payload: payload})},
+		{"Unknown type", envelopeBytes(t, &SpecialTx{Type: 5, Version: SpecialTxVersion, Payload: payload})},
+		{"Type unknown", envelopeBytes(t, &SpecialTx{Type: SpecialTxType_UNKNOWN, Version: SpecialTxVersion,
+			Payload: payload})},
+		{"Trailing bytes", append(append([]byte{}, validTx...), 0)},
+		{"Unknown field", append(append([]byte{}, validTx...), 0x20, 0x01)},
+		{"Truncated", validTx[:len(validTx)-1]},
+		{"Invalid payload", envelopeBytes(t, &SpecialTx{Type: SpecialTxType_DKG, Version: SpecialTxVersion,
+			Payload: []byte{0xff}})},
+		{"Payload unknown field", envelopeBytes(t, &SpecialTx{Type: SpecialTxType_DKG, Version: SpecialTxVersion,
+			Payload: append(append([]byte{}, payload...), 0x40, 0x01)})},
+		{"Payload type overflow", envelopeBytes(t, &SpecialTx{Type: SpecialTxType_DKG, Version: SpecialTxVersion,
+			Payload: badTypePayload})},
+		{"Legacy prefix only", []byte(Prefix)},
+		{"Legacy invalid", PrependBytes([]byte{0xff, 0xff})},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			msg, err := FromBytes(tc.tx)
+			assert.Error(t, err)
+			assert.Nil(t, msg)
+		})
+	}
+
+	assert.False(t, IsDKGRelated([]byte(SpecialTxPrefix)))
+	assert.Equal(t, ErrNotSpecialTx, func() error { _, err := FromBytes([]byte("tx")); return err }())
+}
+
+// TestSpecialTxRandomInput mutates valid special txs and checks decoding never panics and
+// successfully decoded txs round trip
+func TestSpecialTxRandomInput(t *testing.T) {
+	msg := testDKGMessage()
+	seeds := [][]byte{AsBytes(msg), PrependBytes(cdc.MustMarshalBinaryBare(*msg))}
+
+	for i := 0; i < 10000; i++ {
+		seed := seeds[i%len(seeds)]
+		tx := append([]byte{}, seed...)
+		switch rand.Intn(3) {
+		case 0:
+			tx[rand.Intn(len(tx))] ^= byte(1 << uint(rand.Intn(8)))
+		case 1:
+			tx = tx[:rand.Intn(len(tx))]
+		default:
+			tx = append(tx[:SpecialTxPrefixLen], crypto.CRandBytes(rand.Intn(100))...)
+		}
+
+		decoded, err := FromBytes(tx)
+		if err != nil {
+			assert.Nil(t, decoded)
+			continue
+		}
+		bz := AsBytes(decoded)
+		if bytes.HasPrefix(tx, []byte(SpecialTxPrefix)) {
+			assert.Equal(t, tx, bz)
+		}
+		decoded2, err := FromBytes(bz)
+		require.NoError(t, err)
+		assert.Equal(t, bz, AsBytes(decoded2))
+	}
+}
@@ -10,11 +10,6 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
-const (
-	Prefix    = "SP:DKG"
-	PrefixLen = len(Prefix)
-)
-
 var cdc = amino.NewCodec()
 
 func init() {
@@ -26,29 +21,13 @@ func RegisterMessages(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&types.DKGMessage{}, "tendermint/DKGMessage", nil)
 }
 
-// Return the DKG message as bytes
-func AsBytes(msg *types.DKGMessage) (ret []byte) {
-
-	as_bytes := cdc.MustMarshalBinaryBare(*msg)
-	ret = append([]byte(Prefix), as_bytes...)
-
-	return
-}
-
-// Prepend bytes with the DKG prefix
+// Prepend bytes with the legacy DKG prefix
 func PrependBytes(msg []byte) (ret []byte) {
 
 	ret = append([]byte(Prefix), msg...)
 	return
 }
 
-func FromBytes(msg []byte) (ret *types.DKGMessage, err error) {
-
-	ret = &types.DKGMessage{}
-	err = cdc.UnmarshalBinaryBare(msg[6:], ret)
-	return
-}
-
 // Handler for converting DKG message from a string
 func AsDKG(msg interface{}) (ret types.DKGMessage, err error) {
 
@@ -61,30 +40,26 @@ func AsDKG(msg interface{}) (ret types.DKGMessage, err error) {
 	return
 }
 
-// IsDKGRelated informs as to whether this TX (bytes) is an on chain DKG transaction.
-// At the moment this is signified by the leading bytes being 'SP:DKG' (special tx: DKG)
-func IsDKGRelated(tx []byte) bool {
-	if len(tx) >= PrefixLen && bytes.Equal(tx[0:PrefixLen], []byte(Prefix)) {
-		return true
-	}
-	return false
-}
-
-// Determine if the transaction is DKG related, if so then filter off the custom header
+// Determine if the transaction is DKG related, if so then filter off the custom header. The
+// payload of the envelope is returned for special txs.
 func FilterCustomHeader(tx []byte) []byte {
 	if !IsDKGRelated(tx) {
 		return tx
 	}
-
-	return tx[PrefixLen:]
+	if bytes.HasPrefix(tx, []byte(Prefix)) {
+		return tx[PrefixLen:]
+	}
+	envelope, err := DecodeSpecialTx(tx)
+	if err != nil {
+		return tx
+	}
+	return envelope.Payload
 }
 
 type MessageHandler interface {
+	DeliverHandler                                                                 // Chain watcher calls this to notify of TXs seen
 	SubmitSpecialTx(message interface{})                                           // DKG calls this to send away messages
 	ToSubmitTx(cb func([]byte))                                                    // Set the callback to dispatch raw TXs to mempool
-	SpecialTxSeen(tx []byte)                                                       // Chain watcher calls this to notify of TXs seen
-	BeginBlock(entropy types.ThresholdSignature)                                   // Call this to get entropy from block
-	EndBlock(blockHeight int64)                                                    // Call this to send the block TXs to the DKG
 	WhenChainTxSeen(cb func(int64, types.ThresholdSignature, []*types.DKGMessage)) // Set the callback for an end block
 }
 
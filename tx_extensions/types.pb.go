@@ -0,0 +1,1167 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tx_extensions/types.proto
+
+package tx_extensions
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SpecialTxType identifies the payload carried by a special transaction
+type SpecialTxType int32
+
+const (
+	SpecialTxType_UNKNOWN SpecialTxType = 0
+	SpecialTxType_DKG     SpecialTxType = 1
+)
+
+var SpecialTxType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DKG",
+}
+
+var SpecialTxType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"DKG":     1,
+}
+
+func (x SpecialTxType) String() string {
+	return proto.EnumName(SpecialTxType_name, int32(x))
+}
+
+func (SpecialTxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6d45204c7987ce8, []int{0}
+}
+
+// SpecialTx is the envelope of all special transactions, which follow the
+// "SP:TX" prefix on chain
+type SpecialTx struct {
+	Type                 SpecialTxType `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.tx_extensions.SpecialTxType" json:"type,omitempty"`
+	Version              uint32        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload              []byte        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SpecialTx) Reset()         { *m = SpecialTx{} }
+func (m *SpecialTx) String() string { return proto.CompactTextString(m) }
+func (*SpecialTx) ProtoMessage()    {}
+func (*SpecialTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6d45204c7987ce8, []int{0}
+}
+func (m *SpecialTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecialTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecialTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecialTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecialTx.Merge(m, src)
+}
+func (m *SpecialTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecialTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecialTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecialTx proto.InternalMessageInfo
+
+func (m *SpecialTx) GetType() SpecialTxType {
+	if m != nil {
+		return m.Type
+	}
+	return SpecialTxType_UNKNOWN
+}
+
+func (m *SpecialTx) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SpecialTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// DKGMessage is the payload of special transactions of type DKG
+type DKGMessage struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	FromAddress          []byte   `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	DKGID                int64    `protobuf:"varint,3,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	DKGIteration         int64    `protobuf:"varint,4,opt,name=dkg_iteration,json=dkgIteration,proto3" json:"dkg_iteration,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	ToAddress            []byte   `protobuf:"bytes,6,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Signature            []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	PayloadHash          []byte   `protobuf:"bytes,8,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DKGMessage) Reset()         { *m = DKGMessage{} }
+func (m *DKGMessage) String() string { return proto.CompactTextString(m) }
+func (*DKGMessage) ProtoMessage()    {}
+func (*DKGMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6d45204c7987ce8, []int{1}
+}
+func (m *DKGMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGMessage.Merge(m, src)
+}
+func (m *DKGMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGMessage proto.InternalMessageInfo
+
+func (m *DKGMessage) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *DKGMessage) GetFromAddress() []byte {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *DKGMessage) GetDKGID() int64 {
+	if m != nil {
+		return m.DKGID
+	}
+	return 0
+}
+
+func (m *DKGMessage) GetDKGIteration() int64 {
+	if m != nil {
+		return m.DKGIteration
+	}
+	return 0
+}
+
+func (m *DKGMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DKGMessage) GetToAddress() []byte {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *DKGMessage) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *DKGMessage) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tendermint.tx_extensions.SpecialTxType", SpecialTxType_name, SpecialTxType_value)
+	golang_proto.RegisterEnum("tendermint.tx_extensions.SpecialTxType", SpecialTxType_name, SpecialTxType_value)
+	proto.RegisterType((*SpecialTx)(nil), "tendermint.tx_extensions.SpecialTx")
+	golang_proto.RegisterType((*SpecialTx)(nil), "tendermint.tx_extensions.SpecialTx")
+	proto.RegisterType((*DKGMessage)(nil), "tendermint.tx_extensions.DKGMessage")
+	golang_proto.RegisterType((*DKGMessage)(nil), "tendermint.tx_extensions.DKGMessage")
+}
+
+func init() { proto.RegisterFile("tx_extensions/types.proto", fileDescriptor_a6d45204c7987ce8) }
+func init() { golang_proto.RegisterFile("tx_extensions/types.proto", fileDescriptor_a6d45204c7987ce8) }
+
+var fileDescriptor_a6d45204c7987ce8 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0xc5, 0xd3, 0x69, 0x43, 0x3c, 0x09, 0xaa, 0xbc, 0x32, 0x08, 0x32, 0x61, 0x24, 0xa0, 0x62,
+	0x91, 0x48, 0x20, 0x56, 0xac, 0x18, 0x45, 0x2a, 0xa3, 0x40, 0x91, 0xc2, 0x20, 0x24, 0x36, 0x91,
+	0x5b, 0x9b, 0x24, 0x6a, 0x1b, 0x47, 0xb6, 0x8b, 0x1a, 0x89, 0x8f, 0xe0, 0x33, 0xf8, 0x04, 0x96,
+	0x2c, 0x59, 0xf2, 0x05, 0x15, 0x84, 0x9f, 0x60, 0x89, 0xec, 0x34, 0x7d, 0x2c, 0xd8, 0xdd, 0x7b,
+	0xce, 0xf1, 0xb9, 0xe7, 0xe6, 0x06, 0xde, 0x56, 0xeb, 0x94, 0xad, 0x15, 0x2b, 0x65, 0xc1, 0x4b,
+	0x19, 0xaa, 0xba, 0x62, 0x32, 0xa8, 0x04, 0x57, 0x1c, 0x61, 0xc5, 0x4a, 0xca, 0xc4, 0xb2, 0x28,
+	0x55, 0x70, 0xa4, 0xba, 0xf3, 0x50, 0xe5, 0x85, 0xa0, 0x69, 0x45, 0x84, 0xaa, 0x43, 0x23, 0x0e,
+	0x33, 0x9e, 0xf1, 0x7d, 0xd5, 0x3a, 0x5c, 0x7c, 0x86, 0xf6, 0xdb, 0x8a, 0xcd, 0x0a, 0xb2, 0xb8,
+	0x5e, 0xa3, 0xe7, 0xf0, 0x54, 0xbb, 0x63, 0xe0, 0x83, 0xd1, 0xad, 0x27, 0x8f, 0x82, 0xff, 0xb9,
+	0x07, 0xbb, 0x27, 0xd7, 0x75, 0xc5, 0x12, 0xf3, 0x08, 0x61, 0x68, 0x7d, 0x62, 0x42, 0xf3, 0xf8,
+	0xc4, 0x07, 0x23, 0x37, 0xe9, 0x5a, 0xcd, 0x54, 0xa4, 0x5e, 0x70, 0x42, 0x71, 0xcf, 0x07, 0x23,
+	0x27, 0xe9, 0xda, 0x8b, 0x2f, 0x27, 0x10, 0x46, 0xf1, 0xf8, 0x35, 0x93, 0x92, 0x64, 0x0c, 0xa1,
+	0x83, 0xf9, 0xee, 0xd6, 0xf6, 0x3e, 0x74, 0x3e, 0x0a, 0xbe, 0x4c, 0x09, 0xa5, 0x82, 0x49, 0x69,
+	0xbc, 0x9d, 0xe4, 0x4c, 0x63, 0x2f, 0x5a, 0x08, 0xf9, 0x70, 0x40, 0xe7, 0x59, 0x5a, 0xb4, 0xf6,
+	0xbd, 0x4b, 0xbb, 0xd9, 0x9c, 0xf7, 0xa3, 0x78, 0x7c, 0x15, 0x25, 0x7d, 0x3a, 0xcf, 0xae, 0x28,
+	0x7a, 0x06, 0x5d, 0xa3, 0x50, 0x4c, 0x10, 0xa5, 0x13, 0x9e, 0x1a, 0xe1, 0xb0, 0xd9, 0x9c, 0x3b,
+	0x5a, 0xd8, 0xe1, 0x89, 0xa3, 0xf5, 0x5d, 0xa7, 0xf3, 0x50, 0xa2, 0x08, 0xee, 0x9b, 0x99, 0xa6,
+	0x46, 0xf7, 0x20, 0x54, 0x7c, 0x97, 0x66, 0x60, 0x18, 0x5b, 0xf1, 0x2e, 0xcb, 0x5d, 0x68, 0xcb,
+	0x22, 0x2b, 0x89, 0x5a, 0x09, 0x86, 0xad, 0x96, 0xdd, 0x01, 0x7a, 0x99, 0xed, 0xea, 0x69, 0x4e,
+	0x64, 0x8e, 0x6f, 0xb6, 0xcb, 0x6c, 0xb1, 0x97, 0x44, 0xe6, 0x8f, 0x1f, 0x40, 0xf7, 0xe8, 0xeb,
+	0xa2, 0x33, 0x68, 0xbd, 0x9b, 0xc4, 0x93, 0x37, 0xef, 0x27, 0xc3, 0x1b, 0xc8, 0x82, 0xbd, 0x28,
+	0x1e, 0x0f, 0xc1, 0xe5, 0xab, 0xbf, 0xbf, 0x3d, 0xf0, 0xb5, 0xf1, 0xc0, 0xb7, 0xc6, 0x03, 0x3f,
+	0x1a, 0x0f, 0xfc, 0x6c, 0x3c, 0xf0, 0xab, 0xf1, 0xc0, 0xf7, 0x3f, 0x1e, 0xf8, 0x10, 0x64, 0x85,
+	0xca, 0x57, 0xd3, 0x60, 0xc6, 0x97, 0xe1, 0xfe, 0x88, 0x47, 0xe5, 0xe1, 0x3d, 0xa7, 0x03, 0xf3,
+	0x33, 0x3c, 0xfd, 0x37, 0x00, 0x45, 0xc2, 0x9a, 0x13, 0x6b, 0x02, 0x00, 0x00,
+}
+
+func (this *SpecialTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpecialTx)
+	if !ok {
+		that2, ok := that.(SpecialTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DKGMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DKGMessage)
+	if !ok {
+		that2, ok := that.(DKGMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.FromAddress, that1.FromAddress) {
+		return false
+	}
+	if this.DKGID != that1.DKGID {
+		return false
+	}
+	if this.DKGIteration != that1.DKGIteration {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !bytes.Equal(this.ToAddress, that1.ToAddress) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.PayloadHash, that1.PayloadHash) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *SpecialTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecialTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecialTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DKGIteration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DKGIteration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DKGID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DKGID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSpecialTx(r randyTypes, easy bool) *SpecialTx {
+	this := &SpecialTx{}
+	this.Type = SpecialTxType([]int32{0, 1}[r.Intn(2)])
+	this.Version = uint32(r.Uint32())
+	v1 := r.Intn(100)
+	this.Payload = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Payload[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedDKGMessage(r randyTypes, easy bool) *DKGMessage {
+	this := &DKGMessage{}
+	this.Type = uint32(r.Uint32())
+	v2 := r.Intn(100)
+	this.FromAddress = make([]byte, v2)
+	for i := 0; i < v2; i++ {
+		this.FromAddress[i] = byte(r.Intn(256))
+	}
+	this.DKGID = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DKGID *= -1
+	}
+	this.DKGIteration = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DKGIteration *= -1
+	}
+	v3 := r.Intn(100)
+	this.Data = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	v4 := r.Intn(100)
+	this.ToAddress = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.ToAddress[i] = byte(r.Intn(256))
+	}
+	v5 := r.Intn(100)
+	this.Signature = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Signature[i] = byte(r.Intn(256))
+	}
+	v6 := r.Intn(100)
+	this.PayloadHash = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.PayloadHash[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 9)
+	}
+	return this
+}
+
+type randyTypes interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneTypes(r randyTypes) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringTypes(r randyTypes) string {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
+		tmps[i] = randUTF8RuneTypes(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedTypes(r randyTypes, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldTypes(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldTypes(dAtA []byte, r randyTypes, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		v7 := r.Int63()
+		if r.Intn(2) == 0 {
+			v7 *= -1
+		}
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(v7))
+	case 1:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateTypes(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *SpecialTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DKGMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DKGID != 0 {
+		n += 1 + sovTypes(uint64(m.DKGID))
+	}
+	if m.DKGIteration != 0 {
+		n += 1 + sovTypes(uint64(m.DKGIteration))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SpecialTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecialTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecialTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SpecialTxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DKGID", wireType)
+			}
+			m.DKGID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DKGID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DKGIteration", wireType)
+			}
+			m.DKGIteration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DKGIteration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadHash == nil {
+				m.PayloadHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// BeaconEvidenceVerifier checks the threshold cryptography in beacon evidence, which can not
+// be verified with the validator's public key alone
+type BeaconEvidenceVerifier interface {
+	// VerifySignatureShare returns true if share is a valid signature share of message by the
+	// member of aeon with index
+	VerifySignatureShare(aeon *DKGOutput, message string, share string, index uint) bool
+	// VerifyComplaintAnswer returns false if the shares exposed for complainer in the serialised
+	// complaint answers are inconsistent with the serialised coefficients of the dkg member which
+	// sent both, in a dkg generating keys of keyType
+	VerifyComplaintAnswer(coefficients string, answers string, complainer uint, threshold uint, keyType string) bool
+}
+
+//-------------------------------------------
+
+// InvalidEntropyShareEvidence contains evidence a member of an aeon signed an entropy share which
+// is not a valid signature share of the entropy message at its height. Checking the signature
+// share requires the aeon and previous entropy in the state db.
+type InvalidEntropyShareEvidence struct {
+	PubKey crypto.PubKey
+	Share  *EntropyShare
+	DKGID  int64
+
+	// time of the block at the share height, which is not signed over but checked against the chain
+	Timestamp time.Time
+}
+
+var _ Evidence = &InvalidEntropyShareEvidence{}
+
+// NewInvalidEntropyShareEvidence creates InvalidEntropyShareEvidence for a share signed by pubKey
+// in the aeon generated by dkg with dkgID
+func NewInvalidEntropyShareEvidence(pubKey crypto.PubKey, dkgID int64, share *EntropyShare,
+	timestamp time.Time) *InvalidEntropyShareEvidence {
+	if share == nil {
+		return nil
+	}
+	return &InvalidEntropyShareEvidence{
+		PubKey:    pubKey,
+		Share:     share,
+		DKGID:     dkgID,
+		Timestamp: timestamp,
+	}
+}
+
+// String returns a string representation of the evidence.
+func (iese *InvalidEntropyShareEvidence) String() string {
+	return fmt.Sprintf("DKGID: %v; Share: %v", iese.DKGID, iese.Share)
+}
+
+// Height returns the height this evidence refers to.
+func (iese *InvalidEntropyShareEvidence) Height() int64 {
+	return iese.Share.Height
+}
+
+// Time return the time the evidence was created
+func (iese *InvalidEntropyShareEvidence) Time() time.Time {
+	return iese.Timestamp
+}
+
+// Address returns the address of the validator.
+func (iese *InvalidEntropyShareEvidence) Address() []byte {
+	return iese.PubKey.Address()
+}
+
+// Bytes returns the amino encoded evidence.
+func (iese *InvalidEntropyShareEvidence) Bytes() []byte {
+	return cdcEncode(iese)
+}
+
+// Hash returns the hash of the evidence. The timestamp is excluded so the same share can only be
+// reported once.
+func (iese *InvalidEntropyShareEvidence) Hash() []byte {
+	evCopy := *iese
+	evCopy.Timestamp = time.Time{}
+	return tmhash.Sum(cdcEncode(&evCopy))
+}
+
+// Verify returns an error if the share was not signed by pubKey. Whether the signature share is
+// invalid is checked against the aeon when verifying evidence against the state.
+func (iese *InvalidEntropyShareEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	if !bytes.Equal(pubKey.Address(), iese.Share.SignerAddress) {
+		return fmt.Errorf("invalidEntropyShareEvidence address (%X) doesn't match pubkey (%v - %X)",
+			iese.Share.SignerAddress, pubKey, pubKey.Address())
+	}
+	if !pubKey.VerifyBytes(iese.Share.SignBytes(chainID), iese.Share.Signature) {
+		return errors.New("invalidEntropyShareEvidence Error verifying share signature")
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (iese *InvalidEntropyShareEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*InvalidEntropyShareEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(iese.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (iese *InvalidEntropyShareEvidence) ValidateBasic() error {
+	if iese.PubKey == nil || len(iese.PubKey.Bytes()) == 0 {
+		return errors.New("empty PubKey")
+	}
+	if iese.Share == nil {
+		return errors.New("empty share")
+	}
+	if err := validateEvidenceShare(iese.Share); err != nil {
+		return fmt.Errorf("invalid share: %v", err)
+	}
+	if iese.DKGID < 0 {
+		return fmt.Errorf("expected DKG ID >= 0, got %d", iese.DKGID)
+	}
+	return nil
+}
+
+// validateEvidenceShare bounds the signature to the size of a validator signature, so evidence
+// stays within MaxEvidenceBytes
+func validateEvidenceShare(share *EntropyShare) error {
+	if err := share.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(share.Signature) > MaxSignatureSize {
+		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
+	}
+	return nil
+}
+
+//-------------------------------------------
+
+// DKGComplaintEvidence contains evidence a dkg member answered a complaint against it by exposing
+// shares which are inconsistent with its coefficients. The coefficients and complaint answers of
+// the accused are committed on chain in dkg transactions and looked up in the state db during
+// verification, so the evidence only identifies them.
+type DKGComplaintEvidence struct {
+	PubKey       crypto.PubKey
+	Complainer   crypto.Address
+	DKGID        int64
+	DKGIteration int64
+
+	// time the complaint answers were checked
+	Timestamp time.Time
+}
+
+var _ Evidence = &DKGComplaintEvidence{}
+
+// NewDKGComplaintEvidence creates DKGComplaintEvidence against the dkg member with pubKey
+func NewDKGComplaintEvidence(pubKey crypto.PubKey, complainer crypto.Address, dkgID int64, dkgIteration int64,
+	timestamp time.Time) *DKGComplaintEvidence {
+	return &DKGComplaintEvidence{
+		PubKey:       pubKey,
+		Complainer:   complainer,
+		DKGID:        dkgID,
+		DKGIteration: dkgIteration,
+		Timestamp:    timestamp,
+	}
+}
+
+// String returns a string representation of the evidence.
+func (dce *DKGComplaintEvidence) String() string {
+	return fmt.Sprintf("DKGID/Iteration: %v/%v; Accused: %X; Complainer: %X",
+		dce.DKGID, dce.DKGIteration, dce.PubKey.Address(), dce.Complainer)
+}
+
+// Height returns the validator height of the dkg, which is equal to its id.
+func (dce *DKGComplaintEvidence) Height() int64 {
+	return dce.DKGID
+}
+
+// Time return the time the evidence was created
+func (dce *DKGComplaintEvidence) Time() time.Time {
+	return dce.Timestamp
+}
+
+// Address returns the address of the validator.
+func (dce *DKGComplaintEvidence) Address() []byte {
+	return dce.PubKey.Address()
+}
+
+// Bytes returns the amino encoded evidence.
+func (dce *DKGComplaintEvidence) Bytes() []byte {
+	return cdcEncode(dce)
+}
+
+// Hash returns the hash of the evidence. The timestamp is excluded so the same complaint can only
+// be reported once.
+func (dce *DKGComplaintEvidence) Hash() []byte {
+	evCopy := *dce
+	evCopy.Timestamp = time.Time{}
+	return tmhash.Sum(cdcEncode(&evCopy))
+}
+
+// Verify returns an error if the accused is the complainer. The complaint answers are checked
+// against the messages on chain when verifying evidence against the state.
+func (dce *DKGComplaintEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	if !bytes.Equal(pubKey.Address(), dce.PubKey.Address()) {
+		return fmt.Errorf("dkgComplaintEvidence address (%X) doesn't match pubkey (%v - %X)",
+			dce.PubKey.Address(), pubKey, pubKey.Address())
+	}
+	if bytes.Equal(dce.Complainer, dce.PubKey.Address()) {
+		return errors.New("dkgComplaintEvidence Error: complaint against self")
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (dce *DKGComplaintEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*DKGComplaintEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(dce.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (dce *DKGComplaintEvidence) ValidateBasic() error {
+	if dce.PubKey == nil || len(dce.PubKey.Bytes()) == 0 {
+		return errors.New("empty PubKey")
+	}
+	if len(dce.Complainer) != crypto.AddressSize {
+		return fmt.Errorf("expected Complainer size to be %d bytes, got %d bytes",
+			crypto.AddressSize,
+			len(dce.Complainer),
+		)
+	}
+	if dce.DKGID < 0 {
+		return fmt.Errorf("expected DKG ID >= 0, got %d", dce.DKGID)
+	}
+	if dce.DKGIteration < 0 {
+		return fmt.Errorf("expected DKG iteration >= 0, got %d", dce.DKGIteration)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func makeEntropyShare(val PrivValidator, chainID string, height int64, signatureShare string) *EntropyShare {
+	share := &EntropyShare{
+		Height:         height,
+		SignerAddress:  val.GetPubKey().Address(),
+		SignatureShare: signatureShare,
+	}
+	if err := val.SignEntropy(chainID, share); err != nil {
+		panic(err)
+	}
+	return share
+}
+
+func makeMaxEntropyShare(c string) *EntropyShare {
+	return &EntropyShare{
+		Height:         math.MaxInt64,
+		SignerAddress:  make([]byte, crypto.AddressSize),
+		SignatureShare: strings.Repeat(c, MaxEntropyShareSize),
+		Signature:      make([]byte, MaxSignatureSize),
+	}
+}
+
+func TestInvalidEntropyShareEvidence(t *testing.T) {
+	val := NewMockPV()
+	val2 := NewMockPV()
+	const chainID = "mychain"
+
+	share := makeEntropyShare(val, chainID, 10, "share")
+	ev := NewInvalidEntropyShareEvidence(val.GetPubKey(), 1, share, time.Now())
+	require.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, ev.Verify(chainID, val.GetPubKey()))
+	assert.Error(t, ev.Verify("mychain2", val.GetPubKey()))
+	assert.Error(t, ev.Verify(chainID, val2.GetPubKey()))
+
+	ev.Share = nil
+	assert.Error(t, ev.ValidateBasic())
+}
+
+func TestDKGComplaintEvidence(t *testing.T) {
+	val := NewMockPV()
+	val2 := NewMockPV()
+	const chainID = "mychain"
+
+	ev := NewDKGComplaintEvidence(val.GetPubKey(), val2.GetPubKey().Address(), 10, 0, time.Now())
+	require.NoError(t, ev.ValidateBasic())
+	assert.EqualValues(t, 10, ev.Height())
+	assert.NoError(t, ev.Verify(chainID, val.GetPubKey()))
+	assert.Error(t, ev.Verify(chainID, val2.GetPubKey()))
+
+	ev.Complainer = val.GetPubKey().Address()
+	assert.Error(t, ev.Verify(chainID, val.GetPubKey()), "complaint against self")
+
+	ev.Complainer = []byte{1}
+	assert.Error(t, ev.ValidateBasic())
+}
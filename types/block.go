@@ -20,7 +20,7 @@ import (
 
 const (
 	// MaxHeaderBytes is a maximum header size (including amino overhead).
-	MaxHeaderBytes int64 = 632
+	MaxHeaderBytes int64 = 667
 
 	// MaxAminoOverheadForBlock - maximum amino overhead to encode a block (up to
 	// MaxBlockSizeBytes in size) not including it's parts except Data.
@@ -41,6 +41,9 @@ type Block struct {
 	Data       `json:"data"`
 	Evidence   EvidenceData `json:"evidence"`
 	LastCommit *Commit      `json:"last_commit"`
+	// Set when the block falls back to empty entropy after the beacon timed out. It is not
+	// hashed into the header, but is committed to by the part set header of the block id.
+	EntropyTimeout *EntropyTimeout `json:"entropy_timeout"`
 }
 
 // ValidateBasic performs basic validation that doesn't involve state data.
@@ -143,6 +146,25 @@ func (b *Block) ValidateBasic() error {
 	if err := b.Entropy.ValidateBasic(); err != nil {
 		return fmt.Errorf("Wrong Header.Entropy: %v", err)
 	}
+	if err := ValidateHash(b.AeonHash); err != nil {
+		return fmt.Errorf("wrong Header.AeonHash: %v", err)
+	}
+	if IsEmptyBlockEntropy(&b.Entropy) && len(b.AeonHash) != 0 {
+		return fmt.Errorf("expected empty Header.AeonHash without entropy, got %v", b.AeonHash)
+	}
+
+	if b.EntropyTimeout != nil {
+		if err := b.EntropyTimeout.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong EntropyTimeout: %v", err)
+		}
+		if b.EntropyTimeout.Height() != b.Height {
+			return fmt.Errorf("wrong EntropyTimeout height. Expected %v, got %v", b.Height,
+				b.EntropyTimeout.Height())
+		}
+		if !IsEmptyBlockEntropy(&b.Entropy) {
+			return errors.New("expected empty Header.Entropy with EntropyTimeout")
+		}
+	}
 
 	return nil
 }
@@ -231,11 +253,13 @@ func (b *Block) StringIndented(indent string) string {
 %s  %v
 %s  %v
 %s  %v
+%s  %v
 %s}#%v`,
 		indent, b.Header.StringIndented(indent+"  "),
 		indent, b.Data.StringIndented(indent+"  "),
 		indent, b.Evidence.StringIndented(indent+"  "),
 		indent, b.LastCommit.StringIndented(indent+"  "),
+		indent, b.EntropyTimeout,
 		indent, b.Hash())
 }
 
@@ -349,7 +373,8 @@ type Header struct {
 	// consensus info
 	EvidenceHash    tmbytes.HexBytes `json:"evidence_hash"`    // evidence included in the block
 	ProposerAddress Address          `json:"proposer_address"` // original proposer of the block
-	Entropy BlockEntropy `json:"entropy"` // group signature for this block height
+	Entropy         BlockEntropy     `json:"entropy"`          // group signature for this block height
+	AeonHash        tmbytes.HexBytes `json:"aeon_hash"`        // public info of the aeon which generated the entropy
 }
 
 // Populate the Header with state-derived data.
@@ -399,6 +424,7 @@ func (h *Header) Hash() tmbytes.HexBytes {
 		cdcEncode(h.EvidenceHash),
 		cdcEncode(h.ProposerAddress),
 		cdcEncode(h.Entropy),
+		cdcEncode(h.AeonHash),
 	})
 }
 
@@ -423,6 +449,7 @@ func (h *Header) StringIndented(indent string) string {
 %s  Evidence:       %v
 %s  Proposer:       %v
 %s  Entropy: 		%v
+%s  Aeon:           %v
 %s}#%v`,
 		indent, h.Version,
 		indent, h.ChainID,
@@ -439,6 +466,7 @@ func (h *Header) StringIndented(indent string) string {
 		indent, h.EvidenceHash,
 		indent, h.ProposerAddress,
 		indent, h.Entropy.StringIndented(indent),
+		indent, h.AeonHash,
 		indent, h.Hash())
 }
 
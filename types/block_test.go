@@ -63,6 +63,11 @@ func TestBlockValidateBasic(t *testing.T) {
 	ev := NewMockEvidence(h, time.Now(), 0, valSet.Validators[0].Address)
 	evList := []Evidence{ev}
 
+	timedOut := &Vote{ValidatorAddress: valSet.Validators[0].Address, ValidatorIndex: 0, Height: h,
+		Type: PrevoteType, EntropyTimedOut: true}
+	require.NoError(t, vals[0].SignVote("test_chain_id", timedOut))
+	entropyTimeout := &EntropyTimeout{Votes: []*Vote{timedOut}}
+
 	testCases := []struct {
 		testName      string
 		malleateBlock func(*Block)
@@ -90,6 +95,23 @@ func TestBlockValidateBasic(t *testing.T) {
 			zeros := [MaxThresholdSignatureSize + 1]byte{1}
 			blk.Entropy = *NewBlockEntropy(zeros[0:len(zeros)], 0, 1, 0)
 		}, true},
+		{"AeonHash with entropy", func(blk *Block) {
+			blk.Entropy = *NewBlockEntropy(tmhash.Sum([]byte("group_signature")), 0, 1, 0)
+			blk.AeonHash = tmhash.Sum([]byte("aeon_hash"))
+		}, false},
+		{"AeonHash without entropy", func(blk *Block) { blk.AeonHash = tmhash.Sum([]byte("aeon_hash")) }, true},
+		{"Wrong AeonHash size", func(blk *Block) {
+			blk.Entropy = *NewBlockEntropy(tmhash.Sum([]byte("group_signature")), 0, 1, 0)
+			blk.AeonHash = []byte("aeon_hash")
+		}, true},
+		{"EntropyTimeout", func(blk *Block) { blk.EntropyTimeout = entropyTimeout }, false},
+		{"EntropyTimeout with entropy", func(blk *Block) {
+			blk.EntropyTimeout = entropyTimeout
+			blk.Entropy = *NewBlockEntropy(tmhash.Sum([]byte("group_signature")), 0, 1, 0)
+		}, true},
+		{"EntropyTimeout wrong height", func(blk *Block) {
+			blk.EntropyTimeout = &EntropyTimeout{Votes: []*Vote{withHeight(timedOut, h+1)}}
+		}, true},
 	}
 	for i, tc := range testCases {
 		tc := tc
@@ -274,7 +296,8 @@ func TestHeaderHash(t *testing.T) {
 			EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
 			ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
 			Entropy:            *NewBlockEntropy(tmhash.Sum([]byte("group_signature")), 0, 1, 0),
-		}, hexBytesFromString("3BF2FD9A5E3223EB08289B3DCB759642E13DDE75D66EAD05A8ABDE71566B2A0C")},
+			AeonHash:           tmhash.Sum([]byte("aeon_hash")),
+		}, hexBytesFromString("C6B5ECE8797250A53C735FC10EC9B2E15DFEFEE46E8B52D9031136C055D7F463")},
 		{"nil header yields nil", nil, nil},
 		{"nil ValidatorsHash yields nil", &Header{
 			Version:            version.Consensus{Block: 1, App: 2},
@@ -345,6 +368,7 @@ func TestMaxHeaderBytes(t *testing.T) {
 		LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
 		EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
 		ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
+		AeonHash:           tmhash.Sum([]byte("aeon_hash")),
 	}
 
 	bz, err := cdc.MarshalBinaryLengthPrefixed(h)
@@ -382,9 +406,9 @@ func TestBlockMaxDataBytes(t *testing.T) {
 	}{
 		0: {-10, 1, 0, true, 0},
 		1: {10, 1, 0, true, 0},
-		2: {865, 1, 0, true, 0},
-		3: {866, 1, 0, false, 0},
-		4: {867, 1, 0, false, 1},
+		2: {902, 1, 0, true, 0},
+		3: {903, 1, 0, false, 0},
+		4: {904, 1, 0, false, 1},
 	}
 
 	for i, tc := range testCases {
@@ -411,9 +435,9 @@ func TestBlockMaxDataBytesUnknownEvidence(t *testing.T) {
 	}{
 		0: {-10, 1, true, 0},
 		1: {10, 1, true, 0},
-		2: {961, 1, true, 0},
-		3: {962, 1, false, 0},
-		4: {963, 1, false, 1},
+		2: {1002, 1, true, 0},
+		3: {1003, 1, false, 0},
+		4: {1004, 1, false, 1},
 	}
 
 	for i, tc := range testCases {
@@ -39,6 +39,8 @@ type CanonicalVote struct {
 	BlockID   CanonicalBlockID
 	Timestamp time.Time
 	ChainID   string
+
+	EntropyTimedOut bool
 }
 
 //-----------------------------------
@@ -78,6 +80,8 @@ func CanonicalizeVote(chainID string, vote *Vote) CanonicalVote {
 		BlockID:   CanonicalizeBlockID(vote.BlockID),
 		Timestamp: vote.Timestamp,
 		ChainID:   chainID,
+
+		EntropyTimedOut: vote.EntropyTimedOut,
 	}
 }
 
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // DKGMessageType are message types allowed in DKG
@@ -19,11 +22,20 @@ const (
 	DKGQualComplaint
 	DKGReconstructionShare
 	DKGDryRun
+	// Messages of dkgs which reshare the keys of the previous aeon
+	DKGReshareCoefficient
+	DKGReshareShare
+	DKGReshareComplaint
+	DKGReshareComplaintAnswer
 
 	MaxDKGDataSize = 100000 // Max value calculated for committee size of 200
+	// MaxDKGPayloadSize is the max size of payloads gossiped off chain, which are not limited by
+	// the size of blocks
+	MaxDKGPayloadSize = 10 * MaxDKGDataSize
 )
 
-// DKGMessage contains DKGData for a particular phase of the DKG
+// DKGMessage contains DKGData for a particular phase of the DKG. Messages with a PayloadHash
+// only commit to their data on chain, and the payload itself is gossiped between peers
 type DKGMessage struct {
 	Type         DKGMessageType
 	FromAddress  crypto.Address
@@ -32,6 +44,12 @@ type DKGMessage struct {
 	Data         string
 	ToAddress    crypto.Address
 	Signature    []byte
+	PayloadHash  tmbytes.HexBytes
+}
+
+// DKGPayloadHash returns the hash with which a dkg message commits to a payload gossiped off chain
+func DKGPayloadHash(payload string) tmbytes.HexBytes {
+	return tmhash.Sum([]byte(payload))
 }
 
 // String returns a string representation of DKGMessage
@@ -60,7 +78,7 @@ func (m DKGMessage) SignBytes(chainID string) []byte {
 
 // ValidateBasic performs basic validation
 func (m *DKGMessage) ValidateBasic() error {
-	if m.Type < 0 || m.Type > DKGDryRun {
+	if m.Type < 0 || m.Type > DKGReshareComplaintAnswer {
 		return fmt.Errorf("invalid Type")
 	}
 	if len(m.FromAddress) != crypto.AddressSize {
@@ -72,7 +90,17 @@ func (m *DKGMessage) ValidateBasic() error {
 	if m.DKGID < 0 || m.DKGIteration < 0 {
 		return fmt.Errorf("invalid DKGID/DKGIteration")
 	}
-	if len(m.Data) == 0 || len(m.Data) > MaxDKGDataSize {
+	if m.HasOffChainPayload() {
+		if len(m.PayloadHash) != tmhash.Size {
+			return fmt.Errorf("expected PayloadHash size to be %d bytes, got %d bytes",
+				tmhash.Size,
+				len(m.PayloadHash),
+			)
+		}
+		if len(m.Data) != 0 {
+			return fmt.Errorf("expected empty Data with off-chain payload, got %d bytes", len(m.Data))
+		}
+	} else if len(m.Data) == 0 || len(m.Data) > MaxDKGDataSize {
 		return fmt.Errorf("expected non-empty Data size to be less than %d bytes, got %d bytes",
 			MaxDKGDataSize,
 			len(m.Data),
@@ -93,3 +121,136 @@ func (m *DKGMessage) ValidateBasic() error {
 	}
 	return nil
 }
+
+// HasOffChainPayload returns true if the message commits to a payload gossiped off chain, rather
+// than containing its data
+func (m *DKGMessage) HasOffChainPayload() bool {
+	return len(m.PayloadHash) != 0
+}
+
+// VerifyPayload checks that payload is the off-chain payload committed to by the message
+func (m *DKGMessage) VerifyPayload(payload string) error {
+	if !m.HasOffChainPayload() {
+		return fmt.Errorf("message has no off-chain payload")
+	}
+	if len(payload) == 0 || len(payload) > MaxDKGPayloadSize {
+		return fmt.Errorf("expected non-empty payload size to be less than %d bytes, got %d bytes",
+			MaxDKGPayloadSize,
+			len(payload),
+		)
+	}
+	if !bytes.Equal(DKGPayloadHash(payload), m.PayloadHash) {
+		return fmt.Errorf("payload does not match hash %v", m.PayloadHash)
+	}
+	return nil
+}
+
+// WithPayload returns a copy of the message with the off-chain payload as its data
+func (m *DKGMessage) WithPayload(payload string) *DKGMessage {
+	msg := *m
+	msg.Data = payload
+	return &msg
+}
+
+//-----------------------------------------------------------------------------
+
+// Key schemes of aeons, matching the names of the beacon execution units
+const (
+	BlsAeonKeyType  = "BlsAeon"
+	GlowAeonKeyType = "GlowAeon"
+)
+
+// DKGOutput is struct for broadcasting dkg completion info
+type DKGOutput struct {
+	KeyType         string   `json:"key_type"`
+	GroupPublicKey  string   `json:"group_public_key"`
+	PublicKeyShares []string `json:"public_key_shares"`
+	Generator       string   `json:"generator"`
+	ValidatorHeight int64    `json:"validator_height"`
+	Qual            []uint   `json:"qual"`
+	Start           int64    `json:"start"`
+	End             int64    `json:"end"`
+}
+
+// ValidateBasic for basic validity checking of dkg output
+func (output *DKGOutput) ValidateBasic() error {
+	if len(output.GroupPublicKey) != 0 {
+		if len(output.Generator) == 0 {
+			return fmt.Errorf("Empty generator")
+		}
+		if output.ValidatorHeight <= 0 {
+			return fmt.Errorf("Invalid validator height %v", output.ValidatorHeight)
+		}
+		if len(output.Qual) == 0 || len(output.Qual) != len(output.PublicKeyShares) {
+			return fmt.Errorf("Mismatch in qual size %v and public key shares %v", len(output.Qual), len(output.PublicKeyShares))
+		}
+	}
+	if output.Start <= 0 || output.End < output.Start {
+		return fmt.Errorf("Invalid start %v or end %v", output.Start, output.End)
+	}
+	return nil
+}
+
+// Equal returns true if both dkg outputs have the same encoding
+func (output *DKGOutput) Equal(other *DKGOutput) bool {
+	return bytes.Equal(cdc.MustMarshalBinaryBare(output), cdc.MustMarshalBinaryBare(other))
+}
+
+// Hash returns the hash of the dkg output, committed to in the header of blocks with entropy
+// generated by the aeon
+func (output *DKGOutput) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(cdc.MustMarshalBinaryBare(output))
+}
+
+// IsKeyless returns true if the dkg output is for an aeon without keys, for which only
+// trivial entropy is generated
+func (output *DKGOutput) IsKeyless() bool {
+	return len(output.GroupPublicKey) == 0
+}
+
+// DKGThreshold returns the number of signature shares required to compute a group signature
+// with the keys generated by a dkg run by numValidators
+func DKGThreshold(numValidators int) uint {
+	return uint(numValidators/2 + 1)
+}
+
+// DryRunPassSize returns the number of validators which must sign off on the output of
+// a dkg, in dry run messages, for it to be adopted
+func DryRunPassSize(numValidators int) int {
+	passSize := numValidators - numValidators/3
+	if threshold := int(DKGThreshold(numValidators)); passSize < threshold {
+		passSize = threshold
+	}
+	return passSize
+}
+
+// DryRunSignature is struct publishing public dkg output with group signature
+type DryRunSignature struct {
+	PublicInfo     DKGOutput `json:"public_info"`
+	SignatureShare string    `json:"group_signature"`
+}
+
+// ValidateBasic for basic validity checking of dry run signature
+func (dryRun *DryRunSignature) ValidateBasic() error {
+	err := dryRun.PublicInfo.ValidateBasic()
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if len(dryRun.SignatureShare) == 0 || len(dryRun.SignatureShare) > MaxEntropyShareSize {
+		return fmt.Errorf("Invalid signature share size %v", len(dryRun.SignatureShare))
+	}
+	return nil
+}
+
+// DKGStatus summarises the progress of a dkg run. Validators are listed if this node has
+// received the corresponding messages from them in the current iteration
+type DKGStatus struct {
+	DKGID           int64            `json:"dkg_id"`
+	Iteration       int64            `json:"iteration"`
+	State           string           `json:"state"`
+	ValidatorHeight int64            `json:"validator_height"`
+	StartHeight     int64            `json:"start_height"`
+	EncryptionKeys  []crypto.Address `json:"encryption_keys"`
+	Shares          []crypto.Address `json:"shares"`
+	DryRuns         []crypto.Address `json:"dry_runs"`
+}
@@ -68,7 +68,7 @@ func TestDKGValidateBasic(t *testing.T) {
 		expectErr       bool
 	}{
 		{"Good DKGMessage", func(msg *DKGMessage) {}, false},
-		{"Invalid Type 2", func(msg *DKGMessage) { msg.Type = DKGMessageType(9) }, true},
+		{"Invalid Type 2", func(msg *DKGMessage) { msg.Type = DKGReshareComplaintAnswer + 1 }, true},
 		{"Negative DKGID", func(msg *DKGMessage) { msg.DKGID = -1 }, true},
 		{"Negative DKGIteration", func(msg *DKGMessage) { msg.DKGIteration = -1 }, true},
 		{"Invalid FromAddress", func(msg *DKGMessage) { msg.FromAddress = make([]byte, 1) }, true},
@@ -78,6 +78,15 @@ func TestDKGValidateBasic(t *testing.T) {
 		{"Too big Data", func(msg *DKGMessage) { msg.Data = string(make([]byte, MaxDKGDataSize+1)) }, true},
 		{"Invalid Signature", func(msg *DKGMessage) { msg.Signature = nil }, true},
 		{"Too big Signature", func(msg *DKGMessage) { msg.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Off-chain payload", func(msg *DKGMessage) {
+			msg.Data = ""
+			msg.PayloadHash = DKGPayloadHash("dkg_data")
+		}, false},
+		{"Off-chain payload with Data", func(msg *DKGMessage) { msg.PayloadHash = DKGPayloadHash("dkg_data") }, true},
+		{"Invalid PayloadHash", func(msg *DKGMessage) {
+			msg.Data = ""
+			msg.PayloadHash = make([]byte, 1)
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -91,3 +100,34 @@ func TestDKGValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestDKGVerifyPayload(t *testing.T) {
+	msg := exampleDKGMessage(DKGShare)
+	assert.Error(t, msg.VerifyPayload("dkg_data"))
+
+	payload := msg.Data
+	msg.Data = ""
+	msg.PayloadHash = DKGPayloadHash(payload)
+	assert.NoError(t, msg.VerifyPayload(payload))
+	assert.Error(t, msg.VerifyPayload("other_data"))
+	assert.Error(t, msg.VerifyPayload(""))
+
+	withPayload := msg.WithPayload(payload)
+	assert.Equal(t, payload, withPayload.Data)
+	assert.Empty(t, msg.Data)
+
+	// Sign bytes of messages without an off-chain payload are unchanged by the payload hash field
+	msg = exampleDKGMessage(DKGShare)
+	legacy := struct {
+		Type         DKGMessageType
+		FromAddress  crypto.Address
+		DKGID        int64
+		DKGIteration int64
+		Data         string
+		ToAddress    crypto.Address
+		Signature    []byte
+	}{msg.Type, msg.FromAddress, msg.DKGID, msg.DKGIteration, msg.Data, msg.ToAddress, nil}
+	expected, err := cdc.MarshalBinaryLengthPrefixed(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte("test_chain_id"), expected...), msg.SignBytes("test_chain_id"))
+}
@@ -0,0 +1,59 @@
+package types
+
+// DKGStepType are the steps of a dkg which use the secret state of a node
+type DKGStepType uint16
+
+const (
+	// DKGStepStart starts an iteration of a dkg, returning the ephemeral key with which
+	// shares are encrypted to the node. Starting the iteration again keeps its state
+	DKGStepStart DKGStepType = iota
+	// DKGStepGetMessage returns the data of the message of MessageType sent by the node
+	DKGStepGetMessage
+	// DKGStepGetShare returns the share dealt to validator index To, encrypted to its
+	// ephemeral EncryptionKey
+	DKGStepGetShare
+	// DKGStepOnMessage processes the data of a message of MessageType from validator index
+	// From. Shares are decrypted with the ephemeral EncryptionKey of the sender
+	DKGStepOnMessage
+	// DKGStepReceivedAll returns whether messages of MessageType have been received from
+	// all validators
+	DKGStepReceivedAll
+	// DKGStepBuildQual returns the size of the qualified set of dealers
+	DKGStepBuildQual
+	// DKGStepCheckQualComplaints returns whether the qual complaints were checked successfully
+	DKGStepCheckQualComplaints
+	// DKGStepRunReconstruction returns whether the reconstruction of secrets succeeded
+	DKGStepRunReconstruction
+	// DKGStepComputeKeys computes the keys of the aeon with the heights of Output, and
+	// returns its public keys. The key share stays with the signer
+	DKGStepComputeKeys
+)
+
+// DKGStep is a step of an iteration of a dkg run by a DKGSigner for a node
+type DKGStep struct {
+	Type      DKGStepType
+	DKGID     int64
+	Iteration int64
+
+	// Parameters of the dkg, for DKGStepStart
+	CabinetSize uint
+	Threshold   uint
+	Index       uint // validator index of the node
+	KeyType     string
+
+	MessageType   DKGMessageType
+	From          uint   // validator index of sender
+	To            uint   // validator index of recipient
+	Data          string // message data
+	EncryptionKey []byte // ephemeral key of sender or recipient
+	Output        *DKGOutput
+}
+
+// DKGStepResult is the result of a DKGStep
+type DKGStepResult struct {
+	EncryptionKey []byte
+	Data          string
+	Success       bool
+	QualSize      uint
+	Output        *DKGOutput
+}
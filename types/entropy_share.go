@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // For event switch in entropy generator
@@ -17,6 +18,58 @@ const (
 
 type ThresholdSignature = []byte
 
+// GroupSignatureVerifier checks threshold signatures against the public
+// information of the aeon which produced them
+type GroupSignatureVerifier interface {
+	VerifyGroupSignature(aeon *DKGOutput, message string, signature ThresholdSignature) bool
+}
+
+// AeonSignatureVerifier checks signature shares, as well as threshold signatures, against the
+// public information of the aeon which produced them
+type AeonSignatureVerifier interface {
+	GroupSignatureVerifier
+	VerifySignatureShare(aeon *DKGOutput, message string, share string, index uint) bool
+}
+
+// EntropyMessage returns the message which the aeon signs to produce the entropy following
+// previousEntropy
+func EntropyMessage(previousEntropy ThresholdSignature) string {
+	return string(tmhash.Sum(previousEntropy))
+}
+
+// InitialEntropy is the entropy chained from by the first aeon in the absence of any previous
+// entropy
+func InitialEntropy(groupPublicKey string) ThresholdSignature {
+	return tmhash.Sum([]byte(groupPublicKey))
+}
+
+// VerifyBlockEntropy checks that entropy, included in the block at height, was generated by aeon
+// by signing the hash of previousEntropy
+func VerifyBlockEntropy(verifier GroupSignatureVerifier, aeon *DKGOutput, height int64, entropy *BlockEntropy,
+	previousEntropy ThresholdSignature) error {
+	if aeon.IsKeyless() {
+		return fmt.Errorf("aeon for dkg id %v has no keys", entropy.DKGID)
+	}
+	if height < aeon.Start || height > aeon.End {
+		return fmt.Errorf("height %v outside aeon [%v, %v]", height, aeon.Start, aeon.End)
+	}
+	if entropy.Round != height-aeon.Start || entropy.AeonLength != aeon.End-aeon.Start {
+		return fmt.Errorf("expected round/aeon length %v/%v, got %v/%v",
+			height-aeon.Start,
+			aeon.End-aeon.Start,
+			entropy.Round,
+			entropy.AeonLength,
+		)
+	}
+	if len(previousEntropy) == 0 {
+		previousEntropy = InitialEntropy(aeon.GroupPublicKey)
+	}
+	if !verifier.VerifyGroupSignature(aeon, EntropyMessage(previousEntropy), entropy.GroupSignature) {
+		return fmt.Errorf("invalid group signature for dkg id %v", entropy.DKGID)
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 
 // BlockEntropy struct for entropy in block
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EntropyTimeout is carried by a block which falls back to empty entropy because validators
+// with more than two thirds of the voting power timed out waiting for the beacon. It holds
+// their prevotes from a single round, each flagged with EntropyTimedOut.
+type EntropyTimeout struct {
+	Votes []*Vote `json:"votes"`
+}
+
+// MaxEntropyTimeoutBytes returns the maximum size of an entropy timeout from a validator set
+// of the given size, including amino overhead.
+func MaxEntropyTimeoutBytes(valsCount int) int64 {
+	return int64(valsCount) * MaxVoteBytes
+}
+
+// Height returns the height of the prevotes
+func (et *EntropyTimeout) Height() int64 {
+	if len(et.Votes) == 0 {
+		return 0
+	}
+	return et.Votes[0].Height
+}
+
+// Round returns the round of the prevotes
+func (et *EntropyTimeout) Round() int {
+	if len(et.Votes) == 0 {
+		return -1
+	}
+	return et.Votes[0].Round
+}
+
+// ValidateBasic checks the entropy timeout holds flagged prevotes from a single round.
+func (et *EntropyTimeout) ValidateBasic() error {
+	if len(et.Votes) == 0 {
+		return errors.New("no votes")
+	}
+	if len(et.Votes) > MaxVotesCount {
+		return fmt.Errorf("too many votes. Got %v, max %v", len(et.Votes), MaxVotesCount)
+	}
+	for i, vote := range et.Votes {
+		if vote == nil {
+			return fmt.Errorf("nil vote #%d", i)
+		}
+		if err := vote.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong vote #%d: %v", i, err)
+		}
+		if vote.Type != PrevoteType || !vote.EntropyTimedOut {
+			return fmt.Errorf("vote #%d is not a prevote flagged as timed out waiting for entropy", i)
+		}
+		if vote.Height != et.Height() || vote.Round != et.Round() {
+			return fmt.Errorf("vote #%d is for %v/%v, expected %v/%v", i, vote.Height, vote.Round,
+				et.Height(), et.Round())
+		}
+	}
+	return nil
+}
+
+// String returns a string representation of the entropy timeout
+func (et *EntropyTimeout) String() string {
+	if et == nil {
+		return "nil-EntropyTimeout"
+	}
+	return fmt.Sprintf("EntropyTimeout{%v/%v, %v votes}", et.Height(), et.Round(), len(et.Votes))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntropyTimeoutValidateBasic(t *testing.T) {
+	privVal := NewMockPV()
+	vote := &Vote{
+		ValidatorAddress: privVal.GetPubKey().Address(),
+		ValidatorIndex:   0,
+		Height:           10,
+		Round:            1,
+		Type:             PrevoteType,
+		EntropyTimedOut:  true,
+	}
+	err := privVal.SignVote("test_chain_id", vote)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		testName  string
+		malleate  func(*EntropyTimeout)
+		expectErr bool
+	}{
+		{"Good Entropy Timeout", func(et *EntropyTimeout) {}, false},
+		{"No Votes", func(et *EntropyTimeout) { et.Votes = nil }, true},
+		{"Nil Vote", func(et *EntropyTimeout) { et.Votes = append(et.Votes, nil) }, true},
+		{"Not Timed Out", func(et *EntropyTimeout) { et.Votes[0].EntropyTimedOut = false }, true},
+		{"Precommit", func(et *EntropyTimeout) { et.Votes[0].Type = PrecommitType }, true},
+		{"Different Round", func(et *EntropyTimeout) {
+			other := vote.Copy()
+			other.Round = 2
+			et.Votes = append(et.Votes, other)
+		}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			et := &EntropyTimeout{Votes: []*Vote{vote.Copy()}}
+			tc.malleate(et)
+			assert.Equal(t, tc.expectErr, et.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
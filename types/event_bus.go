@@ -221,6 +221,26 @@ func (b *EventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpd
 	return b.Publish(EventValidatorSetUpdates, data)
 }
 
+func (b *EventBus) PublishEventDKGStateChange(data EventDataDKGStateChange) error {
+	return b.Publish(EventDKGStateChange, data)
+}
+
+func (b *EventBus) PublishEventDKGCompleted(data EventDataDKGCompleted) error {
+	return b.Publish(EventDKGCompleted, data)
+}
+
+func (b *EventBus) PublishEventDKGFailed(data EventDataDKGFailed) error {
+	return b.Publish(EventDKGFailed, data)
+}
+
+func (b *EventBus) PublishEventNewEntropy(data EventDataNewEntropy) error {
+	return b.Publish(EventNewEntropy, data)
+}
+
+func (b *EventBus) PublishEventAeonChanged(data EventDataAeonChanged) error {
+	return b.Publish(EventAeonChanged, data)
+}
+
 //-----------------------------------------------------------------------------
 type NopEventBus struct{}
 
@@ -296,3 +316,23 @@ func (NopEventBus) PublishEventLock(data EventDataRoundState) error {
 func (NopEventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpdates) error {
 	return nil
 }
+
+func (NopEventBus) PublishEventDKGStateChange(data EventDataDKGStateChange) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventDKGCompleted(data EventDataDKGCompleted) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventDKGFailed(data EventDataDKGFailed) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventNewEntropy(data EventDataNewEntropy) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventAeonChanged(data EventDataAeonChanged) error {
+	return nil
+}
@@ -262,7 +262,7 @@ func TestEventBusPublish(t *testing.T) {
 	require.NoError(t, err)
 	defer eventBus.Stop()
 
-	const numEventsExpected = 14
+	const numEventsExpected = 19
 
 	sub, err := eventBus.Subscribe(context.Background(), "test", tmquery.Empty{}, numEventsExpected)
 	require.NoError(t, err)
@@ -307,6 +307,16 @@ func TestEventBusPublish(t *testing.T) {
 	require.NoError(t, err)
 	err = eventBus.PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates{})
 	require.NoError(t, err)
+	err = eventBus.PublishEventDKGStateChange(EventDataDKGStateChange{})
+	require.NoError(t, err)
+	err = eventBus.PublishEventDKGCompleted(EventDataDKGCompleted{})
+	require.NoError(t, err)
+	err = eventBus.PublishEventDKGFailed(EventDataDKGFailed{})
+	require.NoError(t, err)
+	err = eventBus.PublishEventNewEntropy(EventDataNewEntropy{})
+	require.NoError(t, err)
+	err = eventBus.PublishEventAeonChanged(EventDataAeonChanged{})
+	require.NoError(t, err)
 
 	select {
 	case <-done:
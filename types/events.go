@@ -35,6 +35,15 @@ const (
 	EventUnlock           = "Unlock"
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
+
+	// Beacon events.
+	// These are triggered by the dkg and the entropy generator, and allow
+	// monitoring the progress of dkgs and the entropy used by consensus.
+	EventAeonChanged    = "AeonChanged"
+	EventDKGCompleted   = "DKGCompleted"
+	EventDKGFailed      = "DKGFailed"
+	EventDKGStateChange = "DKGStateChange"
+	EventNewEntropy     = "NewEntropy"
 )
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -57,6 +66,11 @@ func RegisterEventDatas(cdc *amino.Codec) {
 	cdc.RegisterConcrete(EventDataVote{}, "tendermint/event/Vote", nil)
 	cdc.RegisterConcrete(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates", nil)
 	cdc.RegisterConcrete(EventDataString(""), "tendermint/event/ProposalString", nil)
+	cdc.RegisterConcrete(EventDataDKGStateChange{}, "tendermint/event/DKGStateChange", nil)
+	cdc.RegisterConcrete(EventDataDKGCompleted{}, "tendermint/event/DKGCompleted", nil)
+	cdc.RegisterConcrete(EventDataDKGFailed{}, "tendermint/event/DKGFailed", nil)
+	cdc.RegisterConcrete(EventDataNewEntropy{}, "tendermint/event/NewEntropy", nil)
+	cdc.RegisterConcrete(EventDataAeonChanged{}, "tendermint/event/AeonChanged", nil)
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -120,6 +134,47 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// EventDataDKGStateChange is fired when the dkg with DKGID enters a new
+// state at BlockHeight
+type EventDataDKGStateChange struct {
+	DKGID       int64  `json:"dkg_id"`
+	Iteration   int64  `json:"iteration"`
+	BlockHeight int64  `json:"block_height"`
+	State       string `json:"state"`
+}
+
+// EventDataDKGCompleted is fired when a dkg produces an aeon. Aeon only
+// contains the public dkg output, and is keyless if the node is not in qual
+type EventDataDKGCompleted struct {
+	DKGID     int64     `json:"dkg_id"`
+	Iteration int64     `json:"iteration"`
+	Aeon      DKGOutput `json:"aeon"`
+}
+
+// EventDataDKGFailed is fired when an iteration of the dkg fails, in
+// State, and is reset
+type EventDataDKGFailed struct {
+	DKGID       int64  `json:"dkg_id"`
+	Iteration   int64  `json:"iteration"`
+	BlockHeight int64  `json:"block_height"`
+	State       string `json:"state"`
+}
+
+// EventDataNewEntropy is fired when the entropy generator has the entropy
+// for a new block height
+type EventDataNewEntropy struct {
+	Height  int64        `json:"height"`
+	Entropy BlockEntropy `json:"entropy"`
+	Enabled bool         `json:"enabled"`
+}
+
+// EventDataAeonChanged is fired when the entropy generator starts
+// generating entropy with a new aeon
+type EventDataAeonChanged struct {
+	Height int64     `json:"height"`
+	Aeon   DKGOutput `json:"aeon"`
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // PUBSUB
 ///////////////////////////////////////////////////////////////////////////////
@@ -136,10 +191,15 @@ const (
 )
 
 var (
+	EventQueryAeonChanged         = QueryForEvent(EventAeonChanged)
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryDKGCompleted        = QueryForEvent(EventDKGCompleted)
+	EventQueryDKGFailed           = QueryForEvent(EventDKGFailed)
+	EventQueryDKGStateChange      = QueryForEvent(EventDKGStateChange)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
+	EventQueryNewEntropy          = QueryForEvent(EventNewEntropy)
 	EventQueryNewRound            = QueryForEvent(EventNewRound)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStep)
 	EventQueryPolka               = QueryForEvent(EventPolka)
@@ -169,6 +229,15 @@ type BlockEventPublisher interface {
 	PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates) error
 }
 
+// BeaconEventPublisher publishes all dkg and entropy related events
+type BeaconEventPublisher interface {
+	PublishEventDKGStateChange(EventDataDKGStateChange) error
+	PublishEventDKGCompleted(EventDataDKGCompleted) error
+	PublishEventDKGFailed(EventDataDKGFailed) error
+	PublishEventNewEntropy(EventDataNewEntropy) error
+	PublishEventAeonChanged(EventDataAeonChanged) error
+}
+
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
@@ -17,7 +17,8 @@ import (
 
 const (
 	// MaxEvidenceBytes is a maximum size of any evidence (including amino overhead).
-	MaxEvidenceBytes int64 = 484
+	// The largest is InvalidEntropyShareEvidence with a share of MaxEntropyShareSize.
+	MaxEvidenceBytes int64 = 675
 )
 
 // ErrEvidenceInvalid wraps a piece of evidence and the error denoting how or why it is invalid.
@@ -71,6 +72,8 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&InvalidEntropyShareEvidence{}, "tendermint/InvalidEntropyShareEvidence", nil)
+	cdc.RegisterConcrete(&DKGComplaintEvidence{}, "tendermint/DKGComplaintEvidence", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -110,6 +110,20 @@ func TestMaxEvidenceBytes(t *testing.T) {
 	bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
 	require.NoError(t, err)
 
+	assert.True(t, int64(len(bz)) <= MaxEvidenceBytes)
+
+	// Largest evidence
+	timestamp := time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	shareEv := &InvalidEntropyShareEvidence{
+		PubKey:    secp256k1.GenPrivKey().PubKey(),
+		Share:     makeMaxEntropyShare("a"),
+		DKGID:     math.MaxInt64,
+		Timestamp: timestamp,
+	}
+
+	bz, err = cdc.MarshalBinaryLengthPrefixed(shareEv)
+	require.NoError(t, err)
+
 	assert.EqualValues(t, MaxEvidenceBytes, len(bz))
 }
 
@@ -60,9 +60,38 @@ type ValidatorParams struct {
 	PubKeyTypes []string `json:"pub_key_types"`
 }
 
+const (
+	// ShuffleProposerSampling orders the validators for each height by shuffling them with
+	// math/rand seeded from the entropy, regardless of voting power. Used by chains whose
+	// params predate the choice
+	ShuffleProposerSampling = "Shuffle"
+	// WeightedProposerSampling picks the proposer for each round with
+	// ValidatorSet.SampleProposer, weighted by voting power
+	WeightedProposerSampling = "Weighted"
+)
+
 // EntropyParams determine configuration of DKG and entropy generation
 type EntropyParams struct {
 	AeonLength int64 `json:"aeon_length"`
+
+	// Duration in blocks of each dkg state in the first iteration of a dkg run by up to 100
+	// validators. Scaled up for larger validator sets
+	DKGStateDuration int64 `json:"dkg_state_duration"`
+	// Percentage by which state durations increase after each failed dkg iteration
+	DKGIterationDurationIncrease int64 `json:"dkg_iteration_duration_increase"`
+	// State durations are not increased beyond this number of blocks
+	DKGMaxStateDuration int64 `json:"dkg_max_state_duration"`
+	// Blocks between the end of a failed dkg iteration and the start of the next
+	DKGResetDelay int64 `json:"dkg_reset_delay"`
+	// Reshare the keys of the current aeon to the validators of the next, keeping the group public
+	// key, rather than running a full dkg. Falls back to a full dkg if resharing fails.
+	DKGReshare bool `json:"dkg_reshare"`
+	// Key scheme of the aeons generated by dkgs, either BlsAeonKeyType or GlowAeonKeyType. Read
+	// when each dkg starts, so changes take effect from the next aeon.
+	KeyType string `json:"key_type"`
+	// How proposers are picked from the validators when there is entropy, either
+	// ShuffleProposerSampling or WeightedProposerSampling
+	ProposerSampling string `json:"proposer_sampling"`
 }
 
 // DefaultConsensusParams returns a default ConsensusParams.
@@ -101,7 +130,13 @@ func DefaultValidatorParams() ValidatorParams {
 // DefaultEntropyParams returns a default EntropyParams.
 func DefaultEntropyParams() EntropyParams {
 	return EntropyParams{
-		AeonLength: 100,
+		AeonLength:                   100,
+		DKGStateDuration:             5,
+		DKGIterationDurationIncrease: 50,
+		DKGMaxStateDuration:          400,
+		DKGResetDelay:                2,
+		KeyType:                      GlowAeonKeyType,
+		ProposerSampling:             ShuffleProposerSampling,
 	}
 }
 
@@ -163,6 +198,36 @@ func (params *ConsensusParams) Validate() error {
 		return errors.Errorf("entropyParams.AeonLength must be greater than 0. Got %v", params.Entropy.AeonLength)
 	}
 
+	if params.Entropy.DKGStateDuration <= 0 {
+		return errors.Errorf("entropyParams.DKGStateDuration must be greater than 0. Got %v",
+			params.Entropy.DKGStateDuration)
+	}
+
+	if params.Entropy.DKGIterationDurationIncrease < 0 {
+		return errors.Errorf("entropyParams.DKGIterationDurationIncrease must be greater or equal to 0. Got %v",
+			params.Entropy.DKGIterationDurationIncrease)
+	}
+
+	if params.Entropy.DKGMaxStateDuration < params.Entropy.DKGStateDuration {
+		return errors.Errorf("entropyParams.DKGMaxStateDuration must be greater or equal to DKGStateDuration. Got %v < %v",
+			params.Entropy.DKGMaxStateDuration, params.Entropy.DKGStateDuration)
+	}
+
+	if params.Entropy.DKGResetDelay < 0 {
+		return errors.Errorf("entropyParams.DKGResetDelay must be greater or equal to 0. Got %v",
+			params.Entropy.DKGResetDelay)
+	}
+
+	if params.Entropy.KeyType != BlsAeonKeyType && params.Entropy.KeyType != GlowAeonKeyType {
+		return errors.Errorf("entropyParams.KeyType %s is an unknown aeon key type", params.Entropy.KeyType)
+	}
+
+	if params.Entropy.ProposerSampling != ShuffleProposerSampling &&
+		params.Entropy.ProposerSampling != WeightedProposerSampling {
+		return errors.Errorf("entropyParams.ProposerSampling %s is an unknown proposer sampling",
+			params.Entropy.ProposerSampling)
+	}
+
 	return nil
 }
 
@@ -215,6 +280,13 @@ func (params ConsensusParams) Update(params2 *abci.ConsensusParams) ConsensusPar
 	}
 	if params2.Entropy != nil {
 		res.Entropy.AeonLength = params2.Entropy.AeonLength
+		res.Entropy.DKGStateDuration = params2.Entropy.DkgStateDuration
+		res.Entropy.DKGIterationDurationIncrease = params2.Entropy.DkgIterationDurationIncrease
+		res.Entropy.DKGMaxStateDuration = params2.Entropy.DkgMaxStateDuration
+		res.Entropy.DKGResetDelay = params2.Entropy.DkgResetDelay
+		res.Entropy.DKGReshare = params2.Entropy.DkgReshare
+		res.Entropy.KeyType = params2.Entropy.KeyType
+		res.Entropy.ProposerSampling = params2.Entropy.ProposerSampling
 	}
 	return res
 }
@@ -39,6 +39,21 @@ func TestConsensusParamsValidation(t *testing.T) {
 		// test invalid pubkey type provided
 		12: {makeParams(1, 0, 10, 1, []string{"potatoes make good pubkeys"}, 100), false},
 		13: {makeParams(1, 0, 10, 1, valEd25519, 0), false},
+		// test dkg timing params
+		14: {makeEntropyParams(1, 0, 1, 0), true},
+		15: {makeEntropyParams(0, 50, 400, 2), false},
+		16: {makeEntropyParams(5, -1, 400, 2), false},
+		17: {makeEntropyParams(5, 50, 4, 2), false},
+		18: {makeEntropyParams(5, 50, 400, -1), false},
+		// test aeon key types
+		19: {makeKeyTypeParams(BlsAeonKeyType), true},
+		20: {makeKeyTypeParams(GlowAeonKeyType), true},
+		21: {makeKeyTypeParams(""), false},
+		22: {makeKeyTypeParams("RsaAeon"), false},
+		// test proposer sampling
+		23: {makeProposerSamplingParams(ShuffleProposerSampling), true},
+		24: {makeProposerSamplingParams(WeightedProposerSampling), true},
+		25: {makeProposerSamplingParams(""), false},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -70,11 +85,38 @@ func makeParams(
 			PubKeyTypes: pubkeyTypes,
 		},
 		Entropy: EntropyParams{
-			AeonLength: aeonLength,
+			AeonLength:                   aeonLength,
+			DKGStateDuration:             5,
+			DKGIterationDurationIncrease: 50,
+			DKGMaxStateDuration:          400,
+			DKGResetDelay:                2,
+			KeyType:                      GlowAeonKeyType,
+			ProposerSampling:             ShuffleProposerSampling,
 		},
 	}
 }
 
+func makeEntropyParams(stateDuration, durationIncrease, maxStateDuration, resetDelay int64) ConsensusParams {
+	params := makeParams(1, 0, 10, 1, valEd25519, 100)
+	params.Entropy.DKGStateDuration = stateDuration
+	params.Entropy.DKGIterationDurationIncrease = durationIncrease
+	params.Entropy.DKGMaxStateDuration = maxStateDuration
+	params.Entropy.DKGResetDelay = resetDelay
+	return params
+}
+
+func makeKeyTypeParams(keyType string) ConsensusParams {
+	params := makeParams(1, 0, 10, 1, valEd25519, 100)
+	params.Entropy.KeyType = keyType
+	return params
+}
+
+func makeProposerSamplingParams(sampling string) ConsensusParams {
+	params := makeParams(1, 0, 10, 1, valEd25519, 100)
+	params.Entropy.ProposerSampling = sampling
+	return params
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []ConsensusParams{
 		makeParams(4, 2, 10, 3, valEd25519, 100),
@@ -130,11 +172,98 @@ func TestConsensusParamsUpdate(t *testing.T) {
 					PubKeyTypes: valSecp256k1,
 				},
 				Entropy: &abci.EntropyParams{
-					AeonLength: 120,
+					AeonLength:                   120,
+					DkgStateDuration:             5,
+					DkgIterationDurationIncrease: 50,
+					DkgMaxStateDuration:          400,
+					DkgResetDelay:                2,
+					KeyType:                      GlowAeonKeyType,
+					ProposerSampling:             ShuffleProposerSampling,
 				},
 			},
 			makeParams(100, 200, 10, 300, valSecp256k1, 120),
 		},
+		// dkg timing updates
+		{
+			makeParams(1, 2, 10, 3, valEd25519, 100),
+			&abci.ConsensusParams{
+				Entropy: &abci.EntropyParams{
+					AeonLength:                   100,
+					DkgStateDuration:             10,
+					DkgIterationDurationIncrease: 25,
+					DkgMaxStateDuration:          200,
+					DkgResetDelay:                4,
+					KeyType:                      GlowAeonKeyType,
+					ProposerSampling:             ShuffleProposerSampling,
+				},
+			},
+			func() ConsensusParams {
+				params := makeParams(1, 2, 10, 3, valEd25519, 100)
+				params.Entropy = EntropyParams{100, 10, 25, 200, 4, false, GlowAeonKeyType, ShuffleProposerSampling}
+				return params
+			}(),
+		},
+		// enable resharing
+		{
+			makeParams(1, 2, 10, 3, valEd25519, 100),
+			&abci.ConsensusParams{
+				Entropy: &abci.EntropyParams{
+					AeonLength:                   100,
+					DkgStateDuration:             5,
+					DkgIterationDurationIncrease: 50,
+					DkgMaxStateDuration:          400,
+					DkgResetDelay:                2,
+					DkgReshare:                   true,
+					KeyType:                      GlowAeonKeyType,
+					ProposerSampling:             ShuffleProposerSampling,
+				},
+			},
+			func() ConsensusParams {
+				params := makeParams(1, 2, 10, 3, valEd25519, 100)
+				params.Entropy.DKGReshare = true
+				return params
+			}(),
+		},
+		// switch aeon key type
+		{
+			makeParams(1, 2, 10, 3, valEd25519, 100),
+			&abci.ConsensusParams{
+				Entropy: &abci.EntropyParams{
+					AeonLength:                   100,
+					DkgStateDuration:             5,
+					DkgIterationDurationIncrease: 50,
+					DkgMaxStateDuration:          400,
+					DkgResetDelay:                2,
+					KeyType:                      BlsAeonKeyType,
+					ProposerSampling:             ShuffleProposerSampling,
+				},
+			},
+			func() ConsensusParams {
+				params := makeParams(1, 2, 10, 3, valEd25519, 100)
+				params.Entropy.KeyType = BlsAeonKeyType
+				return params
+			}(),
+		},
+		// switch to weighted proposer sampling
+		{
+			makeParams(1, 2, 10, 3, valEd25519, 100),
+			&abci.ConsensusParams{
+				Entropy: &abci.EntropyParams{
+					AeonLength:                   100,
+					DkgStateDuration:             5,
+					DkgIterationDurationIncrease: 50,
+					DkgMaxStateDuration:          400,
+					DkgResetDelay:                2,
+					KeyType:                      GlowAeonKeyType,
+					ProposerSampling:             WeightedProposerSampling,
+				},
+			},
+			func() ConsensusParams {
+				params := makeParams(1, 2, 10, 3, valEd25519, 100)
+				params.Entropy.ProposerSampling = WeightedProposerSampling
+				return params
+			}(),
+		},
 	}
 	for _, tc := range testCases {
 		assert.Equal(t, tc.updatedParams, tc.params.Update(tc.updates))
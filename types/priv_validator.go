@@ -21,6 +21,25 @@ type PrivValidator interface {
 	SignDKGMessage(chainID string, msg *DKGMessage) error
 }
 
+// AeonSigner holds the private key shares of aeons, so that they need not be
+// loaded into the node process.
+type AeonSigner interface {
+	// SignAeonShare computes the signature share of message with the key share
+	// of the aeon generated by the dkg with dkgID
+	SignAeonShare(dkgID int64, message string) (string, error)
+}
+
+// DKGSigner also runs the steps of dkgs which use the secret state of the node,
+// so that the shares sent to it and the aeon key shares computed from them are
+// never loaded into the node process. The key shares computed by dkgs are kept
+// by the signer to sign aeon shares.
+type DKGSigner interface {
+	AeonSigner
+
+	// DKGStep runs step of a dkg on chain chainID
+	DKGStep(chainID string, step *DKGStep) (*DKGStepResult, error)
+}
+
 //----------------------------------------
 // Misc.
 
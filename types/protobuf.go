@@ -16,8 +16,10 @@ import (
 // Use strings to distinguish types in ABCI messages
 
 const (
-	ABCIEvidenceTypeDuplicateVote = "duplicate/vote"
-	ABCIEvidenceTypeMock          = "mock/evidence"
+	ABCIEvidenceTypeDuplicateVote       = "duplicate/vote"
+	ABCIEvidenceTypeInvalidEntropyShare = "invalid/entropy_share"
+	ABCIEvidenceTypeDKGComplaint        = "dkg/complaint"
+	ABCIEvidenceTypeMock                = "mock/evidence"
 )
 
 const (
@@ -155,7 +157,14 @@ func (tm2pb) ConsensusParams(params *ConsensusParams) *abci.ConsensusParams {
 			PubKeyTypes: params.Validator.PubKeyTypes,
 		},
 		Entropy: &abci.EntropyParams{
-			AeonLength: params.Entropy.AeonLength,
+			AeonLength:                   params.Entropy.AeonLength,
+			DkgStateDuration:             params.Entropy.DKGStateDuration,
+			DkgIterationDurationIncrease: params.Entropy.DKGIterationDurationIncrease,
+			DkgMaxStateDuration:          params.Entropy.DKGMaxStateDuration,
+			DkgResetDelay:                params.Entropy.DKGResetDelay,
+			DkgReshare:                   params.Entropy.DKGReshare,
+			KeyType:                      params.Entropy.KeyType,
+			ProposerSampling:             params.Entropy.ProposerSampling,
 		},
 	}
 }
@@ -175,6 +184,10 @@ func (tm2pb) Evidence(ev Evidence, valSet *ValidatorSet, evTime time.Time) abci.
 	switch ev.(type) {
 	case *DuplicateVoteEvidence:
 		evType = ABCIEvidenceTypeDuplicateVote
+	case *InvalidEntropyShareEvidence:
+		evType = ABCIEvidenceTypeInvalidEntropyShare
+	case *DKGComplaintEvidence:
+		evType = ABCIEvidenceTypeDKGComplaint
 	case MockEvidence:
 		// XXX: not great to have test types in production paths ...
 		evType = ABCIEvidenceTypeMock
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 )
 
@@ -309,6 +311,50 @@ func (vals *ValidatorSet) findProposer() *Validator {
 	return proposer
 }
 
+// SampleProposer returns the proposer for round, drawn from the validators with probability
+// proportional to their voting power using seed as the source of randomness. Rounds are sampled
+// independently. The algorithm is part of consensus and must give the same result in every
+// implementation:
+//
+//  1. digest = SHA256(seed || uint64be(round) || uint64be(counter)), with counter starting at 0
+//  2. x = uint64be(digest[0:8]). If x >= 2^64 - (2^64 mod total voting power), increment counter
+//     and go back to 1, so that x mod total voting power is unbiased
+//  3. target = x mod total voting power. The proposer is the first validator, in address order,
+//     for which the sum of voting powers up to and including its own exceeds target
+//
+// If the validator set is empty, nil is returned.
+func (vals *ValidatorSet) SampleProposer(seed []byte, round int) *Validator {
+	if len(vals.Validators) == 0 || round < 0 {
+		return nil
+	}
+	total := uint64(vals.TotalVotingPower())
+	// Largest x accepted in step 2, computed without overflowing
+	maxAccepted := uint64(math.MaxUint64) - (math.MaxUint64%total+1)%total
+
+	message := make([]byte, len(seed)+16)
+	copy(message, seed)
+	binary.BigEndian.PutUint64(message[len(seed):], uint64(round))
+	var x uint64
+	for counter := uint64(0); ; counter++ {
+		binary.BigEndian.PutUint64(message[len(seed)+8:], counter)
+		x = binary.BigEndian.Uint64(tmhash.Sum(message)[:8])
+		if x <= maxAccepted {
+			break
+		}
+	}
+
+	target := x % total
+	cumulative := uint64(0)
+	for _, val := range vals.Validators {
+		cumulative += uint64(val.VotingPower)
+		if cumulative > target {
+			return val.Copy()
+		}
+	}
+	// Unreachable as target is less than the total voting power
+	return nil
+}
+
 // Hash returns the Merkle root hash build using validators (as leaves) in the
 // set.
 func (vals *ValidatorSet) Hash() []byte {
@@ -670,6 +716,101 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
 }
 
+// VerifyEntropyTimeout checks the prevotes in the entropy timeout are for height and were signed
+// by validators with more than two thirds of the voting power of the set.
+func (vals *ValidatorSet) VerifyEntropyTimeout(chainID string, height int64, et *EntropyTimeout) error {
+	if err := et.ValidateBasic(); err != nil {
+		return err
+	}
+	if et.Height() != height {
+		return fmt.Errorf("entropy timeout for wrong height. Got %v, expected %v", et.Height(), height)
+	}
+
+	signed := make(map[int]bool)
+	talliedVotingPower := int64(0)
+	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
+	for _, vote := range et.Votes {
+		_, val := vals.GetByIndex(vote.ValidatorIndex)
+		if val == nil {
+			return fmt.Errorf("validator index %v not in validator set", vote.ValidatorIndex)
+		}
+		if signed[vote.ValidatorIndex] {
+			return fmt.Errorf("duplicate vote from validator index %v", vote.ValidatorIndex)
+		}
+		if err := vote.Verify(chainID, val.PubKey); err != nil {
+			return fmt.Errorf("wrong vote from validator index %v: %v", vote.ValidatorIndex, err)
+		}
+		signed[vote.ValidatorIndex] = true
+		talliedVotingPower += val.VotingPower
+	}
+
+	if talliedVotingPower <= votingPowerNeeded {
+		return fmt.Errorf("not enough voting power timed out waiting for entropy. Got %v, needed more than %v",
+			talliedVotingPower, votingPowerNeeded)
+	}
+	return nil
+}
+
+// VerifyAeonDryRuns checks that enough of the validator set signed dry run
+// messages, for the dkg with the given id, agreeing to the aeon for it to have
+// been adopted as the dkg output. The validator set must be the one at the
+// validator height of the aeon.
+func (vals *ValidatorSet) VerifyAeonDryRuns(chainID string, dkgID int64, aeon *DKGOutput,
+	dryRuns []*DKGMessage) error {
+
+	signed := make(map[string]bool)
+	for _, msg := range dryRuns {
+		dryRun, err := vals.VerifyDryRun(chainID, dkgID, msg)
+		if err != nil {
+			return err
+		}
+		if dryRun.PublicInfo.Equal(aeon) {
+			signed[string(msg.FromAddress)] = true
+		}
+	}
+
+	needed := DryRunPassSize(vals.Size())
+	if len(signed) < needed {
+		return fmt.Errorf("not enough validators signed aeon dry run. Got %v, needed %v", len(signed), needed)
+	}
+	return nil
+}
+
+// VerifyDKGMessage checks the dkg message is well formed and was signed by a member of the
+// validator set.
+func (vals *ValidatorSet) VerifyDKGMessage(chainID string, msg *DKGMessage) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	idx, val := vals.GetByAddress(msg.FromAddress)
+	if idx < 0 {
+		return fmt.Errorf("sender %X not in validator set", msg.FromAddress)
+	}
+	if !val.PubKey.VerifyBytes(msg.SignBytes(chainID), msg.Signature) {
+		return fmt.Errorf("wrong signature from %X", msg.FromAddress)
+	}
+	return nil
+}
+
+// VerifyDryRun checks the dry run message, for the dkg with the given id, was
+// signed by a member of the validator set and returns the decoded dry run.
+func (vals *ValidatorSet) VerifyDryRun(chainID string, dkgID int64, msg *DKGMessage) (*DryRunSignature, error) {
+	if msg.Type != DKGDryRun || msg.DKGID != dkgID {
+		return nil, fmt.Errorf("unexpected dry run type %v or dkg id %v", msg.Type, msg.DKGID)
+	}
+	if err := vals.VerifyDKGMessage(chainID, msg); err != nil {
+		return nil, fmt.Errorf("invalid dry run: %v", err)
+	}
+	dryRun := new(DryRunSignature)
+	if err := cdc.UnmarshalBinaryBare([]byte(msg.Data), dryRun); err != nil {
+		return nil, fmt.Errorf("error decoding dry run from %X: %v", msg.FromAddress, err)
+	}
+	if err := dryRun.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid dry run from %X: %v", msg.FromAddress, err)
+	}
+	return dryRun, nil
+}
+
 // VerifyFutureCommit will check to see if the set would be valid with a different
 // validator set.
 //
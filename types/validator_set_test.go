@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
@@ -10,6 +11,7 @@ import (
 	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -339,6 +341,82 @@ func (vals *ValidatorSet) fromBytes(b []byte) {
 	}
 }
 
+// Test vectors for SampleProposer, to be shared with other implementations. Addresses are
+// repeated bytes, given as the byte, and each round maps to the byte of the expected proposer.
+var sampleProposerVectors = []struct {
+	seed      string
+	addresses []byte
+	powers    []int64
+	proposers map[int]byte
+}{
+	{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		[]byte{0x11},
+		[]int64{1},
+		map[int]byte{0: 0x11, 1: 0x11, 7: 0x11},
+	},
+	{
+		"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		[]byte{0x11, 0x22, 0x33, 0x44},
+		[]int64{10, 10, 10, 10},
+		map[int]byte{0: 0x44, 1: 0x22, 2: 0x11, 3: 0x22, 4: 0x33, 5: 0x11, 6: 0x22, 7: 0x44},
+	},
+	{
+		"2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		[]byte{0x11, 0x22, 0x33, 0x44},
+		[]int64{1, 10, 100, 1000},
+		map[int]byte{0: 0x44, 1: 0x44, 2: 0x44, 3: 0x44, 4: 0x44, 5: 0x44, 6: 0x44, 7: 0x33},
+	},
+	{
+		"fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+		[]byte{0x44, 0x11},
+		[]int64{MaxTotalVotingPower / 2, MaxTotalVotingPower / 2},
+		map[int]byte{0: 0x11, 1: 0x44, 2: 0x44, 3: 0x44, 4: 0x11, 5: 0x44, 6: 0x11, 7: 0x44},
+	},
+	// Rounds 5 and 103 reject the first digest, and would pick the other validator otherwise
+	{
+		"fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+		[]byte{0x22, 0x33},
+		[]int64{1 << 58, 1<<58 + 1},
+		map[int]byte{5: 0x22, 103: 0x33},
+	},
+}
+
+func TestSampleProposer(t *testing.T) {
+	for i, v := range sampleProposerVectors {
+		seed, err := hex.DecodeString(v.seed)
+		require.NoError(t, err)
+		vals := make([]*Validator, len(v.addresses))
+		for j, address := range v.addresses {
+			vals[j] = newValidator(bytes.Repeat([]byte{address}, crypto.AddressSize), v.powers[j])
+		}
+		valSet := NewValidatorSet(vals)
+
+		for round, proposer := range v.proposers {
+			sampled := valSet.SampleProposer(seed, round)
+			require.NotNil(t, sampled)
+			assert.Equal(t, bytes.Repeat([]byte{proposer}, crypto.AddressSize), sampled.Address.Bytes(),
+				"vector %d round %d", i, round)
+		}
+	}
+
+	assert.Nil(t, NewValidatorSet(nil).SampleProposer([]byte{1}, 0))
+}
+
+func TestSampleProposerWeighting(t *testing.T) {
+	valSet := NewValidatorSet([]*Validator{
+		newValidator([]byte("a"), 1),
+		newValidator([]byte("b"), 3),
+	})
+	counts := make(map[string]int)
+	for round := 0; round < 4000; round++ {
+		counts[string(valSet.SampleProposer([]byte("seed"), round).Address)]++
+	}
+	// b should propose three times as often as a
+	assert.InDelta(t, 3000, counts["b"], 150)
+	assert.Equal(t, 4000, counts["a"]+counts["b"])
+}
+
 //-------------------------------------------------------------------
 
 func TestValidatorSetTotalVotingPowerPanicsOnOverflow(t *testing.T) {
@@ -12,7 +12,7 @@ import (
 
 const (
 	// MaxVoteBytes is a maximum vote size (including amino overhead).
-	MaxVoteBytes int64  = 223
+	MaxVoteBytes int64  = 225
 	nilVoteStr   string = "nil-Vote"
 )
 
@@ -54,6 +54,8 @@ type Vote struct {
 	ValidatorAddress Address       `json:"validator_address"`
 	ValidatorIndex   int           `json:"validator_index"`
 	Signature        []byte        `json:"signature"`
+	// Set on prevotes by validators which timed out waiting for entropy at the height
+	EntropyTimedOut bool `json:"entropy_timed_out"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -163,6 +165,9 @@ func (vote *Vote) ValidateBasic() error {
 	if vote.ValidatorIndex < 0 {
 		return errors.New("negative ValidatorIndex")
 	}
+	if vote.EntropyTimedOut && vote.Type != PrevoteType {
+		return errors.New("only prevotes can be flagged as timed out waiting for entropy")
+	}
 	if len(vote.Signature) == 0 {
 		return errors.New("signature is missing")
 	}
@@ -437,6 +437,30 @@ func (voteSet *VoteSet) TwoThirdsMajority() (blockID BlockID, ok bool) {
 	return BlockID{}, false
 }
 
+// EntropyTimeout returns the prevotes flagged as timed out waiting for entropy if they carry more
+// than two thirds of the voting power, or nil otherwise.
+func (voteSet *VoteSet) EntropyTimeout() *EntropyTimeout {
+	if voteSet == nil || voteSet.signedMsgType != PrevoteType {
+		return nil
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	var votes []*Vote
+	sum := int64(0)
+	for _, vote := range voteSet.votes {
+		if vote != nil && vote.EntropyTimedOut {
+			votes = append(votes, vote)
+			_, val := voteSet.valSet.GetByIndex(vote.ValidatorIndex)
+			sum += val.VotingPower
+		}
+	}
+	if sum <= voteSet.valSet.TotalVotingPower()*2/3 {
+		return nil
+	}
+	return &EntropyTimeout{Votes: votes}
+}
+
 //--------------------------------------------------------------------------------
 // Strings and JSON
 
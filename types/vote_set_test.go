@@ -546,3 +546,59 @@ func TestMakeCommit(t *testing.T) {
 		t.Errorf("error in Commit.ValidateBasic(): %v", err)
 	}
 }
+
+func TestEntropyTimeout(t *testing.T) {
+	height, round := int64(1), 0
+	voteSet, valSet, privValidators := randVoteSet(height, round, PrevoteType, 10, 1)
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             PrevoteType,
+		BlockID:          BlockID{},
+		EntropyTimedOut:  true,
+	}
+
+	// 6 out of 10 timed out waiting for entropy and one prevoted nil without timing out.
+	for i := 0; i < 7; i++ {
+		addr := privValidators[i].GetPubKey().Address()
+		vote := withValidator(voteProto, addr, i)
+		vote.EntropyTimedOut = i < 6
+		_, err := signAddVote(privValidators[i], vote, voteSet)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	assert.Nil(t, voteSet.EntropyTimeout())
+
+	// The 8th timed out as well.
+	{
+		addr := privValidators[7].GetPubKey().Address()
+		vote := withValidator(voteProto, addr, 7)
+		_, err := signAddVote(privValidators[7], vote, voteSet)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	entropyTimeout := voteSet.EntropyTimeout()
+	if assert.NotNil(t, entropyTimeout) {
+		assert.Len(t, entropyTimeout.Votes, 7)
+		assert.NoError(t, entropyTimeout.ValidateBasic())
+		assert.NoError(t, valSet.VerifyEntropyTimeout("test_chain_id", height, entropyTimeout))
+
+		assert.Error(t, valSet.VerifyEntropyTimeout("test_chain_id", height+1, entropyTimeout))
+		assert.Error(t, valSet.VerifyEntropyTimeout("other_chain_id", height, entropyTimeout))
+		assert.Error(t, valSet.VerifyEntropyTimeout("test_chain_id", height,
+			&EntropyTimeout{Votes: entropyTimeout.Votes[:6]}))
+		duplicated := append(entropyTimeout.Votes[:6:6], entropyTimeout.Votes[0])
+		assert.Error(t, valSet.VerifyEntropyTimeout("test_chain_id", height, &EntropyTimeout{Votes: duplicated}))
+	}
+
+	// Precommits never carry an entropy timeout
+	precommits := NewVoteSet("test_chain_id", height, round, PrecommitType, valSet)
+	assert.Nil(t, precommits.EntropyTimeout())
+}
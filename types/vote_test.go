@@ -124,6 +124,22 @@ func TestVoteSignBytesTestVectors(t *testing.T) {
 				0x32,
 				0xd, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64}, // chainID
 		},
+		// prevote flagged as timed out waiting for entropy:
+		5: {
+			"", &Vote{Height: 1, Round: 1, Type: PrevoteType, EntropyTimedOut: true},
+			[]byte{
+				0x23,                                   // length
+				0x8,                                    // (field_number << 3) | wire_type
+				0x1,                                    // PrevoteType
+				0x11,                                   // (field_number << 3) | wire_type
+				0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // height
+				0x19,                                   // (field_number << 3) | wire_type
+				0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // round
+				0x2a,                                                                // (field_number << 3) | wire_type
+				0xb, 0x8, 0x80, 0x92, 0xb8, 0xc3, 0x98, 0xfe, 0xff, 0xff, 0xff, 0x1, // timestamp
+				0x38, // (field_number << 3) | wire_type
+				0x1}, // entropy timed out
+		},
 	}
 	for i, tc := range tests {
 		got := tc.vote.SignBytes(tc.chainID)
@@ -221,6 +237,7 @@ func TestMaxVoteBytes(t *testing.T) {
 		Round:            math.MaxInt64,
 		Timestamp:        timestamp,
 		Type:             PrevoteType,
+		EntropyTimedOut:  true,
 		BlockID: BlockID{
 			Hash: tmhash.Sum([]byte("blockID_hash")),
 			PartsHeader: PartSetHeader{
@@ -272,6 +289,7 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Entropy Timed Out Precommit", func(v *Vote) { v.EntropyTimedOut = true }, true},
 	}
 	for _, tc := range testCases {
 		tc := tc